@@ -41,9 +41,17 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 		}
 	}
 
-	// initialize the deliver state and check state with a correct header
+	// initialize the deliver state and check state with a correct header. As
+	// after each Commit, the check state holds the height of the last committed
+	// block, i.e. the one before the initial height, so that CheckTx validates
+	// txs against the height of the first block they can be included in.
+	checkHeader := initHeader
+	if checkHeader.Height > 0 {
+		checkHeader.Height--
+	}
+
 	app.setDeliverState(initHeader)
-	app.setCheckState(initHeader)
+	app.setCheckState(checkHeader)
 
 	// Store the consensus params in the BaseApp's paramstore. Note, this must be
 	// done after the deliver state and context have been set as it's persisted
@@ -107,11 +115,18 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 func (app *BaseApp) Info(req abci.RequestInfo) abci.ResponseInfo {
 	lastCommitID := app.cms.LastCommitID()
 
-	return abci.ResponseInfo{
+	res := abci.ResponseInfo{
 		Data:             app.name,
+		Version:          app.appVersion,
 		LastBlockHeight:  lastCommitID.Version,
 		LastBlockAppHash: lastCommitID.Hash,
 	}
+
+	if app.infoHandler != nil {
+		res = app.infoHandler(req, res)
+	}
+
+	return res
 }
 
 // SetOption implements the ABCI interface.
@@ -173,7 +188,9 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		gasMeter = sdk.NewInfiniteGasMeter()
 	}
 
-	app.deliverState.ctx = app.deliverState.ctx.WithBlockGasMeter(gasMeter)
+	app.deliverState.ctx = app.deliverState.ctx.
+		WithBlockGasMeter(gasMeter).
+		WithFeatureActivations(app.GetFeatureActivations(app.deliverState.ctx))
 
 	if app.beginBlocker != nil {
 		res = app.beginBlocker(app.deliverState.ctx, req)
@@ -261,6 +278,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 	gInfo, result, err := app.runTx(runTxModeDeliver, req.Tx)
 	if err != nil {
 		resultStr = "failed"
+		app.logger.Debug("failed to deliver tx", "err", stackTracedError{err})
 		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
 
@@ -276,10 +294,10 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned abci.ResponseCommit. Commit will set the check state based on the
-// latest header and reset the deliver state. Also, if a non-zero halt height is
-// defined in config, Commit will execute a deferred function call to check
-// against that height and gracefully halt if it matches the latest committed
-// height.
+// latest header and reset the deliver state. Also, if a non-zero halt height or
+// halt time is defined in config, Commit will check against them and, if the
+// latest committed block reached them, flush the state sync snapshots and
+// gracefully halt.
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "commit")
 
@@ -299,6 +317,12 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// Commit. Use the header from this latest block.
 	app.setCheckState(header)
 
+	// Reset the mempool filter, transactions remaining in the mempool are
+	// accounted for again when Tendermint rechecks them.
+	if app.mempoolFilter != nil {
+		app.mempoolFilter.Reset()
+	}
+
 	// empty/reset the deliver state
 	app.deliverState = nil
 
@@ -312,16 +336,28 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		halt = true
 	}
 
+	snapshot := app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0
+
 	if halt {
+		// Take the snapshot of the halt height, if any, and wait for the snapshots
+		// being taken so that they are complete once the node is stopped.
+		if snapshot {
+			app.snapshot(header.Height)
+		}
+
+		app.snapshotWG.Wait()
+
 		// Halt the binary and allow Tendermint to receive the ResponseCommit
 		// response with the commit ID hash. This will allow the node to successfully
 		// restart and process blocks assuming the halt configuration has been
 		// reset or moved to a more distant value.
 		app.halt()
-	}
-
-	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
-		go app.snapshot(header.Height)
+	} else if snapshot {
+		app.snapshotWG.Add(1)
+		go func() {
+			defer app.snapshotWG.Done()
+			app.snapshot(header.Height)
+		}()
 	}
 
 	return abci.ResponseCommit{
@@ -411,6 +447,10 @@ func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no query path provided"))
 	}
 
+	if handler := app.abciQueryHandler(path); handler != nil {
+		return handler(req)
+	}
+
 	switch path[0] {
 	// "/app" prefix for special application queries
 	case "app":
@@ -632,7 +672,11 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 	// branch the commit-multistore for safety
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+	).WithMinGasPrices(app.minGasPrices).WithBlockHeight(height)
+
+	// the feature activations are read from the queried state so that
+	// IsFeatureEnabled reflects the queried height
+	ctx = ctx.WithFeatureActivations(app.GetFeatureActivations(ctx))
 
 	return ctx, nil
 }
@@ -729,8 +773,14 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 		switch path[1] {
 		case "simulate":
 			txBytes := req.Data
+			simulate := app.Simulate
 
-			gInfo, res, err := app.Simulate(txBytes)
+			// "/app/simulate/trace" records the store operations of the tx
+			if len(path) >= 3 && path[2] == "trace" {
+				simulate = app.SimulateWithStoreTrace
+			}
+
+			gInfo, res, err := simulate(txBytes)
 			if err != nil {
 				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to simulate tx"))
 			}
@@ -871,3 +921,14 @@ func splitPath(requestPath string) (path []string) {
 
 	return path
 }
+
+// stackTracedError formats the error it wraps with its stack trace, if any. The
+// stack trace is only formatted when the error is logged, i.e. when the log
+// level is enabled.
+type stackTracedError struct {
+	err error
+}
+
+func (e stackTracedError) Error() string {
+	return fmt.Sprintf("%+v", e.err)
+}
@@ -0,0 +1,71 @@
+package baseapp
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIQueryHandler handles the ABCI queries whose path starts with the path it
+// is registered for with SetABCIQueryHandler. The height of the request is set
+// to the latest height if the client did not provide one.
+type ABCIQueryHandler func(req abci.RequestQuery) abci.ResponseQuery
+
+// InfoHandler augments the response of the ABCI Info method, e.g. with the
+// version of the binary or the features it enables. It is given the response
+// built by BaseApp.
+type InfoHandler func(req abci.RequestInfo, res abci.ResponseInfo) abci.ResponseInfo
+
+// SetABCIQueryHandler registers an ABCIQueryHandler for the ABCI queries whose
+// path starts with the given path, e.g. "/app/simulate/custom" or "/custom-path".
+// Paths are matched by their segments, the handler of the longest matching
+// path handling the query. Registered handlers take precedence over the
+// "/app", "/store", "/p2p" and "/custom" queries of BaseApp, but not over gRPC
+// queries.
+func (app *BaseApp) SetABCIQueryHandler(path string, handler ABCIQueryHandler) {
+	if app.sealed {
+		panic("SetABCIQueryHandler() on sealed BaseApp")
+	}
+
+	segments := splitPath(strings.TrimSuffix(path, "/"))
+	if len(segments) == 0 || segments[0] == "" {
+		panic("SetABCIQueryHandler() with an empty path")
+	}
+
+	if app.abciQueryHandlers == nil {
+		app.abciQueryHandlers = make(map[string]ABCIQueryHandler)
+	}
+
+	app.abciQueryHandlers[strings.Join(segments, "/")] = handler
+}
+
+// SetInfoHandler sets the InfoHandler augmenting the response of the ABCI Info
+// method.
+func (app *BaseApp) SetInfoHandler(handler InfoHandler) {
+	if app.sealed {
+		panic("SetInfoHandler() on sealed BaseApp")
+	}
+
+	app.infoHandler = handler
+}
+
+// abciQueryHandler returns the registered ABCIQueryHandler of the longest path
+// the given query path starts with, if any.
+func (app *BaseApp) abciQueryHandler(path []string) ABCIQueryHandler {
+	for i := len(path); i > 0 && len(app.abciQueryHandlers) > 0; i-- {
+		if handler, ok := app.abciQueryHandlers[strings.Join(path[:i], "/")]; ok {
+			return handler
+		}
+	}
+
+	return nil
+}
+
+// CreateQueryContext returns a branched context of the state at the given
+// height for ABCI query handlers. A proof can only be requested for heights
+// greater than 1. The latest height is used if height is 0.
+func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, error) {
+	return app.createQueryContext(height, prove)
+}
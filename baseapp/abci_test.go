@@ -139,3 +139,52 @@ func TestBaseAppCreateQueryContextRejectsNegativeHeights(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseAppCreateQueryContextBlockHeight(t *testing.T) {
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmprototypes.Header{Height: height}})
+		app.Commit()
+	}
+
+	testCases := []struct {
+		height   int64
+		expected int64
+	}{
+		{0, 3},
+		{1, 1},
+		{2, 2},
+		{3, 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("height=%d", tc.height), func(t *testing.T) {
+			ctx, err := app.createQueryContext(tc.height, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ctx.BlockHeight())
+		})
+	}
+}
+
+func TestBaseAppCreateQueryContextFeatureActivations(t *testing.T) {
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+	app.paramStore.Set(app.deliverState.ctx, ParamStoreKeyFeatureActivations, sdk.FeatureActivations{{Name: "foo", Height: 2}})
+
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmprototypes.Header{Height: height}})
+		app.Commit()
+	}
+
+	ctx, err := app.createQueryContext(0, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), ctx.BlockHeight())
+	require.True(t, ctx.IsFeatureEnabled("foo"))
+
+	ctx, err = app.createQueryContext(1, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), ctx.BlockHeight())
+	require.False(t, ctx.IsFeatureEnabled("foo"))
+}
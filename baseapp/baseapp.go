@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -68,8 +69,9 @@ type BaseApp struct { // nolint: maligned
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager    *snapshots.Manager
-	snapshotInterval   uint64 // block interval between state sync snapshots
-	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+	snapshotInterval   uint64         // block interval between state sync snapshots
+	snapshotKeepRecent uint32         // recent state sync snapshots to keep
+	snapshotWG         sync.WaitGroup // snapshots being taken in the background
 
 	// volatile states:
 	//
@@ -84,6 +86,28 @@ type BaseApp struct { // nolint: maligned
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
 
+	// mempoolFilter, if set, decides whether a transaction accepted by the
+	// AnteHandler in CheckTx and ReCheckTx may enter the mempool
+	mempoolFilter MempoolFilter
+
+	// storeTraceEnabled enables the /app/simulate/trace query, which records
+	// the store operations of simulated txs up to storeTraceMaxGas gas and
+	// storeTraceMaxSize bytes of trace
+	storeTraceEnabled bool
+	storeTraceMaxGas  uint64
+	storeTraceMaxSize uint64
+
+	// storeKeyDecoders, if set, decode the keys of the store operations
+	// recorded by the /app/simulate/trace query, by store name
+	storeKeyDecoders sdk.StoreKeyDecoderRegistry
+
+	// abciQueryHandlers are the ABCI query handlers registered by the app, by
+	// path
+	abciQueryHandlers map[string]ABCIQueryHandler
+
+	// infoHandler, if set, augments the response of the ABCI Info method
+	infoHandler InfoHandler
+
 	// paramStore is used to query for ABCI consensus parameters from an
 	// application parameter store.
 	paramStore ParamStore
@@ -126,7 +150,8 @@ type BaseApp struct { // nolint: maligned
 	trace bool
 
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
-	// which informs Tendermint what to index. If empty, all events will be indexed.
+	// or {eventType}.* for all the attributes of an event type, which informs
+	// Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
 }
 
@@ -269,6 +294,23 @@ func (app *BaseApp) LoadVersion(version int64) error {
 	return app.init()
 }
 
+// ReloadLatestVersion reloads the latest version of the multistore of a
+// loaded BaseApp, e.g. once its database was updated by another process, and
+// resets the CheckTx state on top of it. It must not be called on a BaseApp
+// processing blocks.
+func (app *BaseApp) ReloadLatestVersion() error {
+	if !app.sealed {
+		return errors.New("cannot reload the latest version of a BaseApp which was not loaded")
+	}
+
+	if err := app.cms.LoadLatestVersion(); err != nil {
+		return fmt.Errorf("failed to reload latest version: %w", err)
+	}
+
+	app.setCheckState(tmproto.Header{})
+	return nil
+}
+
 // LastCommitID returns the last CommitID of the multistore.
 func (app *BaseApp) LastCommitID() sdk.CommitID {
 	return app.cms.LastCommitID()
@@ -279,6 +321,15 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// Close waits for the state sync snapshots being taken and closes the
+// database of the application. It must be called once the application is no
+// longer used, e.g. once the node is stopped.
+func (app *BaseApp) Close() error {
+	app.snapshotWG.Wait()
+
+	return app.db.Close()
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -414,6 +465,20 @@ func (app *BaseApp) GetConsensusParams(ctx sdk.Context) *abci.ConsensusParams {
 	return cp
 }
 
+// GetFeatureActivations returns the governance-set protocol feature activation
+// schedule from the BaseApp's ParamStore. If the BaseApp has no ParamStore
+// defined or no schedule was set, nil is returned.
+func (app *BaseApp) GetFeatureActivations(ctx sdk.Context) sdk.FeatureActivations {
+	if app.paramStore == nil || !app.paramStore.Has(ctx, ParamStoreKeyFeatureActivations) {
+		return nil
+	}
+
+	var features sdk.FeatureActivations
+	app.paramStore.Get(ctx, ParamStoreKeyFeatureActivations, &features)
+
+	return features
+}
+
 // AddRunTxRecoveryHandler adds custom app.runTx method panic handlers.
 func (app *BaseApp) AddRunTxRecoveryHandler(handlers ...RecoveryHandler) {
 	for _, h := range handlers {
@@ -518,7 +583,8 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos)
 
-	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx)).
+		WithFeatureActivations(app.GetFeatureActivations(ctx))
 
 	if mode == runTxModeReCheck {
 		ctx = ctx.WithIsReCheckTx(true)
@@ -558,12 +624,17 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+	return app.runTxWithContext(mode, app.getContextForTx(mode, txBytes), txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx, from the given context
+// for the transaction.
+func (app *BaseApp) runTxWithContext(mode runTxMode, ctx sdk.Context, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -613,7 +684,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		return sdk.GasInfo{}, nil, err
 	}
 
-	var events sdk.Events
+	var (
+		events      sdk.Events
+		anteMsCache sdk.CacheMultiStore
+	)
 	if app.anteHandler != nil {
 		var (
 			anteCtx sdk.Context
@@ -650,7 +724,20 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			return gInfo, nil, err
 		}
 
-		msCache.Write()
+		anteMsCache = msCache
+	}
+
+	// The mempool filter runs whether or not an AnteHandler is set, before the
+	// state changes of the AnteHandler are written so that they are discarded
+	// along with the txs it rejects.
+	if app.mempoolFilter != nil && (mode == runTxModeCheck || mode == runTxModeReCheck) {
+		if err := app.mempoolFilter.FilterTx(ctx, tx); err != nil {
+			return gInfo, nil, err
+		}
+	}
+
+	if anteMsCache != nil {
+		anteMsCache.Write()
 	}
 
 	// Create a new Context based off of the existing Context with a MultiStore branch
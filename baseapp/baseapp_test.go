@@ -3,6 +3,7 @@ package baseapp
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -503,6 +504,24 @@ func TestInfo(t *testing.T) {
 	// TODO
 }
 
+func TestInfoHandler(t *testing.T) {
+	infoHandlerOpt := func(bapp *BaseApp) {
+		bapp.SetAppVersion("v1.0.0")
+		bapp.SetInfoHandler(func(req abci.RequestInfo, res abci.ResponseInfo) abci.ResponseInfo {
+			require.Equal(t, "v1.0.0", res.Version)
+			res.Data = fmt.Sprintf("%s (features: %s)", res.Data, req.Version)
+			return res
+		})
+	}
+
+	app := setupBaseApp(t, infoHandlerOpt)
+
+	res := app.Info(abci.RequestInfo{Version: "foo"})
+	require.Equal(t, "v1.0.0", res.Version)
+	require.Equal(t, t.Name()+" (features: foo)", res.Data)
+	require.Equal(t, int64(0), res.LastBlockHeight)
+}
+
 func TestBaseAppOptionSeal(t *testing.T) {
 	app := setupBaseApp(t)
 
@@ -642,9 +661,15 @@ func TestInitChain_WithInitialHeight(t *testing.T) {
 			InitialHeight: 3,
 		},
 	)
+
+	// the check state holds the last committed height, as after a Commit
+	require.Equal(t, int64(3), app.deliverState.ctx.BlockHeight())
+	require.Equal(t, int64(2), app.checkState.ctx.BlockHeight())
+
 	app.Commit()
 
 	require.Equal(t, int64(3), app.LastBlockHeight())
+	require.Equal(t, int64(3), app.checkState.ctx.BlockHeight())
 }
 
 func TestBeginBlock_WithInitialHeight(t *testing.T) {
@@ -959,6 +984,42 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+type rejectingMempoolFilter struct{}
+
+func (rejectingMempoolFilter) FilterTx(sdk.Context, sdk.Tx) error {
+	return sdkerrors.Wrap(sdkerrors.ErrMempoolIsFull, "rejected by the mempool filter")
+}
+
+func (rejectingMempoolFilter) Reset() {}
+
+// Test that the mempool filter rejects txs in CheckTx even when no AnteHandler
+// is set, and that it is not invoked in DeliverTx.
+func TestCheckTxMempoolFilterWithoutAnteHandler(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+	filterOpt := func(bapp *BaseApp) { bapp.SetMempoolFilter(rejectingMempoolFilter{}) }
+
+	app := setupBaseApp(t, routerOpt, filterOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	txBytes, err := codec.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Equal(t, sdkerrors.ErrMempoolIsFull.ABCICode(), r.Code)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1159,6 +1220,118 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+func TestSimulateWithStoreTrace(t *testing.T) {
+	anteKey, msgKey := []byte("ante-key"), []byte("msg-key")
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			ctx.KVStore(capKey1).Get(anteKey)
+			return ctx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.KVStore(capKey2).Set(msgKey, []byte("value"))
+			ctx.KVStore(capKey2).Delete(anteKey)
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	decodersOpt := func(bapp *BaseApp) {
+		bapp.SetStoreKeyDecoders(sdk.StoreKeyDecoderRegistry{
+			capKey2.Name(): func(key []byte) (sdk.DecodedStoreKey, error) {
+				return sdk.NewStoreKeyParser("Test", key, nil).String("name").Decode()
+			},
+		})
+	}
+
+	setupApp := func(t *testing.T, options ...func(*BaseApp)) *BaseApp {
+		app := setupBaseApp(t, append(options, anteOpt, routerOpt, decodersOpt)...)
+		app.InitChain(abci.RequestInitChain{})
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+		return app
+	}
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	expected := []abci.Event{
+		storeTraceEvent("read", capKey1.Name(), anteKey, "", nil),
+		storeTraceEvent("write", capKey2.Name(), msgKey, "Test(name=msg-key)", []byte("value")),
+		storeTraceEvent("delete", capKey2.Name(), anteKey, "Test(name=ante-key)", nil),
+	}
+
+	app := setupApp(t, SetSimulateStoreTrace(true, 100000, 1<<20))
+
+	_, result, err := app.SimulateWithStoreTrace(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, expected, filterStoreTraceEvents(result.Events))
+
+	// the trace is returned by the simulate query as well
+	queryResult := app.Query(abci.RequestQuery{Path: "/app/simulate/trace", Data: txBytes})
+	require.True(t, queryResult.IsOK(), queryResult.Log)
+
+	var simRes sdk.SimulationResponse
+	require.NoError(t, jsonpb.Unmarshal(strings.NewReader(string(queryResult.Value)), &simRes))
+	require.Equal(t, expected, filterStoreTraceEvents(simRes.Result.Events))
+
+	// and simulations are not traced otherwise
+	_, result, err = app.Simulate(txBytes)
+	require.NoError(t, err)
+	require.Empty(t, filterStoreTraceEvents(result.Events))
+
+	// nor do they write to the state
+	require.Nil(t, app.checkState.ctx.KVStore(capKey2).Get(msgKey))
+
+	// store traces are disabled by default
+	app = setupApp(t)
+	_, _, err = app.SimulateWithStoreTrace(txBytes)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err), err)
+	require.False(t, app.Query(abci.RequestQuery{Path: "/app/simulate/trace", Data: txBytes}).IsOK())
+
+	// the store operations of a traced tx are bounded by the gas limit
+	app = setupApp(t, SetSimulateStoreTrace(true, 2000, 1<<20))
+	_, _, err = app.SimulateWithStoreTrace(txBytes)
+	require.True(t, sdkerrors.ErrOutOfGas.Is(err), err)
+
+	// and their trace by the size limit
+	app = setupApp(t, SetSimulateStoreTrace(true, 100000, 100))
+	_, _, err = app.SimulateWithStoreTrace(txBytes)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err), err)
+}
+
+func filterStoreTraceEvents(events []abci.Event) []abci.Event {
+	var traceEvents []abci.Event
+	for _, event := range events {
+		if event.Type == EventTypeStoreTrace {
+			traceEvents = append(traceEvents, event)
+		}
+	}
+
+	return traceEvents
+}
+
+func storeTraceEvent(operation, store string, key []byte, decodedKey string, value []byte) abci.Event {
+	attributes := []abci.EventAttribute{
+		{Key: []byte(AttributeKeyStoreTraceOperation), Value: []byte(operation)},
+		{Key: []byte(AttributeKeyStoreTraceStore), Value: []byte(store)},
+		{Key: []byte(AttributeKeyStoreTraceKey), Value: []byte(hex.EncodeToString(key))},
+	}
+
+	if decodedKey != "" {
+		attributes = append(attributes, abci.EventAttribute{Key: []byte(AttributeKeyStoreTraceDecodedKey), Value: []byte(decodedKey)})
+	}
+
+	attributes = append(attributes, abci.EventAttribute{Key: []byte(AttributeKeyStoreTraceValue), Value: []byte(hex.EncodeToString(value))})
+
+	return abci.Event{Type: EventTypeStoreTrace, Attributes: attributes}
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1764,6 +1937,56 @@ func TestP2PQuery(t *testing.T) {
 	require.Equal(t, uint32(4), res.Code)
 }
 
+func TestABCIQueryHandler(t *testing.T) {
+	abciQueryHandlerOpt := func(bapp *BaseApp) {
+		bapp.SetABCIQueryHandler("/app/simulate/custom", func(req abci.RequestQuery) abci.ResponseQuery {
+			return abci.ResponseQuery{Code: uint32(5), Height: req.Height, Value: req.Data}
+		})
+		bapp.SetABCIQueryHandler("/app/simulate/custom/nested/", func(req abci.RequestQuery) abci.ResponseQuery {
+			return abci.ResponseQuery{Code: uint32(6)}
+		})
+		bapp.SetABCIQueryHandler("/foo", func(req abci.RequestQuery) abci.ResponseQuery {
+			return abci.ResponseQuery{Code: uint32(7)}
+		})
+	}
+
+	app := setupBaseApp(t, abciQueryHandlerOpt)
+	app.InitChain(abci.RequestInitChain{})
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	testCases := []struct {
+		path    string
+		expCode uint32
+	}{
+		{"/app/simulate/custom", 5},
+		{"/app/simulate/custom/bar", 5},
+		{"/app/simulate/custom/nested", 6},
+		{"/app/simulate/custom/nested/bar", 6},
+		{"/app/simulate/customs", sdkerrors.ErrTxDecode.ABCICode()},
+		{"/foo", 7},
+		{"/foo/bar", 7},
+		{"/bar", sdkerrors.ErrUnknownRequest.ABCICode()},
+		{"/app/version", 0},
+	}
+
+	for _, tc := range testCases {
+		res := app.Query(abci.RequestQuery{Path: tc.path, Data: []byte("data")})
+		require.Equal(t, tc.expCode, res.Code, tc.path)
+	}
+
+	// the height is set to the latest height if not provided
+	res := app.Query(abci.RequestQuery{Path: "/app/simulate/custom", Data: []byte("data")})
+	require.Equal(t, int64(1), res.Height)
+	require.Equal(t, []byte("data"), res.Value)
+
+	require.Panics(t, func() {
+		app.SetABCIQueryHandler("/bar", func(req abci.RequestQuery) abci.ResponseQuery { return abci.ResponseQuery{} })
+	})
+}
+
 func TestGetMaximumBlockGas(t *testing.T) {
 	app := setupBaseApp(t)
 	app.InitChain(abci.RequestInitChain{})
@@ -1799,6 +2022,24 @@ func TestListSnapshots(t *testing.T) {
 	}}, resp)
 }
 
+func TestCloseWaitsForSnapshots(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 2, 1)
+	defer teardown()
+
+	for height := int64(3); height <= 4; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// the snapshot of height 4 is taken in the background, Close waits for it
+	require.NoError(t, app.Close())
+
+	resp := app.ListSnapshots(abci.RequestListSnapshots{})
+	require.Len(t, resp.Snapshots, 2)
+	require.Equal(t, uint64(4), resp.Snapshots[0].Height)
+}
+
 func TestLoadSnapshotChunk(t *testing.T) {
 	app, teardown := setupBaseAppWithSnapshots(t, 2, 5)
 	defer teardown()
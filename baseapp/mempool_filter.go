@@ -0,0 +1,166 @@
+package baseapp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MempoolFilter defines a hook invoked during CheckTx and ReCheckTx, once the
+// AnteHandler accepted a transaction, which decides whether the transaction
+// may enter or remain in the mempool. It is never invoked in DeliverTx and
+// therefore has no influence on consensus.
+type MempoolFilter interface {
+	// FilterTx returns an error if the transaction must be rejected from the
+	// mempool. A transaction accepted by FilterTx is considered pending until
+	// the next call to Reset.
+	FilterTx(ctx sdk.Context, tx sdk.Tx) error
+
+	// Reset is called on Commit, before Tendermint rechecks the transactions
+	// remaining in its mempool.
+	Reset()
+}
+
+// TxCountLimiter is a MempoolFilter limiting the number of pending transactions
+// per signer, and per signer and message type. It protects the mempool from a
+// single account flooding it, e.g. a relayer submitting thousands of client
+// updates.
+//
+// Pending transactions are counted from the last Commit. Accurate limits thus
+// require Tendermint's mempool recheck to be enabled, so that transactions
+// remaining in the mempool after a block are counted again.
+type TxCountLimiter struct {
+	mtx sync.Mutex
+
+	maxTxsPerSigner uint64
+	msgTypeLimits   map[string]uint64
+
+	signerCounts  map[string]uint64
+	msgTypeCounts map[string]uint64
+}
+
+var _ MempoolFilter = (*TxCountLimiter)(nil)
+
+// NewTxCountLimiter returns a TxCountLimiter allowing at most maxTxsPerSigner
+// pending transactions per signer and, for every message type in
+// msgTypeLimits, at most the given number of pending transactions per signer
+// containing a message of that type. A zero limit disables the corresponding
+// check.
+func NewTxCountLimiter(maxTxsPerSigner uint64, msgTypeLimits map[string]uint64) *TxCountLimiter {
+	return &TxCountLimiter{
+		maxTxsPerSigner: maxTxsPerSigner,
+		msgTypeLimits:   msgTypeLimits,
+		signerCounts:    make(map[string]uint64),
+		msgTypeCounts:   make(map[string]uint64),
+	}
+}
+
+// FilterTx implements MempoolFilter.
+func (l *TxCountLimiter) FilterTx(_ sdk.Context, tx sdk.Tx) error {
+	signers, msgTypeKeys := txSignersAndMsgTypes(tx)
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.maxTxsPerSigner > 0 {
+		for _, signer := range signers {
+			if l.signerCounts[signer] >= l.maxTxsPerSigner {
+				return sdkerrors.Wrapf(
+					sdkerrors.ErrMempoolIsFull, "signer %s has reached the limit of %d pending txs", signer, l.maxTxsPerSigner,
+				)
+			}
+		}
+	}
+
+	for key, msgType := range msgTypeKeys {
+		if limit := l.msgTypeLimits[msgType]; limit > 0 && l.msgTypeCounts[key] >= limit {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrMempoolIsFull, "signer has reached the limit of %d pending txs with %s messages", limit, msgType,
+			)
+		}
+	}
+
+	for _, signer := range signers {
+		l.signerCounts[signer]++
+	}
+
+	for key := range msgTypeKeys {
+		l.msgTypeCounts[key]++
+	}
+
+	return nil
+}
+
+// Reset implements MempoolFilter.
+func (l *TxCountLimiter) Reset() {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.signerCounts = make(map[string]uint64)
+	l.msgTypeCounts = make(map[string]uint64)
+}
+
+// txSignersAndMsgTypes returns the unique signers of the transaction along
+// with a map of signer/message type keys to their message type.
+func txSignersAndMsgTypes(tx sdk.Tx) ([]string, map[string]string) {
+	var signers []string
+
+	seen := make(map[string]bool)
+	msgTypeKeys := make(map[string]string)
+
+	for _, msg := range tx.GetMsgs() {
+		msgType := MsgTypeName(msg)
+
+		for _, signer := range msg.GetSigners() {
+			addr := signer.String()
+			if !seen[addr] {
+				seen[addr] = true
+				signers = append(signers, addr)
+			}
+
+			msgTypeKeys[addr+"/"+msgType] = msgType
+		}
+	}
+
+	return signers, msgTypeKeys
+}
+
+// MsgTypeName returns the name identifying the type of msg: the fully-qualified
+// service method name of a ServiceMsg (e.g. /cosmos.bank.v1beta1.Msg/Send) or
+// the fully-qualified proto message name of a legacy Msg prefixed with a
+// slash (e.g. /cosmos.bank.v1beta1.MsgSend).
+func MsgTypeName(msg sdk.Msg) string {
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		return svcMsg.MethodName
+	}
+
+	return "/" + proto.MessageName(msg)
+}
+
+// ParseMsgTypeLimits parses message type limits given in the form
+// {msgType}={limit}, e.g. /cosmos.bank.v1beta1.Msg/Send=10.
+func ParseMsgTypeLimits(limits []string) (map[string]uint64, error) {
+	res := make(map[string]uint64, len(limits))
+
+	for _, l := range limits {
+		idx := strings.LastIndex(l, "=")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid message type limit %q, expected {msgType}={limit}", l)
+		}
+
+		limit, err := strconv.ParseUint(l[idx+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message type limit %q: %w", l, err)
+		}
+
+		res[l[:idx]] = limit
+	}
+
+	return res, nil
+}
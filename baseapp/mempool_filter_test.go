@@ -0,0 +1,59 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mempoolTestTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mempoolTestTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mempoolTestTx) ValidateBasic() error { return nil }
+
+func TestTxCountLimiter(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	msgType := baseapp.MsgTypeName(testdata.NewTestMsg(addr1))
+	limiter := baseapp.NewTxCountLimiter(3, map[string]uint64{msgType: 2})
+
+	tx1 := mempoolTestTx{msgs: []sdk.Msg{testdata.NewTestMsg(addr1)}}
+	tx2 := mempoolTestTx{msgs: []sdk.Msg{testdata.NewTestMsg(addr2)}}
+	ctx := sdk.Context{}
+
+	// the per message type limit is reached first
+	require.NoError(t, limiter.FilterTx(ctx, tx1))
+	require.NoError(t, limiter.FilterTx(ctx, tx1))
+	require.Error(t, limiter.FilterTx(ctx, tx1))
+
+	// limits are enforced per signer
+	require.NoError(t, limiter.FilterTx(ctx, tx2))
+
+	// pending transactions are forgotten on reset
+	limiter.Reset()
+	require.NoError(t, limiter.FilterTx(ctx, tx1))
+
+	// the per signer limit applies to all message types
+	limiter = baseapp.NewTxCountLimiter(1, nil)
+	require.NoError(t, limiter.FilterTx(ctx, tx1))
+	require.Error(t, limiter.FilterTx(ctx, tx1))
+}
+
+func TestParseMsgTypeLimits(t *testing.T) {
+	limits, err := baseapp.ParseMsgTypeLimits([]string{"/cosmos.bank.v1beta1.Msg/Send=10"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"/cosmos.bank.v1beta1.Msg/Send": 10}, limits)
+
+	_, err = baseapp.ParseMsgTypeLimits([]string{"/cosmos.bank.v1beta1.Msg/Send"})
+	require.Error(t, err)
+
+	_, err = baseapp.ParseMsgTypeLimits([]string{"/cosmos.bank.v1beta1.Msg/Send=-1"})
+	require.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package baseapp
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgDispatcher executes Msg service messages on behalf of modules, e.g. the
+// messages a grantee executes with the authorization of a granter, with the
+// handlers registered in a MsgServiceRouter.
+type MsgDispatcher struct {
+	router *MsgServiceRouter
+}
+
+// NewMsgDispatcher returns a MsgDispatcher executing messages with the handlers
+// of the router.
+func NewMsgDispatcher(router *MsgServiceRouter) MsgDispatcher {
+	return MsgDispatcher{router: router}
+}
+
+// HasRoute returns whether a handler is registered for the Msg service method,
+// e.g. /cosmos.bank.v1beta1.Msg/Send.
+func (d MsgDispatcher) HasRoute(methodName string) bool {
+	_, ok := d.router.responseRoutes[methodName]
+	return ok
+}
+
+// DispatchResult is the result of a message executed by a MsgDispatcher.
+type DispatchResult struct {
+	// Response is the response of the Msg service method, e.g. a
+	// *banktypes.MsgSendResponse for a /cosmos.bank.v1beta1.Msg/Send message.
+	Response proto.Message
+	// Result holds the encoded response and the events of the message.
+	Result *sdk.Result
+}
+
+// Dispatch executes the message on behalf of the signer, which must be its only
+// signer, and returns its response. The message is validated with its
+// ValidateBasic method first, as messages are before being included in txs.
+// The events of the message are emitted on the EventManager of ctx.
+//
+// The state changes of the message are written to ctx even if it fails: the
+// caller is responsible for discarding them, e.g. with ctx.CacheContext.
+func (d MsgDispatcher) Dispatch(ctx sdk.Context, signer sdk.AccAddress, msg sdk.ServiceMsg) (DispatchResult, error) {
+	handler := d.router.responseRoutes[msg.MethodName]
+	if handler == nil {
+		return DispatchResult{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.MethodName)
+	}
+
+	if msg.Request == nil {
+		return DispatchResult{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "empty message %s", msg.MethodName)
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(signer) {
+		return DispatchResult{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %s must only be signed by %s", msg.MethodName, signer)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return DispatchResult{}, err
+	}
+
+	res, result, err := handler(ctx, msg.Request)
+	if err != nil {
+		return DispatchResult{}, sdkerrors.Wrapf(err, "failed to execute message; message %s", msg.MethodName)
+	}
+
+	for _, event := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(event))
+	}
+
+	return DispatchResult{Response: res, Result: result}, nil
+}
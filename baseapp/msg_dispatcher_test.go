@@ -0,0 +1,66 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMsgDispatcher(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(1000))
+	dispatcher := baseapp.NewMsgDispatcher(app.MsgServiceRouter())
+
+	sendMsg := func(from sdk.AccAddress, amount int64) sdk.ServiceMsg {
+		return sdk.ServiceMsg{
+			MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+			Request:    banktypes.NewMsgSend(from, addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))),
+		}
+	}
+
+	require.True(t, dispatcher.HasRoute("/cosmos.bank.v1beta1.Msg/Send"))
+	require.False(t, dispatcher.HasRoute("/cosmos.bank.v1beta1.Msg/Unknown"))
+
+	testCases := []struct {
+		msg    string
+		signer sdk.AccAddress
+		svcMsg sdk.ServiceMsg
+		expErr *sdkerrors.Error
+	}{
+		{"unknown method", addrs[0], sdk.ServiceMsg{MethodName: "/cosmos.bank.v1beta1.Msg/Unknown", Request: sendMsg(addrs[0], 10).Request}, sdkerrors.ErrUnknownRequest},
+		{"empty request", addrs[0], sdk.ServiceMsg{MethodName: "/cosmos.bank.v1beta1.Msg/Send"}, sdkerrors.ErrInvalidRequest},
+		{"wrong signer", addrs[1], sendMsg(addrs[0], 10), sdkerrors.ErrUnauthorized},
+		{"invalid message", addrs[0], sendMsg(addrs[0], 0), sdkerrors.ErrInvalidCoins},
+		{"failed message", addrs[0], sendMsg(addrs[0], 2000), sdkerrors.ErrInsufficientFunds},
+		{"valid message", addrs[0], sendMsg(addrs[0], 10), nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			ctx := ctx.WithEventManager(sdk.NewEventManager())
+			res, err := dispatcher.Dispatch(ctx, tc.signer, tc.svcMsg)
+
+			if tc.expErr != nil {
+				require.True(t, tc.expErr.Is(err), err)
+				require.Empty(t, ctx.EventManager().Events())
+				return
+			}
+
+			require.NoError(t, err)
+			require.IsType(t, &banktypes.MsgSendResponse{}, res.Response)
+			require.NotEmpty(t, res.Result.Events)
+			require.Equal(t, res.Result.Events, ctx.EventManager().ABCIEvents())
+		})
+	}
+
+	require.Equal(t, sdk.NewInt(1010), app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).Amount)
+}
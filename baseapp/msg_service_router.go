@@ -17,6 +17,7 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	responseRoutes    map[string]msgServiceResponseHandler
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -24,13 +25,18 @@ var _ gogogrpc.Server = &MsgServiceRouter{}
 // NewMsgServiceRouter creates a new MsgServiceRouter.
 func NewMsgServiceRouter() *MsgServiceRouter {
 	return &MsgServiceRouter{
-		routes: map[string]MsgServiceHandler{},
+		routes:         map[string]MsgServiceHandler{},
+		responseRoutes: map[string]msgServiceResponseHandler{},
 	}
 }
 
 // MsgServiceHandler defines a function type which handles Msg service message.
 type MsgServiceHandler = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error)
 
+// msgServiceResponseHandler is a MsgServiceHandler also returning the response
+// of the Msg service method.
+type msgServiceResponseHandler = func(ctx sdk.Context, req sdk.MsgRequest) (proto.Message, *sdk.Result, error)
+
 // Handler returns the MsgServiceHandler for a given query route path or nil
 // if not found.
 func (msr *MsgServiceRouter) Handler(methodName string) MsgServiceHandler {
@@ -83,7 +89,7 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			)
 		}
 
-		msr.routes[fqMethod] = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
+		responseHandler := func(ctx sdk.Context, req sdk.MsgRequest) (proto.Message, *sdk.Result, error) {
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -93,15 +99,26 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			// We don't do any decoding here because the decoding was already done.
 			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), noopDecoder, interceptor)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
 			resMsg, ok := res.(proto.Message)
 			if !ok {
-				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting proto.Message, got %T", resMsg)
+				return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting proto.Message, got %T", resMsg)
+			}
+
+			result, err := sdk.WrapServiceResult(ctx, resMsg, err)
+			if err != nil {
+				return nil, nil, err
 			}
 
-			return sdk.WrapServiceResult(ctx, resMsg, err)
+			return resMsg, result, nil
+		}
+
+		msr.responseRoutes[fqMethod] = responseHandler
+		msr.routes[fqMethod] = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
+			_, result, err := responseHandler(ctx, req)
+			return result, err
 		}
 	}
 }
@@ -63,6 +63,35 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetCommitBatching provides a BaseApp option function that, if enabled, makes
+// the CommitMultiStore write all the changes of a commit to the database in a
+// single batch, synced to disk unless asyncFsync is set.
+func SetCommitBatching(enabled, asyncFsync bool) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if enabled {
+			app.cms.SetCommitBatching(asyncFsync)
+		}
+	}
+}
+
+// SetIAVLFastIndex provides a BaseApp option function that enables or disables
+// the in-memory fast index of the latest version of the IAVL stores.
+func SetIAVLFastIndex(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.cms.SetIAVLFastIndex(enabled) }
+}
+
+// SetSimulateStoreTrace provides a BaseApp option function that enables or
+// disables the /app/simulate/trace query. The store operations of a simulated
+// tx are recorded up to maxGas gas, charged with the KV store gas config, and
+// maxSize bytes of trace.
+func SetSimulateStoreTrace(enabled bool, maxGas, maxSize uint64) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.storeTraceEnabled = enabled
+		app.storeTraceMaxGas = maxGas
+		app.storeTraceMaxSize = maxSize
+	}
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func SetSnapshotInterval(interval uint64) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
@@ -78,6 +107,12 @@ func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
 }
 
+// SetMempoolFilter provides a BaseApp option function that sets the mempool
+// filter invoked in CheckTx and ReCheckTx.
+func SetMempoolFilter(filter MempoolFilter) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempoolFilter(filter) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -86,6 +121,15 @@ func (app *BaseApp) SetName(name string) {
 	app.name = name
 }
 
+// SetMempoolFilter sets the mempool filter invoked in CheckTx and ReCheckTx.
+func (app *BaseApp) SetMempoolFilter(filter MempoolFilter) {
+	if app.sealed {
+		panic("SetMempoolFilter() on sealed BaseApp")
+	}
+
+	app.mempoolFilter = filter
+}
+
 // SetParamStore sets a parameter store on the BaseApp.
 func (app *BaseApp) SetParamStore(ps ParamStore) {
 	if app.sealed {
@@ -233,3 +277,12 @@ func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.grpcQueryRouter.SetInterfaceRegistry(registry)
 	app.msgServiceRouter.SetInterfaceRegistry(registry)
 }
+
+// SetStoreKeyDecoders sets the store key decoders of the modules, by store
+// name, decoding the keys of the store traces of simulated txs.
+func (app *BaseApp) SetStoreKeyDecoders(decoders sdk.StoreKeyDecoderRegistry) {
+	if app.sealed {
+		panic("SetStoreKeyDecoders() on sealed BaseApp")
+	}
+	app.storeKeyDecoders = decoders
+}
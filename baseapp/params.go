@@ -18,6 +18,10 @@ var (
 	ParamStoreKeyBlockParams     = []byte("BlockParams")
 	ParamStoreKeyEvidenceParams  = []byte("EvidenceParams")
 	ParamStoreKeyValidatorParams = []byte("ValidatorParams")
+
+	// ParamStoreKeyFeatureActivations defines the parameter store key of the
+	// governance-set protocol feature activation schedule.
+	ParamStoreKeyFeatureActivations = []byte("FeatureActivations")
 )
 
 // ParamStore defines the interface the parameter store used by the BaseApp must
@@ -84,3 +88,15 @@ func ValidateValidatorParams(i interface{}) error {
 
 	return nil
 }
+
+// ValidateFeatureActivations defines a stateless validation on the feature
+// activation schedule. This function is called whenever the parameter is
+// updated or stored.
+func ValidateFeatureActivations(i interface{}) error {
+	v, ok := i.(sdk.FeatureActivations)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
@@ -8,6 +8,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestValidateBlockParams(t *testing.T) {
@@ -64,3 +65,21 @@ func TestValidateValidatorParams(t *testing.T) {
 		require.Equal(t, tc.expectErr, baseapp.ValidateValidatorParams(tc.arg) != nil)
 	}
 }
+
+func TestValidateFeatureActivations(t *testing.T) {
+	testCases := []struct {
+		arg       interface{}
+		expectErr bool
+	}{
+		{nil, true},
+		{&sdk.FeatureActivations{}, true},
+		{sdk.FeatureActivations{}, false},
+		{sdk.FeatureActivations{{Name: "foo", Height: 0}}, true},
+		{sdk.FeatureActivations{{Name: "foo", Height: 10}}, false},
+		{sdk.FeatureActivations{{Name: "foo", Height: 10}, {Name: "bar", Height: 5}}, true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expectErr, baseapp.ValidateFeatureActivations(tc.arg) != nil)
+	}
+}
@@ -0,0 +1,204 @@
+package baseapp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Store trace events, appended to the result of a tx simulated with
+// SimulateWithStoreTrace.
+const (
+	EventTypeStoreTrace = "store_trace"
+
+	AttributeKeyStoreTraceOperation  = "operation"
+	AttributeKeyStoreTraceStore      = "store"
+	AttributeKeyStoreTraceKey        = "key"
+	AttributeKeyStoreTraceDecodedKey = "decoded_key"
+	AttributeKeyStoreTraceValue      = "value"
+)
+
+// storeTraceContextKey is the key of the trace context holding the name of the
+// store of a traced operation.
+const storeTraceContextKey = "store"
+
+// storeTraceMultiStore is a branched multi-store whose KV stores, and those of
+// its branches, write every operation to the given writer along with the name
+// of their store. Operations consume gas from the given gas meter, bounding the
+// work of a traced tx independently of the gas meter of its context, which is
+// infinite in simulations.
+type storeTraceMultiStore struct {
+	// the traced branch, which is a CacheMultiStore
+	sdk.MultiStore
+
+	writer   io.Writer
+	gasMeter sdk.GasMeter
+}
+
+var _ sdk.CacheMultiStore = storeTraceMultiStore{}
+
+func newStoreTraceMultiStore(ms sdk.CacheMultiStore, w io.Writer, gasMeter sdk.GasMeter) storeTraceMultiStore {
+	return storeTraceMultiStore{MultiStore: ms, writer: w, gasMeter: gasMeter}
+}
+
+// Write implements the CacheMultiStore interface.
+func (ms storeTraceMultiStore) Write() {
+	ms.MultiStore.(sdk.CacheMultiStore).Write()
+}
+
+// GetKVStore implements the MultiStore interface.
+func (ms storeTraceMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	return gaskv.NewStore(
+		tracekv.NewStore(ms.MultiStore.GetKVStore(key), ms.writer, sdk.TraceContext{storeTraceContextKey: key.Name()}),
+		ms.gasMeter, storetypes.KVGasConfig(),
+	)
+}
+
+// GetStore implements the MultiStore interface.
+func (ms storeTraceMultiStore) GetStore(key sdk.StoreKey) sdk.Store {
+	return ms.GetKVStore(key)
+}
+
+// CacheMultiStore implements the MultiStore interface. The branch is traced as
+// well.
+func (ms storeTraceMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return newStoreTraceMultiStore(ms.MultiStore.CacheMultiStore(), ms.writer, ms.gasMeter)
+}
+
+// SetTracer implements the MultiStore interface.
+func (ms storeTraceMultiStore) SetTracer(w io.Writer) sdk.MultiStore {
+	return newStoreTraceMultiStore(ms.MultiStore.SetTracer(w).(sdk.CacheMultiStore), ms.writer, ms.gasMeter)
+}
+
+// SetTracingContext implements the MultiStore interface.
+func (ms storeTraceMultiStore) SetTracingContext(tc sdk.TraceContext) sdk.MultiStore {
+	return newStoreTraceMultiStore(ms.MultiStore.SetTracingContext(tc).(sdk.CacheMultiStore), ms.writer, ms.gasMeter)
+}
+
+// storeTraceWriter buffers a store trace up to maxSize bytes. Operations
+// written past maxSize are dropped and the trace is marked as truncated.
+type storeTraceWriter struct {
+	buf       bytes.Buffer
+	maxSize   uint64
+	truncated bool
+}
+
+// Write implements the io.Writer interface.
+func (w *storeTraceWriter) Write(p []byte) (int, error) {
+	if w.truncated || uint64(w.buf.Len())+uint64(len(p)) > w.maxSize {
+		w.truncated = true
+		return len(p), nil
+	}
+
+	return w.buf.Write(p)
+}
+
+// storeTraceEvents returns the store trace events of the operations traced by
+// a tracekv.Store, in order. Keys and values are hex encoded, and keys are
+// decoded with the decoder of their store if any.
+func storeTraceEvents(trace []byte, decoders sdk.StoreKeyDecoderRegistry) ([]abci.Event, error) {
+	var events []abci.Event
+
+	scanner := bufio.NewScanner(bytes.NewReader(trace))
+	scanner.Buffer(nil, len(trace)+1)
+
+	for scanner.Scan() {
+		var op struct {
+			Operation string                 `json:"operation"`
+			Key       string                 `json:"key"`
+			Value     string                 `json:"value"`
+			Metadata  map[string]interface{} `json:"metadata"`
+		}
+
+		if err := json.Unmarshal(scanner.Bytes(), &op); err != nil {
+			return nil, err
+		}
+
+		key, err := base64.StdEncoding.DecodeString(op.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := base64.StdEncoding.DecodeString(op.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		store, _ := op.Metadata[storeTraceContextKey].(string)
+
+		attributes := []abci.EventAttribute{
+			{Key: []byte(AttributeKeyStoreTraceOperation), Value: []byte(op.Operation)},
+			{Key: []byte(AttributeKeyStoreTraceStore), Value: []byte(store)},
+			{Key: []byte(AttributeKeyStoreTraceKey), Value: []byte(hex.EncodeToString(key))},
+		}
+
+		if decoder, ok := decoders[store]; ok && len(key) > 0 {
+			if decoded, err := decoder(key); err == nil {
+				attributes = append(attributes, abci.EventAttribute{
+					Key: []byte(AttributeKeyStoreTraceDecodedKey), Value: []byte(decoded.String()),
+				})
+			}
+		}
+
+		attributes = append(attributes, abci.EventAttribute{
+			Key: []byte(AttributeKeyStoreTraceValue), Value: []byte(hex.EncodeToString(value)),
+		})
+
+		events = append(events, abci.Event{Type: EventTypeStoreTrace, Attributes: attributes})
+	}
+
+	return events, scanner.Err()
+}
+
+// SimulateWithStoreTrace simulates a tx like Simulate, and records every
+// operation of the tx on the KV stores: reads, writes, deletes and iterations.
+// They are appended to the events of the result, in order, as store_trace
+// events holding the operation, the name of the store, the hex encoded key and
+// value, and the key decoded with the store key decoders set on the app.
+//
+// It is meant for debugging only and must be enabled with
+// SetSimulateStoreTrace. The simulation fails if the store operations of the
+// tx exceed the configured gas, or their trace the configured size.
+func (app *BaseApp) SimulateWithStoreTrace(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+	if !app.storeTraceEnabled {
+		return sdk.GasInfo{}, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "store traces of simulated txs are disabled")
+	}
+
+	trace := &storeTraceWriter{maxSize: app.storeTraceMaxSize}
+	gasMeter := sdk.NewGasMeter(app.storeTraceMaxGas)
+
+	ctx := app.getContextForTx(runTxModeSimulate, txBytes)
+	ctx = ctx.WithMultiStore(newStoreTraceMultiStore(ctx.MultiStore().CacheMultiStore(), trace, gasMeter))
+
+	gInfo, res, err := app.runTxWithContext(runTxModeSimulate, ctx, txBytes)
+	if gasMeter.IsPastLimit() {
+		return gInfo, nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "store operations of the traced tx exceed %d gas", app.storeTraceMaxGas)
+	}
+
+	if err != nil {
+		return gInfo, res, err
+	}
+
+	if trace.truncated {
+		return gInfo, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "store trace of the tx exceeds %d bytes", app.storeTraceMaxSize)
+	}
+
+	events, err := storeTraceEvents(trace.buf.Bytes(), app.storeKeyDecoders)
+	if err != nil {
+		return gInfo, nil, err
+	}
+
+	res.Events = append(res.Events, events...)
+	return gInfo, res, nil
+}
@@ -0,0 +1,109 @@
+package debug
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// DecodeStoreCmd returns a command decoding the key and value of a store entry
+// with the store key decoders of the modules, registered by store name, and
+// the codec of the app.
+func DecodeStoreCmd(cdc codec.Marshaler, decoders sdk.StoreKeyDecoderRegistry) *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-store [store-name] [hex-key] [hex-value]",
+		Short: "Decode the key and value of a store entry",
+		Long: fmt.Sprintf(`Decode the hex encoded key, and value if any, of an entry of a module store
+with the store key decoder of the module: the key is decoded into the name of
+its prefix and the addresses, ids, etc. embedded in it, and the value into
+JSON if it is a protobuf message.
+
+Example:
+$ %s debug decode-store staking 2114E8D7... 0A34636F736D6F7376616C6F706572...
+			`, version.AppName),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			storeName := args[0]
+
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid hex key: %w", err)
+			}
+
+			if len(key) == 0 {
+				return fmt.Errorf("empty key")
+			}
+
+			decoded, err := decodeStoreKey(decoders, storeName, key)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Store: %s\n", storeName)
+			cmd.Printf("Key (hex): %X\n", key)
+			cmd.Printf("Key (string): %s\n", strconv.Quote(string(key)))
+			cmd.Printf("Key prefix: %s\n", decoded.Prefix)
+			for _, field := range decoded.Fields {
+				cmd.Printf("  %s: %s\n", field.Name, field.Value)
+			}
+
+			if len(args) < 3 {
+				return nil
+			}
+
+			value, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid hex value: %w", err)
+			}
+
+			if decoded.Value == nil {
+				cmd.Printf("Value (hex): %X\n", value)
+				cmd.Printf("Value (string): %s\n", strconv.Quote(string(value)))
+				return nil
+			}
+
+			if err := cdc.UnmarshalBinaryBare(value, decoded.Value); err != nil {
+				return fmt.Errorf("cannot decode the value as %T: %w", decoded.Value, err)
+			}
+
+			bz, err := cdc.MarshalJSON(decoded.Value)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Value:\n%s\n", bz)
+			return nil
+		},
+	}
+}
+
+// decodeStoreKey decodes the key of an entry of the store with its store key
+// decoder.
+func decodeStoreKey(decoders sdk.StoreKeyDecoderRegistry, storeName string, key []byte) (sdk.DecodedStoreKey, error) {
+	decoder, ok := decoders[storeName]
+	if !ok {
+		storeNames := make([]string, 0, len(decoders))
+		for name := range decoders {
+			storeNames = append(storeNames, name)
+		}
+
+		sort.Strings(storeNames)
+
+		return sdk.DecodedStoreKey{}, fmt.Errorf("no store key decoder for store %s, expected one of: %s", storeName, strings.Join(storeNames, ", "))
+	}
+
+	decoded, err := decoder(key)
+	if err != nil {
+		return sdk.DecodedStoreKey{}, fmt.Errorf("cannot decode the key of store %s: %w", storeName, err)
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,74 @@
+package debug
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func testStoreKeyDecoders() sdk.StoreKeyDecoderRegistry {
+	return sdk.StoreKeyDecoderRegistry{
+		"foo": func(key []byte) (sdk.DecodedStoreKey, error) {
+			switch key[0] {
+			case 0x01:
+				return sdk.NewStoreKeyParser("Balances", key[1:], &sdk.Coin{}).AccAddress("address").String("denom").Decode()
+			case 0x02:
+				return sdk.NewStoreKeyParser("Names", key[1:], nil).String("name").Decode()
+			default:
+				return sdk.DecodedStoreKey{}, fmt.Errorf("unknown foo store key %X", key)
+			}
+		},
+	}
+}
+
+func TestDecodeStoreCmd(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	addr := sdk.AccAddress("addr________________")
+	balanceKey := fmt.Sprintf("01%02X%X%X", len(addr), addr, "stake")
+	coinBz := cdc.MustMarshalBinaryBare(&sdk.Coin{Denom: "stake", Amount: sdk.NewInt(10)})
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expOut string
+		expErr bool
+	}{
+		{"key only", []string{"foo", balanceKey}, fmt.Sprintf("Key prefix: Balances\n  address: %s\n  denom: stake\n", addr), false},
+		{"proto value", []string{"foo", balanceKey, fmt.Sprintf("%X", coinBz)}, "Value:\n{\"denom\":\"stake\",\"amount\":\"10\"}\n", false},
+		{"raw value", []string{"foo", "0261", "76616c7565"}, "Key prefix: Names\n  name: a\nValue (hex): 76616C7565\nValue (string): \"value\"\n", false},
+		{"invalid proto value", []string{"foo", balanceKey, "ffff"}, "", true},
+		{"invalid key fields", []string{"foo", "01ff61"}, "", true},
+		{"unknown key", []string{"foo", "0361"}, "", true},
+		{"unknown store", []string{"bar", "0261"}, "", true},
+		{"invalid key", []string{"foo", "xyz"}, "", true},
+		{"empty key", []string{"foo", ""}, "", true},
+		{"invalid value", []string{"foo", "0261", "xyz"}, "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := DecodeStoreCmd(cdc, testStoreKeyDecoders())
+			out := new(bytes.Buffer)
+			cmd.SetOut(out)
+			cmd.SetErr(new(bytes.Buffer))
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Contains(t, out.String(), tc.expOut)
+		})
+	}
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -75,6 +77,14 @@ func (s *IntegrationTestSuite) TestGRPCQuery() {
 	)
 	blockHeight = header.Get(grpctypes.GRPCBlockHeightHeader)
 	s.Require().Equal([]string{"1"}, blockHeight)
+
+	// failed queries carry the gRPC code of their error
+	_, err = bankClient.Balance(
+		context.Background(),
+		&banktypes.QueryBalanceRequest{Address: "invalid", Denom: denom},
+	)
+	s.Require().Error(err)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
 }
 
 func TestIntegrationTestSuite(t *testing.T) {
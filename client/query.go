@@ -10,9 +10,12 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // GetNode returns an RPC client. If the context's client is not defined, an
@@ -84,7 +87,11 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 	}
 
 	if !result.Response.IsOK() {
-		return abci.ResponseQuery{}, errors.New(result.Response.Log)
+		return abci.ResponseQuery{}, queryError{
+			codespace: result.Response.Codespace,
+			code:      result.Response.Code,
+			log:       result.Response.Log,
+		}
 	}
 
 	// data from trusted node or subspace query doesn't need verification
@@ -95,6 +102,39 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 	return result.Response, nil
 }
 
+// queryError is the error of a failed ABCI query. Its message is the log of
+// the query, and its gRPC status is derived from the code of the query, so that
+// callers can tell invalid requests and missing entities from other failures
+// with status.Code.
+type queryError struct {
+	codespace string
+	code      uint32
+	log       string
+}
+
+// Error implements the error interface.
+func (e queryError) Error() string {
+	return e.log
+}
+
+// GRPCStatus returns the gRPC status of the query error.
+func (e queryError) GRPCStatus() *status.Status {
+	code := codes.Unknown
+
+	if e.codespace == sdkerrors.RootCodespace {
+		switch e.code {
+		case sdkerrors.ErrInvalidRequest.ABCICode(), sdkerrors.ErrInvalidAddress.ABCICode():
+			code = codes.InvalidArgument
+		case sdkerrors.ErrKeyNotFound.ABCICode(), sdkerrors.ErrNotFound.ABCICode():
+			code = codes.NotFound
+		case sdkerrors.ErrUnauthorized.ABCICode():
+			code = codes.Unauthenticated
+		}
+	}
+
+	return status.New(code, e.log)
+}
+
 // query performs a query to a Tendermint node with the provided store name
 // and path. It returns the result and height of the query upon success
 // or an error if the query fails.
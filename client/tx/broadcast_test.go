@@ -0,0 +1,122 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) clientCtx() client.Context {
+	val := s.network.Validators[0]
+
+	return val.ClientCtx.
+		WithFromAddress(val.Address).
+		WithFromName(val.Moniker).
+		WithBroadcastMode(flags.BroadcastSync).
+		WithSignModeStr(flags.SignModeDirect)
+}
+
+func (s *IntegrationTestSuite) newFactory(clientCtx client.Context) tx.Factory {
+	return tx.NewFactory(clientCtx).WithFees(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String())
+}
+
+func (s *IntegrationTestSuite) sendMsg() sdk.Msg {
+	val := s.network.Validators[0]
+
+	return banktypes.NewMsgSend(val.Address, val.Address, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)))
+}
+
+func (s *IntegrationTestSuite) TestSignAndBroadcastTx() {
+	clientCtx := s.clientCtx()
+	txf := s.newFactory(clientCtx).
+		WithSimulateAndExecute(true).
+		WithGasAdjustment(1.5).
+		WithMemo("memo")
+
+	res, err := tx.SignAndBroadcastTx(clientCtx, txf, s.sendMsg())
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), res.Code, res.RawLog)
+
+	// a transaction signed with a wrong sequence is rejected without retries
+	_, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
+	s.Require().NoError(err)
+
+	wrongTxf := s.newFactory(clientCtx).WithSequence(seq + 100)
+	res, err = tx.SignAndBroadcastTx(clientCtx, wrongTxf, s.sendMsg())
+	s.Require().NoError(err)
+	s.Require().Equal(sdkerrors.ErrWrongSequence.ABCICode(), res.Code)
+
+	// and signed again with the expected sequence otherwise
+	res, err = tx.SignAndBroadcastTx(clientCtx, wrongTxf.WithMaxRetries(1), s.sendMsg())
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), res.Code, res.RawLog)
+}
+
+func (s *IntegrationTestSuite) TestSignAndBroadcastTxSimulationRetry() {
+	clientCtx := s.clientCtx()
+	_, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
+	s.Require().NoError(err)
+
+	// the simulation is rejected as well when the sequence is wrong
+	txf := s.newFactory(clientCtx).
+		WithSimulateAndExecute(true).
+		WithSequence(seq + 100).
+		WithMaxRetries(1)
+
+	res, err := tx.SignAndBroadcastTx(clientCtx, txf, s.sendMsg())
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), res.Code, res.RawLog)
+}
+
+func TestNewFactory(t *testing.T) {
+	clientCtx := client.Context{}.
+		WithChainID("test-chain").
+		WithTxConfig(NewTestTxConfig()).
+		WithSignModeStr(flags.SignModeLegacyAminoJSON)
+
+	txf := tx.NewFactory(clientCtx)
+	require.Equal(t, "test-chain", txf.ChainID())
+	require.Equal(t, uint64(flags.DefaultGasLimit), txf.Gas())
+	require.Equal(t, flags.DefaultGasAdjustment, txf.GasAdjustment())
+	require.Equal(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, txf.SignMode())
+	require.Equal(t, uint64(0), txf.MaxRetries())
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -27,19 +27,28 @@ type Factory struct {
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	maxRetries         uint64
+}
+
+// NewFactory creates a new Factory from the given client context, using the
+// default gas limit and gas adjustment. It is meant for Go clients building
+// transactions programmatically, which then configure the Factory through its
+// With* methods.
+func NewFactory(clientCtx client.Context) Factory {
+	return Factory{
+		txConfig:         clientCtx.TxConfig,
+		accountRetriever: clientCtx.AccountRetriever,
+		keybase:          clientCtx.Keyring,
+		chainID:          clientCtx.ChainID,
+		gas:              flags.DefaultGasLimit,
+		gasAdjustment:    flags.DefaultGasAdjustment,
+		signMode:         parseSignMode(clientCtx.SignModeStr),
+	}
 }
 
 // NewFactoryCLI creates a new Factory.
 func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
-	signModeStr := clientCtx.SignModeStr
-
-	signMode := signing.SignMode_SIGN_MODE_UNSPECIFIED
-	switch signModeStr {
-	case flags.SignModeDirect:
-		signMode = signing.SignMode_SIGN_MODE_DIRECT
-	case flags.SignModeLegacyAminoJSON:
-		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
-	}
+	signMode := parseSignMode(clientCtx.SignModeStr)
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
 	accSeq, _ := flagSet.GetUint64(flags.FlagSequence)
@@ -85,6 +94,7 @@ func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) MaxRetries() uint64                        { return f.maxRetries }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -189,3 +199,26 @@ func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	f.timeoutHeight = height
 	return f
 }
+
+// WithMaxRetries returns a copy of the Factory with an updated maximum number
+// of times a transaction is signed again and rebroadcast by SignAndBroadcastTx
+// after an account sequence mismatch.
+func (f Factory) WithMaxRetries(retries uint64) Factory {
+	f.maxRetries = retries
+	return f
+}
+
+// parseSignMode returns the sign mode of the given sign mode flag value, or
+// SIGN_MODE_UNSPECIFIED to use the default sign mode of the TxConfig.
+func parseSignMode(signModeStr string) signing.SignMode {
+	switch signModeStr {
+	case flags.SignModeDirect:
+		return signing.SignMode_SIGN_MODE_DIRECT
+
+	case flags.SignModeLegacyAminoJSON:
+		return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+	default:
+		return signing.SignMode_SIGN_MODE_UNSPECIFIED
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"regexp"
+	"strconv"
 
 	"github.com/spf13/pflag"
 
@@ -117,24 +119,109 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		}
 	}
 
-	tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
-	err = Sign(txf, clientCtx.GetFromName(), tx, true)
+	res, err := signAndBroadcastTx(clientCtx, txf, tx)
 	if err != nil {
 		return err
 	}
 
+	return clientCtx.PrintProto(res)
+}
+
+// SignAndBroadcastTx generates, signs and broadcasts a transaction with the
+// given set of messages on behalf of the from address of the client context,
+// without any user interaction, and returns the broadcast response. The account
+// number and sequence are queried if not set and the gas is simulated if
+// requested, as in BroadcastTx. It is meant for Go clients such as relayers and
+// bots.
+//
+// If the transaction is rejected because of an account sequence mismatch, e.g.
+// because another transaction of the account was broadcast concurrently, it is
+// signed again with the sequence expected by the node and rebroadcast, up to
+// the maximum number of retries of the Factory. Note that sequence mismatches
+// are only detected for transactions signed in SIGN_MODE_DIRECT, as they are
+// reported as signature verification failures otherwise.
+func SignAndBroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txf, err := PrepareFactory(clientCtx, txf)
+	if err != nil {
+		return nil, err
+	}
+
+	for retries := uint64(0); ; retries++ {
+		res, err := simulateSignAndBroadcastTx(clientCtx, txf, msgs...)
+
+		var log string
+		switch {
+		case err != nil:
+			log = err.Error()
+
+		case res.Codespace == sdkerrors.RootCodespace && res.Code == sdkerrors.ErrWrongSequence.ABCICode():
+			log = res.RawLog
+
+		default:
+			return res, nil
+		}
+
+		sequence, ok := parseExpectedSequence(log)
+		if !ok || retries >= txf.MaxRetries() {
+			return res, err
+		}
+
+		txf = txf.WithSequence(sequence)
+	}
+}
+
+func simulateSignAndBroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := CalculateGas(clientCtx.QueryWithData, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	tx, err := BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return signAndBroadcastTx(clientCtx, txf, tx)
+}
+
+func signAndBroadcastTx(clientCtx client.Context, txf Factory, tx client.TxBuilder) (*sdk.TxResponse, error) {
+	tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+	err := Sign(txf, clientCtx.GetFromName(), tx, true)
+	if err != nil {
+		return nil, err
+	}
+
 	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// broadcast to a Tendermint node
-	res, err := clientCtx.BroadcastTx(txBytes)
+	return clientCtx.BroadcastTx(txBytes)
+}
+
+// sequenceMismatchRegexp matches the error reported by the ante handler for
+// a transaction signed with an account sequence other than the expected one.
+var sequenceMismatchRegexp = regexp.MustCompile(`account sequence mismatch, expected (\d+), got \d+`)
+
+// parseExpectedSequence returns the account sequence expected by the node
+// according to the given sequence mismatch error log.
+func parseExpectedSequence(log string) (uint64, bool) {
+	matches := sequenceMismatchRegexp.FindStringSubmatch(log)
+	if matches == nil {
+		return 0, false
+	}
+
+	sequence, err := strconv.ParseUint(matches[1], 10, 64)
 	if err != nil {
-		return err
+		return 0, false
 	}
 
-	return clientCtx.PrintProto(res)
+	return sequence, true
 }
 
 // WriteGeneratedTxResponse writes a generated unsigned transaction to the
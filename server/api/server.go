@@ -11,6 +11,7 @@ import (
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
 
@@ -30,9 +31,10 @@ type Server struct {
 	GRPCGatewayRouter *runtime.ServeMux
 	ClientCtx         client.Context
 
-	logger   log.Logger
-	metrics  *telemetry.Metrics
-	listener net.Listener
+	logger     log.Logger
+	metrics    *telemetry.Metrics
+	listener   net.Listener
+	grpcWebSrv *grpcweb.WrappedGrpcServer
 }
 
 // CustomGRPCHeaderMatcher for mapping request headers to
@@ -78,6 +80,13 @@ func New(clientCtx client.Context, logger log.Logger) *Server {
 	}
 }
 
+// SetGRPCWebServer makes the API server serve gRPC-Web requests, including
+// their CORS preflight requests, with the given gRPC-Web server. It must be
+// called before the API server is started.
+func (s *Server) SetGRPCWebServer(grpcWebSrv *grpcweb.WrappedGrpcServer) {
+	s.grpcWebSrv = grpcWebSrv
+}
+
 // Start starts the API server. Internally, the API server leverages Tendermint's
 // JSON RPC server. Configuration options are provided via config.APIConfig
 // and are delegated to the Tendermint JSON RPC server. The process is
@@ -111,11 +120,28 @@ func (s *Server) Start(cfg config.Config) error {
 
 	if cfg.API.EnableUnsafeCORS {
 		allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
-		return tmrpcserver.Serve(s.listener, allowAllCORS(h), s.logger, tmCfg)
+		h = allowAllCORS(h)
+	}
+
+	if s.grpcWebSrv != nil {
+		h = s.grpcWebHandler(h)
 	}
 
 	s.logger.Info("starting API server...")
-	return tmrpcserver.Serve(s.listener, s.Router, s.logger, tmCfg)
+	return tmrpcserver.Serve(s.listener, h, s.logger, tmCfg)
+}
+
+// grpcWebHandler routes gRPC-Web requests to the gRPC-Web server and all the
+// other requests to next.
+func (s *Server) grpcWebHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.grpcWebSrv.IsGrpcWebRequest(r) || s.grpcWebSrv.IsAcceptableGrpcCorsRequest(r) {
+			s.grpcWebSrv.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
 }
 
 // Close closes the API server.
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// ChainRegistry defines the chain-registry compatible description of a chain
+// emitted by the chain-registry command.
+type ChainRegistry struct {
+	ChainName    string                `json:"chain_name"`
+	ChainID      string                `json:"chain_id"`
+	Bech32Prefix string                `json:"bech32_prefix"`
+	DaemonName   string                `json:"daemon_name"`
+	Genesis      ChainRegistryGenesis  `json:"genesis"`
+	Codebase     ChainRegistryCodebase `json:"codebase"`
+	Fees         ChainRegistryFees     `json:"fees"`
+	APIs         ChainRegistryAPIs     `json:"apis"`
+}
+
+// ChainRegistryGenesis defines the genesis information of a chain.
+type ChainRegistryGenesis struct {
+	GenesisTime string `json:"genesis_time"`
+	Hash        string `json:"hash"`
+}
+
+// ChainRegistryCodebase defines the binary information of a chain.
+type ChainRegistryCodebase struct {
+	RecommendedVersion string `json:"recommended_version"`
+	GitCommit          string `json:"git_commit"`
+}
+
+// ChainRegistryFees defines the fee tokens accepted by the node.
+type ChainRegistryFees struct {
+	FeeTokens []ChainRegistryFeeToken `json:"fee_tokens"`
+}
+
+// ChainRegistryFeeToken defines a fee token and its minimum gas price.
+type ChainRegistryFeeToken struct {
+	Denom            string `json:"denom"`
+	FixedMinGasPrice string `json:"fixed_min_gas_price"`
+}
+
+// ChainRegistryAPIs defines the endpoints exposed by the node.
+type ChainRegistryAPIs struct {
+	RPC  []ChainRegistryEndpoint `json:"rpc"`
+	REST []ChainRegistryEndpoint `json:"rest"`
+	GRPC []ChainRegistryEndpoint `json:"grpc"`
+}
+
+// ChainRegistryEndpoint defines a single API endpoint.
+type ChainRegistryEndpoint struct {
+	Address string `json:"address"`
+}
+
+// ChainRegistryCmd returns a command that emits a chain-registry compatible
+// JSON document describing the chain from the node's configuration and
+// genesis, for wallet and relayer auto-configuration.
+func ChainRegistryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "chain-registry",
+		Short: "Output a chain-registry compatible JSON description of the chain served by this node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+			appCfg := config.GetConfig(serverCtx.Viper)
+
+			genBz, err := ioutil.ReadFile(cfg.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			genDoc, err := tmtypes.GenesisDocFromJSON(genBz)
+			if err != nil {
+				return fmt.Errorf("failed to read genesis doc from file: %w", err)
+			}
+
+			genHash := sha256.Sum256(genBz)
+			versionInfo := version.NewInfo()
+
+			registry := ChainRegistry{
+				ChainName:    versionInfo.Name,
+				ChainID:      genDoc.ChainID,
+				Bech32Prefix: sdk.GetConfig().GetBech32AccountAddrPrefix(),
+				DaemonName:   versionInfo.AppName,
+				Genesis: ChainRegistryGenesis{
+					GenesisTime: genDoc.GenesisTime.UTC().Format(time.RFC3339),
+					Hash:        hex.EncodeToString(genHash[:]),
+				},
+				Codebase: ChainRegistryCodebase{
+					RecommendedVersion: versionInfo.Version,
+					GitCommit:          versionInfo.GitCommit,
+				},
+				Fees: ChainRegistryFees{
+					FeeTokens: []ChainRegistryFeeToken{},
+				},
+				APIs: ChainRegistryAPIs{
+					RPC:  []ChainRegistryEndpoint{{Address: cfg.RPC.ListenAddress}},
+					REST: []ChainRegistryEndpoint{},
+					GRPC: []ChainRegistryEndpoint{},
+				},
+			}
+
+			for _, gasPrice := range appCfg.GetMinGasPrices() {
+				registry.Fees.FeeTokens = append(registry.Fees.FeeTokens, ChainRegistryFeeToken{
+					Denom:            gasPrice.Denom,
+					FixedMinGasPrice: gasPrice.Amount.String(),
+				})
+			}
+
+			if appCfg.API.Enable {
+				registry.APIs.REST = append(registry.APIs.REST, ChainRegistryEndpoint{Address: appCfg.API.Address})
+			}
+
+			if appCfg.GRPC.Enable {
+				registry.APIs.GRPC = append(registry.APIs.GRPC, ChainRegistryEndpoint{Address: appCfg.GRPC.Address})
+			}
+
+			bz, err := json.MarshalIndent(registry, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/simapp"
+)
+
+func TestChainRegistryCmd(t *testing.T) {
+	tempDir := t.TempDir()
+	require.NoError(t, createConfigFolder(tempDir))
+
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.RootDir = tempDir
+
+	appCfg := config.DefaultConfig()
+	appCfg.MinGasPrices = "0.025stake;0.5photon"
+	appCfg.API.Enable = true
+	appCfg.API.Address = "tcp://0.0.0.0:1317"
+	appCfg.GRPC.Enable = false
+
+	appCfgPath := filepath.Join(tempDir, "config", "app.toml")
+	config.WriteConfigFile(appCfgPath, appCfg)
+	serverCtx.Viper.SetConfigFile(appCfgPath)
+	require.NoError(t, serverCtx.Viper.ReadInConfig())
+
+	genDoc := newDefaultGenesisDoc(simapp.MakeTestEncodingConfig().Marshaler)
+	genDoc.GenesisTime = time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, saveGenesisFile(genDoc, serverCtx.Config.GenesisFile()))
+
+	genBz, err := ioutil.ReadFile(serverCtx.Config.GenesisFile())
+	require.NoError(t, err)
+	genHash := sha256.Sum256(genBz)
+
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+	cmd := server.ChainRegistryCmd()
+	output := &bytes.Buffer{}
+	cmd.SetOut(output)
+	cmd.SetErr(ioutil.Discard)
+	cmd.SetArgs([]string{})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	var registry server.ChainRegistry
+	require.NoError(t, json.Unmarshal(output.Bytes(), &registry))
+
+	require.Equal(t, "theChainId", registry.ChainID)
+	require.Equal(t, "cosmos", registry.Bech32Prefix)
+	require.Equal(t, "2021-03-01T12:00:00Z", registry.Genesis.GenesisTime)
+	require.Equal(t, hex.EncodeToString(genHash[:]), registry.Genesis.Hash)
+	require.Equal(t, []server.ChainRegistryFeeToken{
+		{Denom: "stake", FixedMinGasPrice: "0.025000000000000000"},
+		{Denom: "photon", FixedMinGasPrice: "0.500000000000000000"},
+	}, registry.Fees.FeeTokens)
+	require.Equal(t, []server.ChainRegistryEndpoint{{Address: serverCtx.Config.RPC.ListenAddress}}, registry.APIs.RPC)
+	require.Equal(t, []server.ChainRegistryEndpoint{{Address: "tcp://0.0.0.0:1317"}}, registry.APIs.REST)
+	require.Empty(t, registry.APIs.GRPC)
+}
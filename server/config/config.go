@@ -66,8 +66,38 @@ type BaseConfig struct {
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
-	// which informs Tendermint what to index. If empty, all events will be indexed.
+	// or {eventType}.* to select all the attributes of an event type, which
+	// informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
+
+	// AppDBBackend defines the database backend of the application and
+	// snapshots databases. If empty, the backend selected at compile time
+	// (goleveldb by default) is used.
+	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// CommitBatching enables writing all the changes of a commit to the
+	// application database in a single batch.
+	CommitBatching bool `mapstructure:"commit-batching"`
+
+	// CommitAsyncFsync disables waiting for the commit batch to be synced to
+	// disk when commit batching is enabled.
+	CommitAsyncFsync bool `mapstructure:"commit-async-fsync"`
+
+	// IAVLFastIndex enables an in-memory index of the latest version of the
+	// IAVL stores, serving queries without traversing the trees.
+	IAVLFastIndex bool `mapstructure:"iavl-fast-index"`
+
+	// ErrorStackTraces enables the capture of stack traces by sdkerrors.Wrap,
+	// which are logged along with failed transactions at debug level.
+	ErrorStackTraces bool `mapstructure:"error-stack-traces"`
+
+	// AddrCache enables the caches of the bech32 encoding and decoding of
+	// addresses, see sdk.SetAddrCacheEnabled.
+	AddrCache bool `mapstructure:"addr-cache"`
+
+	// EventVerbosity defines the verbosity of the events emitted by the modules,
+	// see sdk.ParseEventVerbosityConfig.
+	EventVerbosity string `mapstructure:"event-verbosity"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -140,6 +170,36 @@ type GRPCWebConfig struct {
 
 	// Address defines the gRPC-web server to listen on
 	Address string `mapstructure:"address"`
+
+	// CORSAllowedOrigins defines the origins allowed to send cross-origin
+	// gRPC-web requests, "*" allowing all origins.
+	CORSAllowedOrigins []string `mapstructure:"cors-allowed-origins"`
+}
+
+// AppMempoolConfig defines the application-side mempool filtering configuration.
+type AppMempoolConfig struct {
+	// MaxTxsPerSigner limits the number of pending transactions per signer.
+	// 0 disables the limit.
+	MaxTxsPerSigner uint64 `mapstructure:"max-txs-per-signer"`
+
+	// MsgTypeLimits limits the number of pending transactions per signer
+	// containing a given message type, in the form {msgType}={limit}.
+	MsgTypeLimits []string `mapstructure:"msg-type-limits"`
+}
+
+// SimulateTraceConfig defines the configuration of the store traces of
+// simulated txs.
+type SimulateTraceConfig struct {
+	// Enable defines if the /app/simulate/trace query, recording the store
+	// operations of simulated txs, should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// MaxGas defines the maximum gas consumed by the store operations of a
+	// traced tx.
+	MaxGas uint64 `mapstructure:"max-gas"`
+
+	// MaxSize defines the maximum size of the store trace of a tx, in bytes.
+	MaxSize uint64 `mapstructure:"max-size"`
 }
 
 // StateSyncConfig defines the state sync snapshot configuration.
@@ -158,12 +218,17 @@ type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
-	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
-	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
-	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Telemetry  telemetry.Config `mapstructure:"telemetry"`
+	API        APIConfig        `mapstructure:"api"`
+	GRPC       GRPCConfig       `mapstructure:"grpc"`
+	Rosetta    RosettaConfig    `mapstructure:"rosetta"`
+	GRPCWeb    GRPCWebConfig    `mapstructure:"grpc-web"`
+	StateSync  StateSyncConfig  `mapstructure:"state-sync"`
+	AppMempool AppMempoolConfig `mapstructure:"app-mempool"`
+
+	// SimulateTrace defines the configuration of the store traces of
+	// simulated txs
+	SimulateTrace SimulateTraceConfig `mapstructure:"simulate-trace"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -205,6 +270,9 @@ func DefaultConfig() *Config {
 			PruningInterval:   "0",
 			MinRetainBlocks:   0,
 			IndexEvents:       make([]string, 0),
+			ErrorStackTraces:  true,
+			AddrCache:         true,
+			EventVerbosity:    sdk.EventVerbosityFull.String(),
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -238,6 +306,15 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		AppMempool: AppMempoolConfig{
+			MaxTxsPerSigner: 0,
+			MsgTypeLimits:   make([]string, 0),
+		},
+		SimulateTrace: SimulateTraceConfig{
+			Enable:  false,
+			MaxGas:  10000000,
+			MaxSize: 1048576,
+		},
 	}
 }
 
@@ -264,6 +341,13 @@ func GetConfig(v *viper.Viper) Config {
 			HaltTime:          v.GetUint64("halt-time"),
 			IndexEvents:       v.GetStringSlice("index-events"),
 			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+			AppDBBackend:      v.GetString("app-db-backend"),
+			CommitBatching:    v.GetBool("commit-batching"),
+			CommitAsyncFsync:  v.GetBool("commit-async-fsync"),
+			IAVLFastIndex:     v.GetBool("iavl-fast-index"),
+			ErrorStackTraces:  v.GetBool("error-stack-traces"),
+			AddrCache:         v.GetBool("addr-cache"),
+			EventVerbosity:    v.GetString("event-verbosity"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -297,12 +381,22 @@ func GetConfig(v *viper.Viper) Config {
 			Address: v.GetString("grpc.address"),
 		},
 		GRPCWeb: GRPCWebConfig{
-			Enable:  v.GetBool("grpc-web.enable"),
-			Address: v.GetString("grpc-web.address"),
+			Enable:             v.GetBool("grpc-web.enable"),
+			Address:            v.GetString("grpc-web.address"),
+			CORSAllowedOrigins: v.GetStringSlice("grpc-web.cors-allowed-origins"),
 		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		AppMempool: AppMempoolConfig{
+			MaxTxsPerSigner: v.GetUint64("app-mempool.max-txs-per-signer"),
+			MsgTypeLimits:   v.GetStringSlice("app-mempool.msg-type-limits"),
+		},
+		SimulateTrace: SimulateTraceConfig{
+			Enable:  v.GetBool("simulate-trace.enable"),
+			MaxGas:  v.GetUint64("simulate-trace.max-gas"),
+			MaxSize: v.GetUint64("simulate-trace.max-size"),
+		},
 	}
 }
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +22,39 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestMigrateConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfgFile := filepath.Join(dir, "app.toml")
+	legacy := `minimum-gas-prices = "0.025stake"
+halt-height = 100
+unknown-key = "foo"
+
+[api]
+enable = true
+legacy-key = 1
+`
+	require.NoError(t, ioutil.WriteFile(cfgFile, []byte(legacy), 0644))
+
+	deprecated, err := MigrateConfigFile(cfgFile)
+	require.NoError(t, err)
+	require.Equal(t, []string{"api.legacy-key", "unknown-key"}, deprecated)
+
+	backup, err := ioutil.ReadFile(cfgFile + ".bak")
+	require.NoError(t, err)
+	require.Equal(t, legacy, string(backup))
+
+	v := viper.New()
+	v.SetConfigFile(cfgFile)
+	require.NoError(t, v.ReadInConfig())
+
+	cfg := GetConfig(v)
+	require.Equal(t, "0.025stake", cfg.MinGasPrices)
+	require.Equal(t, uint64(100), cfg.HaltHeight)
+	require.True(t, cfg.API.Enable)
+	require.Equal(t, DefaultConfig().GRPC.Address, cfg.GRPC.Address)
+	require.False(t, v.IsSet("unknown-key"))
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/spf13/viper"
+)
+
+// MigrateConfigFile upgrades the application configuration file found at
+// configFilePath to the current configuration schema. Values customized by the
+// operator for keys that are still part of the schema are preserved, new keys
+// are populated with their default value and keys that are no longer part of
+// the schema are dropped and returned as deprecated. A copy of the original
+// file is written to configFilePath with a ".bak" suffix.
+//
+// Only the application configuration (app.toml) is migrated, the client
+// configuration (client.toml) and the Tendermint configuration (config.toml)
+// are left untouched.
+func MigrateConfigFile(configFilePath string) (deprecated []string, err error) {
+	original, err := ioutil.ReadFile(configFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(bytes.NewReader(original)); err != nil {
+		return nil, fmt.Errorf("failed to read in %s: %w", configFilePath, err)
+	}
+
+	conf, err := ParseConfig(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", configFilePath, err)
+	}
+
+	deprecated, err = DeprecatedConfigKeys(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ioutil.WriteFile(configFilePath+".bak", original, 0644); err != nil {
+		return nil, err
+	}
+
+	if err := writeConfigFile(configFilePath, conf); err != nil {
+		return nil, err
+	}
+
+	return deprecated, nil
+}
+
+// DeprecatedConfigKeys returns the sorted list of keys set in v which are not
+// part of the current application configuration schema.
+func DeprecatedConfigKeys(v *viper.Viper) ([]string, error) {
+	var buffer bytes.Buffer
+	if err := configTemplate.Execute(&buffer, DefaultConfig()); err != nil {
+		return nil, err
+	}
+
+	defaults := viper.New()
+	defaults.SetConfigType("toml")
+	if err := defaults.ReadConfig(&buffer); err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool)
+	for _, key := range defaults.AllKeys() {
+		known[key] = true
+	}
+
+	var deprecated []string
+	for _, key := range v.AllKeys() {
+		if !known[key] {
+			deprecated = append(deprecated, key)
+		}
+	}
+
+	sort.Strings(deprecated)
+	return deprecated, nil
+}
@@ -2,10 +2,10 @@ package config
 
 import (
 	"bytes"
+	"io/ioutil"
 	"text/template"
 
 	"github.com/spf13/viper"
-	tmos "github.com/tendermint/tendermint/libs/os"
 )
 
 const defaultConfigTemplate = `# This is a TOML config file.
@@ -64,12 +64,67 @@ min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
-# which informs Tendermint what to index. If empty, all events will be indexed.
+# which informs Tendermint what to index. All the attributes of an event type
+# are selected with {eventType}.*. If empty, all events will be indexed.
+#
+# Indexing only the events queried by clients (e.g. relayers) reduces the size
+# of the Tendermint tx index.
 #
 # Example:
-# ["message.sender", "message.recipient"]
+# ["message.sender", "message.recipient", "send_packet.*", "write_acknowledgement.*"]
 index-events = {{ .BaseConfig.IndexEvents }}
 
+# AppDBBackend defines the database backend of the application and snapshots
+# databases. If empty, the backend selected at compile time (goleveldb by
+# default) is used.
+#
+# Supported backends: goleveldb, cleveldb, rocksdb, boltdb, badgerdb. Backends
+# other than goleveldb require the binary to be built with the corresponding
+# build tag. Existing databases can be converted with the convert-db command.
+app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
+
+# CommitBatching enables writing all the changes of a commit (the changes of
+# every store and the commit metadata) to the application database in a single
+# batch, synced to disk, instead of one unsynced batch per store. This reduces
+# commit latency on slow disks.
+commit-batching = {{ .BaseConfig.CommitBatching }}
+
+# CommitAsyncFsync disables waiting for the commit batch to be synced to disk
+# when commit-batching is enabled. Commits are faster, but a crash of the
+# operating system may lose the latest committed blocks, which are then
+# replayed from Tendermint on restart.
+commit-async-fsync = {{ .BaseConfig.CommitAsyncFsync }}
+
+# IAVLFastIndex enables an in-memory index of the leaves of the latest version
+# of the IAVL stores, built in the background on startup. Queries of the latest
+# version are then served from the index without traversing the trees, which
+# speeds up query-heavy nodes (e.g. serving relayers) at the cost of holding
+# the latest state in memory.
+iavl-fast-index = {{ .BaseConfig.IAVLFastIndex }}
+
+# ErrorStackTraces enables capturing a stack trace when an error is wrapped
+# (sdkerrors.Wrap), at a small cost on every wrap. Stack traces are logged at
+# debug level along with failed transactions, and are only returned in the ABCI
+# log of responses when the node runs with --trace.
+error-stack-traces = {{ .BaseConfig.ErrorStackTraces }}
+
+# AddrCache enables the in-memory caches of the bech32 encoding and decoding of
+# addresses, which save the bech32 conversions of frequently used addresses at
+# the cost of holding them in memory.
+addr-cache = {{ .BaseConfig.AddrCache }}
+
+# EventVerbosity defines the verbosity of the events emitted by the modules:
+# full, standard or minimal. Events are not part of the consensus state, so a
+# node may emit, and index, fewer of them to save storage:
+#
+# full: all the events, including those duplicating the information of others
+# standard: the events describing the outcome of messages, e.g. transfers
+# minimal: only the events clients need to find txs, e.g. their sender
+#
+# The verbosity of a module is set with "<module>=<verbosity>", separated by
+# commas from the default verbosity, e.g. "standard,bank=minimal".
+event-verbosity = "{{ .BaseConfig.EventVerbosity }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -184,6 +239,12 @@ enable = {{ .GRPCWeb.Enable }}
 # Address defines the gRPC-web server address to bind to.
 address = "{{ .GRPCWeb.Address }}"
 
+# CORSAllowedOrigins defines the origins allowed to send cross-origin gRPC-web
+# requests, e.g. browser wallets. Use ["*"] to allow all origins.
+#
+# When the API server is enabled, it also serves gRPC-web requests.
+cors-allowed-origins = [{{ range $i, $o := .GRPCWeb.CORSAllowedOrigins }}{{ if $i }}, {{ end }}"{{ $o }}"{{ end }}]
+
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
@@ -198,6 +259,46 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                       App Mempool Configuration                         ###
+###############################################################################
+
+[app-mempool]
+
+# MaxTxsPerSigner limits the number of pending transactions a single signer
+# can have in the mempool. 0 disables the limit.
+#
+# Note: pending transactions are counted from the last committed block, hence
+# the limits are only accurate if Tendermint's mempool recheck is enabled.
+max-txs-per-signer = {{ .AppMempool.MaxTxsPerSigner }}
+
+# MsgTypeLimits limits the number of pending transactions a single signer can
+# have in the mempool containing a given message type.
+#
+# Example:
+# ["/cosmos.bank.v1beta1.Msg/Send=10"]
+msg-type-limits = [{{ range $i, $l := .AppMempool.MsgTypeLimits }}{{ if $i }}, {{ end }}"{{ $l }}"{{ end }}]
+
+###############################################################################
+###                      Simulate Trace Configuration                       ###
+###############################################################################
+
+[simulate-trace]
+
+# Enable defines if the /app/simulate/trace ABCI query should be enabled. It
+# simulates a tx and returns every operation of the tx on the KV stores, with
+# their keys decoded by the store key decoders of the modules. It is meant for
+# debugging, as traces are expensive to build: it should not be enabled on
+# public nodes.
+enable = {{ .SimulateTrace.Enable }}
+
+# MaxGas defines the maximum gas consumed by the store operations of a traced
+# tx, charged with the KV store gas costs.
+max-gas = {{ .SimulateTrace.MaxGas }}
+
+# MaxSize defines the maximum size of the store trace of a tx, in bytes.
+max-size = {{ .SimulateTrace.MaxSize }}
 `
 
 var configTemplate *template.Template
@@ -224,11 +325,19 @@ func ParseConfig(v *viper.Viper) (*Config, error) {
 // WriteConfigFile renders config using the template and writes it to
 // configFilePath.
 func WriteConfigFile(configFilePath string, config *Config) {
+	if err := writeConfigFile(configFilePath, config); err != nil {
+		panic(err)
+	}
+}
+
+// writeConfigFile renders config using the template and writes it to
+// configFilePath, returning any rendering or write error.
+func writeConfigFile(configFilePath string, config *Config) error {
 	var buffer bytes.Buffer
 
 	if err := configTemplate.Execute(&buffer, config); err != nil {
-		panic(err)
+		return err
 	}
 
-	tmos.MustWriteFile(configFilePath, buffer.Bytes(), 0644)
+	return ioutil.WriteFile(configFilePath, buffer.Bytes(), 0644)
 }
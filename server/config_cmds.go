@@ -0,0 +1,58 @@
+package server
+
+// DONTCOVER
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// ConfigCmd returns the parent command of the node configuration commands.
+func ConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Node configuration subcommands",
+	}
+
+	cmd.AddCommand(MigrateConfigCmd())
+
+	return cmd
+}
+
+// MigrateConfigCmd returns a command that upgrades the app.toml configuration
+// file to the current schema while preserving the operator's customizations.
+func MigrateConfigCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the app.toml configuration file to the current schema",
+		Long: `Migrate the app.toml configuration file to the current schema.
+
+Values set in the existing file are preserved, keys introduced by the current
+release are added with their default value and keys that are no longer
+supported are removed and reported. The original file is kept next to the
+migrated one with a .bak suffix.
+
+Only app.toml is migrated: client.toml and Tendermint's config.toml are left
+untouched and must be updated by hand.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			appCfgFilePath := filepath.Join(serverCtx.Config.RootDir, "config", "app.toml")
+
+			deprecated, err := config.MigrateConfigFile(appCfgFilePath)
+			if err != nil {
+				return err
+			}
+
+			for _, key := range deprecated {
+				cmd.PrintErrf("WARNING: removed deprecated configuration key %q\n", key)
+			}
+
+			cmd.PrintErrf("Configuration file %q migrated to the current schema\n", appCfgFilePath)
+			return nil
+		},
+	}
+}
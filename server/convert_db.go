@@ -0,0 +1,123 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagOutputDir = "output-dir"
+	flagBatchSize = "batch-size"
+)
+
+// ConvertDBCmd returns a command copying the application database of a stopped
+// node into a new database of another backend.
+func ConvertDBCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-db [target-backend]",
+		Short: "Convert the application database to another database backend",
+		Long: `Copy the application database of a stopped node, stored with the backend configured
+through app-db-backend, into a new database of the target backend. The new database is written
+to '--output-dir' (defaulting to <home>/data.<target-backend>).
+
+Once converted, replace the application.db of the node's data directory by the new database and
+set app-db-backend to the target backend in app.toml. The Tendermint databases are not converted:
+their backend is configured through db_backend in config.toml.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			sourceBackend := GetAppDBBackend(serverCtx.Viper)
+			targetBackend := dbm.BackendType(args[0])
+			if sourceBackend == targetBackend {
+				return fmt.Errorf("the application database already uses the %s backend", targetBackend)
+			}
+
+			outputDir, _ := cmd.Flags().GetString(flagOutputDir)
+			if outputDir == "" {
+				outputDir = filepath.Join(config.RootDir, fmt.Sprintf("data.%s", targetBackend))
+			}
+
+			batchSize, _ := cmd.Flags().GetInt(flagBatchSize)
+			if batchSize <= 0 {
+				return fmt.Errorf("batch size must be positive: %d", batchSize)
+			}
+
+			source, err := openDB(config.RootDir, sourceBackend)
+			if err != nil {
+				return err
+			}
+			defer source.Close()
+
+			target, err := sdk.NewDB("application", targetBackend, outputDir)
+			if err != nil {
+				return err
+			}
+			defer target.Close()
+
+			count, err := copyDB(source, target, batchSize)
+			if err != nil {
+				return fmt.Errorf("failed to convert the application database: %w", err)
+			}
+
+			cmd.Printf("Copied %d keys from the %s application database to %s (%s)\n", count, sourceBackend, outputDir, targetBackend)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagOutputDir, "", "Directory to write the converted database to (defaults to <home>/data.<target-backend>)")
+	cmd.Flags().Int(flagBatchSize, 10000, "Number of keys written per batch")
+
+	return cmd
+}
+
+// copyDB copies all keys of source into target, writing them in batches of
+// batchSize keys, and returns the number of copied keys.
+func copyDB(source, target dbm.DB, batchSize int) (int, error) {
+	itr, err := source.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer itr.Close()
+
+	var count int
+
+	batch := target.NewBatch()
+	for ; itr.Valid(); itr.Next() {
+		if err := batch.Set(itr.Key(), itr.Value()); err != nil {
+			batch.Close()
+			return count, err
+		}
+
+		count++
+		if count%batchSize == 0 {
+			err := batch.Write()
+			batch.Close()
+			if err != nil {
+				return count, err
+			}
+
+			batch = target.NewBatch()
+		}
+	}
+
+	defer batch.Close()
+
+	if err := itr.Error(); err != nil {
+		return count, err
+	}
+
+	return count, batch.WriteSync()
+}
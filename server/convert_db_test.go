@@ -0,0 +1,35 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestCopyDB(t *testing.T) {
+	source := dbm.NewMemDB()
+	for i := 0; i < 25; i++ {
+		require.NoError(t, source.Set([]byte(fmt.Sprintf("key%02d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+
+	target := dbm.NewMemDB()
+	count, err := copyDB(source, target, 10)
+	require.NoError(t, err)
+	require.Equal(t, 25, count)
+
+	for i := 0; i < 25; i++ {
+		value, err := target.Get([]byte(fmt.Sprintf("key%02d", i)))
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), value)
+	}
+}
+
+func TestGetAppDBBackend(t *testing.T) {
+	serverCtx := NewDefaultContext()
+	require.Equal(t, dbm.GoLevelDBBackend, GetAppDBBackend(serverCtx.Viper))
+
+	serverCtx.Viper.Set(FlagAppDBBackend, "memdb")
+	require.Equal(t, dbm.MemDBBackend, GetAppDBBackend(serverCtx.Viper))
+}
@@ -1,7 +1,9 @@
 package grpc
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"google.golang.org/grpc"
@@ -9,18 +11,37 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 )
 
+// NewGRPCWebServer wraps the given gRPC server to serve gRPC-Web requests,
+// accepting cross-origin requests from the origins allowed by config.
+func NewGRPCWebServer(grpcSrv *grpc.Server, config config.GRPCWebConfig) *grpcweb.WrappedGrpcServer {
+	allowedOrigins := make(map[string]bool, len(config.CORSAllowedOrigins))
+	for _, origin := range config.CORSAllowedOrigins {
+		allowedOrigins[origin] = true
+	}
+
+	return grpcweb.WrapServer(grpcSrv, grpcweb.WithOriginFunc(func(origin string) bool {
+		return allowedOrigins["*"] || allowedOrigins[origin]
+	}))
+}
+
 // StartGRPCWeb starts a gRPC-Web server on the given address.
 func StartGRPCWeb(grpcSrv *grpc.Server, config config.Config) (*http.Server, error) {
-	wrappedServer := grpcweb.WrapServer(grpcSrv)
-	handler := func(resp http.ResponseWriter, req *http.Request) {
-		wrappedServer.ServeHTTP(resp, req)
-	}
 	grpcWebSrv := &http.Server{
 		Addr:    config.GRPCWeb.Address,
-		Handler: http.HandlerFunc(handler),
+		Handler: NewGRPCWebServer(grpcSrv, config.GRPCWeb),
 	}
-	if err := grpcWebSrv.ListenAndServe(); err != nil {
+
+	errCh := make(chan error)
+	go func() {
+		if err := grpcWebSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- fmt.Errorf("failed to serve: %w", err)
+		}
+	}()
+
+	select {
+	case err := <-errCh:
 		return nil, err
+	case <-time.After(5 * time.Second): // assume server started successfully
+		return grpcWebSrv, nil
 	}
-	return grpcWebSrv, nil
 }
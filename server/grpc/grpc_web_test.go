@@ -9,6 +9,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/textproto"
 	"strconv"
 	"strings"
@@ -17,11 +18,14 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -63,6 +67,7 @@ func (s *GRPCWebTestSuite) Test_Latest_Validators() {
 	val := s.network.Validators[0]
 	for _, contentType := range []string{grpcWebContentType} {
 		headers, trailers, responses, err := s.makeGrpcRequest(
+			s.grpcWebURL(),
 			"/cosmos.base.tendermint.v1beta1.Service/GetLatestValidatorSet",
 			headerWithFlag(),
 			serializeProtoMessages([]proto.Message{&tmservice.GetLatestValidatorSetRequest{}}), false)
@@ -83,6 +88,7 @@ func (s *GRPCWebTestSuite) Test_Latest_Validators() {
 func (s *GRPCWebTestSuite) Test_Total_Supply() {
 	for _, contentType := range []string{grpcWebContentType} {
 		headers, trailers, responses, err := s.makeGrpcRequest(
+			s.grpcWebURL(),
 			"/cosmos.bank.v1beta1.Query/TotalSupply",
 			headerWithFlag(),
 			serializeProtoMessages([]proto.Message{&banktypes.QueryTotalSupplyRequest{}}), false)
@@ -96,6 +102,32 @@ func (s *GRPCWebTestSuite) Test_Total_Supply() {
 	}
 }
 
+func (s *GRPCWebTestSuite) Test_API_Server() {
+	val := s.network.Validators[0]
+
+	headers, trailers, responses, err := s.makeGrpcRequest(
+		val.APIAddress,
+		"/cosmos.bank.v1beta1.Query/TotalSupply",
+		headerWithFlag(),
+		serializeProtoMessages([]proto.Message{&banktypes.QueryTotalSupplyRequest{}}), false)
+
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(responses))
+	s.assertTrailerGrpcCode(trailers, codes.OK, "")
+	s.assertContentTypeSet(headers, grpcWebContentType)
+
+	// other requests are still served by the API routes
+	resp, err := http.Get(fmt.Sprintf("%s/cosmos/bank/v1beta1/supply", val.APIAddress))
+	s.Require().NoError(err)
+	s.Require().NoError(resp.Body.Close())
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Require().Equal("application/json", resp.Header.Get("content-type"))
+}
+
+func (s *GRPCWebTestSuite) grpcWebURL() string {
+	return fmt.Sprintf("http://%s", s.network.Validators[0].AppConfig.GRPCWeb.Address)
+}
+
 func (s *GRPCWebTestSuite) assertContentTypeSet(headers http.Header, contentType string) {
 	s.Require().Equal(contentType, headers.Get("content-type"), `Expected there to be content-type=%v`, contentType)
 }
@@ -118,9 +150,8 @@ func serializeProtoMessages(messages []proto.Message) [][]byte {
 }
 
 func (s *GRPCWebTestSuite) makeRequest(
-	verb string, method string, headers http.Header, body io.Reader, isText bool,
+	baseURL, verb string, method string, headers http.Header, body io.Reader, isText bool,
 ) (*http.Response, error) {
-	val := s.network.Validators[0]
 	contentType := "application/grpc-web"
 	if isText {
 		// base64 encode the body
@@ -138,7 +169,7 @@ func (s *GRPCWebTestSuite) makeRequest(
 		contentType = "application/grpc-web-text"
 	}
 
-	url := fmt.Sprintf("http://%s%s", val.AppConfig.GRPCWeb.Address, method)
+	url := fmt.Sprintf("%s%s", baseURL, method)
 	req, err := http.NewRequest(verb, url, body)
 	s.Require().NoError(err, "failed creating a request")
 	req.Header = headers
@@ -181,7 +212,7 @@ func decodeMultipleBase64Chunks(b []byte) ([]byte, error) {
 }
 
 func (s *GRPCWebTestSuite) makeGrpcRequest(
-	method string, reqHeaders http.Header, requestMessages [][]byte, isText bool,
+	baseURL, method string, reqHeaders http.Header, requestMessages [][]byte, isText bool,
 ) (headers http.Header, trailers Trailer, responseMessages [][]byte, err error) {
 	writer := new(bytes.Buffer)
 	for _, msgBytes := range requestMessages {
@@ -190,7 +221,7 @@ func (s *GRPCWebTestSuite) makeGrpcRequest(
 		writer.Write(grpcPreamble)
 		writer.Write(msgBytes)
 	}
-	resp, err := s.makeRequest("POST", method, reqHeaders, writer, isText)
+	resp, err := s.makeRequest(baseURL, "POST", method, reqHeaders, writer, isText)
 	if err != nil {
 		return nil, Trailer{}, nil, err
 	}
@@ -308,6 +339,41 @@ func (t trailer) Get(key string) string {
 	return v[0]
 }
 
+func TestNewGRPCWebServerCORS(t *testing.T) {
+	grpcSrv := grpc.NewServer()
+	tmservice.RegisterServiceServer(grpcSrv, nil)
+
+	testCases := []struct {
+		allowedOrigins []string
+		origin         string
+		allowed        bool
+	}{
+		{nil, "https://wallet.example", false},
+		{[]string{"https://wallet.example"}, "https://wallet.example", true},
+		{[]string{"https://wallet.example"}, "https://other.example", false},
+		{[]string{"*"}, "https://other.example", true},
+	}
+
+	for _, tc := range testCases {
+		grpcWebSrv := servergrpc.NewGRPCWebServer(grpcSrv, config.GRPCWebConfig{CORSAllowedOrigins: tc.allowedOrigins})
+
+		req := httptest.NewRequest(http.MethodOptions, "/cosmos.base.tendermint.v1beta1.Service/GetNodeInfo", nil)
+		req.Header.Set("Origin", tc.origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+		require.True(t, grpcWebSrv.IsAcceptableGrpcCorsRequest(req))
+
+		w := httptest.NewRecorder()
+		grpcWebSrv.ServeHTTP(w, req)
+
+		if tc.allowed {
+			require.Equal(t, tc.origin, w.Header().Get("Access-Control-Allow-Origin"), tc.origin)
+		} else {
+			require.Empty(t, w.Header().Get("Access-Control-Allow-Origin"), tc.origin)
+		}
+	}
+}
+
 func TestGRPCWebTestSuite(t *testing.T) {
 	suite.Run(t, new(GRPCWebTestSuite))
 }
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// GetMempoolFilterFromFlags parses command flags and returns the mempool filter
+// enforcing the configured pending transaction limits. If no limit is
+// configured, nil is returned.
+func GetMempoolFilterFromFlags(appOpts types.AppOptions) (baseapp.MempoolFilter, error) {
+	maxTxsPerSigner := cast.ToUint64(appOpts.Get(FlagAppMempoolMaxTxsPerSigner))
+
+	msgTypeLimits, err := baseapp.ParseMsgTypeLimits(cast.ToStringSlice(appOpts.Get(FlagAppMempoolMsgTypeLimits)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid mempool configuration: %w", err)
+	}
+
+	if maxTxsPerSigner == 0 && len(msgTypeLimits) == 0 {
+		return nil, nil
+	}
+
+	return baseapp.NewTxCountLimiter(maxTxsPerSigner, msgTypeLimits), nil
+}
@@ -103,6 +103,14 @@ func (ms multiStore) SetInterBlockCache(_ sdk.MultiStorePersistentCache) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetCommitBatching(_ bool) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetIAVLFastIndex(_ bool) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetInitialVersion(version int64) error {
 	panic("not implemented")
 }
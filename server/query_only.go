@@ -0,0 +1,419 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/service"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// reloadableApp is implemented by applications able to reload the latest
+// version of their database, e.g. BaseApp.
+type reloadableApp interface {
+	ReloadLatestVersion() error
+}
+
+// startQueryOnly starts a node serving gRPC queries from a read-only replica
+// of the application database, without running Tendermint and thus without
+// participating in consensus. The replica is reopened every
+// FlagReplicaRefreshInterval so that queries are served as of the latest
+// height copied into it.
+func startQueryOnly(ctx *Context, clientCtx client.Context, appCreator types.AppCreator) error {
+	config := config.GetConfig(ctx.Viper)
+	if !config.GRPC.Enable {
+		return fmt.Errorf("the gRPC server must be enabled to run a query-only node")
+	}
+
+	if backendType := GetAppDBBackend(ctx.Viper); backendType != dbm.GoLevelDBBackend {
+		return fmt.Errorf("query-only mode is not supported by the %s backend", backendType)
+	}
+
+	dataDir := ctx.Viper.GetString(FlagReplicaDBDir)
+	if dataDir == "" {
+		dataDir = filepath.Join(ctx.Config.RootDir, "data")
+	}
+
+	replica, err := openReplicaDB(dataDir)
+	if err != nil {
+		return err
+	}
+
+	traceWriter, err := openTraceWriter(ctx.Viper.GetString(flagTraceStore))
+	if err != nil {
+		return err
+	}
+
+	app := appCreator(ctx.Logger, replica, traceWriter, ctx.Viper)
+	info := app.Info(abci.RequestInfo{})
+	ctx.Logger.Info("serving queries from application database replica", "dir", dataDir, "height", info.LastBlockHeight)
+
+	queryClient := newAppQueryClient(app)
+	clientCtx = clientCtx.WithClient(queryClient)
+
+	refreshInterval := ctx.Viper.GetDuration(FlagReplicaRefreshInterval)
+	if refreshInterval > 0 {
+		if _, ok := app.(reloadableApp); !ok {
+			return fmt.Errorf("the application does not support refreshing its database replica; set --%s to 0", FlagReplicaRefreshInterval)
+		}
+	}
+
+	var grpcWebSrv *http.Server
+	grpcSrv, err := servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+	if err != nil {
+		return err
+	}
+
+	if config.GRPCWeb.Enable {
+		grpcWebSrv, err = servergrpc.StartGRPCWeb(grpcSrv, config)
+		if err != nil {
+			ctx.Logger.Error("failed to start grpc-web http server: ", err)
+			return err
+		}
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	if refreshInterval > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ticker := time.NewTicker(refreshInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-done:
+					return
+
+				case <-ticker.C:
+					queryClient.refresh(ctx.Logger, replica, dataDir)
+				}
+			}
+		}()
+	}
+
+	defer func() {
+		close(done)
+		wg.Wait()
+
+		grpcSrv.Stop()
+		if grpcWebSrv != nil {
+			grpcWebSrv.Close()
+		}
+
+		_ = replica.Close()
+		ctx.Logger.Info("exiting...")
+	}()
+
+	// Wait for SIGINT or SIGTERM signal
+	return WaitForQuitSignals()
+}
+
+// replicaDB is a read-only replica of the application database whose handle
+// can be swapped once the replica was updated on disk, as goleveldb only sees
+// the files present when it was opened.
+type replicaDB struct {
+	mtx sync.RWMutex
+	db  dbm.DB
+}
+
+var _ dbm.DB = (*replicaDB)(nil)
+
+// openReplicaDB opens the application database replica in dir.
+func openReplicaDB(dir string) (*replicaDB, error) {
+	db, err := sdk.NewReadOnlyLevelDB("application", dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open application database replica in %s: %w", dir, err)
+	}
+
+	return &replicaDB{db: db}, nil
+}
+
+// swap replaces the handle of the replica and returns the previous one.
+func (r *replicaDB) swap(db dbm.DB) dbm.DB {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	old := r.db
+	r.db = db
+
+	return old
+}
+
+func (r *replicaDB) current() dbm.DB {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.db
+}
+
+// Get implements dbm.DB.
+func (r *replicaDB) Get(key []byte) ([]byte, error) { return r.current().Get(key) }
+
+// Has implements dbm.DB.
+func (r *replicaDB) Has(key []byte) (bool, error) { return r.current().Has(key) }
+
+// Set implements dbm.DB.
+func (r *replicaDB) Set(key, value []byte) error { return r.current().Set(key, value) }
+
+// SetSync implements dbm.DB.
+func (r *replicaDB) SetSync(key, value []byte) error { return r.current().SetSync(key, value) }
+
+// Delete implements dbm.DB.
+func (r *replicaDB) Delete(key []byte) error { return r.current().Delete(key) }
+
+// DeleteSync implements dbm.DB.
+func (r *replicaDB) DeleteSync(key []byte) error { return r.current().DeleteSync(key) }
+
+// Iterator implements dbm.DB.
+func (r *replicaDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return r.current().Iterator(start, end)
+}
+
+// ReverseIterator implements dbm.DB.
+func (r *replicaDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return r.current().ReverseIterator(start, end)
+}
+
+// Close implements dbm.DB.
+func (r *replicaDB) Close() error { return r.current().Close() }
+
+// NewBatch implements dbm.DB.
+func (r *replicaDB) NewBatch() dbm.Batch { return r.current().NewBatch() }
+
+// Print implements dbm.DB.
+func (r *replicaDB) Print() error { return r.current().Print() }
+
+// Stats implements dbm.DB.
+func (r *replicaDB) Stats() map[string]string { return r.current().Stats() }
+
+// errNotSupported is returned by the appQueryClient endpoints requiring a
+// running Tendermint node.
+var errNotSupported = sdkerrors.Wrap(sdkerrors.ErrNotSupported, "not supported by a query-only node")
+
+// appQueryClient is a Tendermint RPC client routing ABCI queries directly to
+// an in-process application. It only supports the ABCI info and query
+// endpoints: all other endpoints require a running Tendermint node and return
+// an ErrNotSupported error.
+type appQueryClient struct {
+	service.BaseService
+
+	// mtx serializes calls to the application, as the Tendermint ABCI local
+	// client does, and the reloads of its database
+	mtx sync.Mutex
+	app abci.Application
+}
+
+var _ rpcclient.Client = (*appQueryClient)(nil)
+
+func newAppQueryClient(app abci.Application) *appQueryClient {
+	c := &appQueryClient{app: app}
+	c.BaseService = *service.NewBaseService(nil, "AppQueryClient", c)
+
+	return c
+}
+
+// refresh reopens the replica in dir and reloads the application from it if
+// it holds a height greater than the one currently served.
+func (c *appQueryClient) refresh(logger log.Logger, replica *replicaDB, dir string) {
+	next, err := sdk.NewReadOnlyLevelDB("application", dir)
+	if err != nil {
+		logger.Error("failed to reopen application database replica", "dir", dir, "err", err)
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	height := c.app.Info(abci.RequestInfo{}).LastBlockHeight
+	if rootmulti.GetLatestVersion(next) <= height {
+		_ = next.Close()
+		return
+	}
+
+	app := c.app.(reloadableApp)
+	old := replica.swap(next)
+	if err := app.ReloadLatestVersion(); err != nil {
+		logger.Error("failed to reload application database replica", "dir", dir, "err", err)
+
+		_ = replica.swap(old)
+		_ = next.Close()
+		if err := app.ReloadLatestVersion(); err != nil {
+			logger.Error("failed to restore application database replica", "dir", dir, "err", err)
+		}
+
+		return
+	}
+
+	_ = old.Close()
+	logger.Info("refreshed application database replica", "dir", dir, "height", c.app.Info(abci.RequestInfo{}).LastBlockHeight)
+}
+
+// ABCIInfo implements rpcclient.ABCIClient.
+func (c *appQueryClient) ABCIInfo(context.Context) (*ctypes.ResultABCIInfo, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return &ctypes.ResultABCIInfo{Response: c.app.Info(abci.RequestInfo{})}, nil
+}
+
+// ABCIQuery implements rpcclient.ABCIClient.
+func (c *appQueryClient) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+// ABCIQueryWithOptions implements rpcclient.ABCIClient.
+func (c *appQueryClient) ABCIQueryWithOptions(
+	_ context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	res := c.app.Query(abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	return &ctypes.ResultABCIQuery{Response: res}, nil
+}
+
+// BroadcastTxCommit implements rpcclient.ABCIClient.
+func (c *appQueryClient) BroadcastTxCommit(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	return nil, errNotSupported
+}
+
+// BroadcastTxAsync implements rpcclient.ABCIClient.
+func (c *appQueryClient) BroadcastTxAsync(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return nil, errNotSupported
+}
+
+// BroadcastTxSync implements rpcclient.ABCIClient.
+func (c *appQueryClient) BroadcastTxSync(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return nil, errNotSupported
+}
+
+// Subscribe implements rpcclient.EventsClient.
+func (c *appQueryClient) Subscribe(context.Context, string, string, ...int) (<-chan ctypes.ResultEvent, error) {
+	return nil, errNotSupported
+}
+
+// Unsubscribe implements rpcclient.EventsClient.
+func (c *appQueryClient) Unsubscribe(context.Context, string, string) error {
+	return errNotSupported
+}
+
+// UnsubscribeAll implements rpcclient.EventsClient.
+func (c *appQueryClient) UnsubscribeAll(context.Context, string) error {
+	return errNotSupported
+}
+
+// Genesis implements rpcclient.HistoryClient.
+func (c *appQueryClient) Genesis(context.Context) (*ctypes.ResultGenesis, error) {
+	return nil, errNotSupported
+}
+
+// BlockchainInfo implements rpcclient.HistoryClient.
+func (c *appQueryClient) BlockchainInfo(context.Context, int64, int64) (*ctypes.ResultBlockchainInfo, error) {
+	return nil, errNotSupported
+}
+
+// NetInfo implements rpcclient.NetworkClient.
+func (c *appQueryClient) NetInfo(context.Context) (*ctypes.ResultNetInfo, error) {
+	return nil, errNotSupported
+}
+
+// DumpConsensusState implements rpcclient.NetworkClient.
+func (c *appQueryClient) DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error) {
+	return nil, errNotSupported
+}
+
+// ConsensusState implements rpcclient.NetworkClient.
+func (c *appQueryClient) ConsensusState(context.Context) (*ctypes.ResultConsensusState, error) {
+	return nil, errNotSupported
+}
+
+// ConsensusParams implements rpcclient.NetworkClient.
+func (c *appQueryClient) ConsensusParams(context.Context, *int64) (*ctypes.ResultConsensusParams, error) {
+	return nil, errNotSupported
+}
+
+// Health implements rpcclient.NetworkClient.
+func (c *appQueryClient) Health(context.Context) (*ctypes.ResultHealth, error) {
+	return nil, errNotSupported
+}
+
+// Block implements rpcclient.SignClient.
+func (c *appQueryClient) Block(context.Context, *int64) (*ctypes.ResultBlock, error) {
+	return nil, errNotSupported
+}
+
+// BlockByHash implements rpcclient.SignClient.
+func (c *appQueryClient) BlockByHash(context.Context, []byte) (*ctypes.ResultBlock, error) {
+	return nil, errNotSupported
+}
+
+// BlockResults implements rpcclient.SignClient.
+func (c *appQueryClient) BlockResults(context.Context, *int64) (*ctypes.ResultBlockResults, error) {
+	return nil, errNotSupported
+}
+
+// Commit implements rpcclient.SignClient.
+func (c *appQueryClient) Commit(context.Context, *int64) (*ctypes.ResultCommit, error) {
+	return nil, errNotSupported
+}
+
+// Validators implements rpcclient.SignClient.
+func (c *appQueryClient) Validators(context.Context, *int64, *int, *int) (*ctypes.ResultValidators, error) {
+	return nil, errNotSupported
+}
+
+// Tx implements rpcclient.SignClient.
+func (c *appQueryClient) Tx(context.Context, []byte, bool) (*ctypes.ResultTx, error) {
+	return nil, errNotSupported
+}
+
+// TxSearch implements rpcclient.SignClient.
+func (c *appQueryClient) TxSearch(context.Context, string, bool, *int, *int, string) (*ctypes.ResultTxSearch, error) {
+	return nil, errNotSupported
+}
+
+// Status implements rpcclient.StatusClient.
+func (c *appQueryClient) Status(context.Context) (*ctypes.ResultStatus, error) {
+	return nil, errNotSupported
+}
+
+// BroadcastEvidence implements rpcclient.EvidenceClient.
+func (c *appQueryClient) BroadcastEvidence(context.Context, tmtypes.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
+	return nil, errNotSupported
+}
+
+// UnconfirmedTxs implements rpcclient.MempoolClient.
+func (c *appQueryClient) UnconfirmedTxs(context.Context, *int) (*ctypes.ResultUnconfirmedTxs, error) {
+	return nil, errNotSupported
+}
+
+// NumUnconfirmedTxs implements rpcclient.MempoolClient.
+func (c *appQueryClient) NumUnconfirmedTxs(context.Context) (*ctypes.ResultUnconfirmedTxs, error) {
+	return nil, errNotSupported
+}
+
+// CheckTx implements rpcclient.MempoolClient.
+func (c *appQueryClient) CheckTx(context.Context, tmtypes.Tx) (*ctypes.ResultCheckTx, error) {
+	return nil, errNotSupported
+}
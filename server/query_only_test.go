@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type queryEchoApp struct {
+	abci.BaseApplication
+}
+
+func (queryEchoApp) Info(abci.RequestInfo) abci.ResponseInfo {
+	return abci.ResponseInfo{LastBlockHeight: 42}
+}
+
+func (queryEchoApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	return abci.ResponseQuery{Key: req.Data, Value: []byte(req.Path), Height: req.Height}
+}
+
+func TestAppQueryClient(t *testing.T) {
+	c := newAppQueryClient(queryEchoApp{})
+
+	info, err := c.ABCIInfo(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(42), info.Response.LastBlockHeight)
+
+	res, err := c.ABCIQueryWithOptions(context.Background(), "/custom/foo", []byte("bar"), rpcclient.ABCIQueryOptions{Height: 7})
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), res.Response.Key)
+	require.Equal(t, []byte("/custom/foo"), res.Response.Value)
+	require.Equal(t, int64(7), res.Response.Height)
+
+	res, err = c.ABCIQuery(context.Background(), "/custom/foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.Response.Height)
+
+	_, err = c.Status(context.Background())
+	require.True(t, sdkerrors.ErrNotSupported.Is(err))
+
+	_, err = c.BroadcastTxSync(context.Background(), []byte("tx"))
+	require.True(t, sdkerrors.ErrNotSupported.Is(err))
+
+	_, err = c.Subscribe(context.Background(), "subscriber", "tm.event = 'Tx'")
+	require.True(t, sdkerrors.ErrNotSupported.Is(err))
+}
+
+// copyReplica copies the application database in src to dst.
+func copyReplica(t *testing.T, src, dst string) {
+	require.NoError(t, os.RemoveAll(filepath.Join(dst, "application.db")))
+	require.NoError(t, os.MkdirAll(filepath.Join(dst, "application.db"), 0755))
+
+	files, err := ioutil.ReadDir(filepath.Join(src, "application.db"))
+	require.NoError(t, err)
+
+	for _, f := range files {
+		bz, err := ioutil.ReadFile(filepath.Join(src, "application.db", f.Name()))
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dst, "application.db", f.Name()), bz, 0644))
+	}
+}
+
+func TestAppQueryClientRefresh(t *testing.T) {
+	writerDir, replicaDir := t.TempDir(), t.TempDir()
+	logger := log.NewNopLogger()
+	key := sdk.NewKVStoreKey("main")
+
+	newApp := func(db dbm.DB) *baseapp.BaseApp {
+		app := baseapp.NewBaseApp("query-only", logger, db, nil)
+		app.MountStores(key)
+		require.NoError(t, app.LoadLatestVersion())
+
+		return app
+	}
+
+	commitBlock := func(dir string, height int64) {
+		db, err := sdk.NewLevelDB("application", dir)
+		require.NoError(t, err)
+
+		app := newApp(db)
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.Commit()
+		require.NoError(t, db.Close())
+	}
+
+	commitBlock(writerDir, 1)
+	copyReplica(t, writerDir, replicaDir)
+
+	replica, err := openReplicaDB(replicaDir)
+	require.NoError(t, err)
+	defer replica.Close()
+
+	c := newAppQueryClient(newApp(replica))
+	info, err := c.ABCIInfo(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), info.Response.LastBlockHeight)
+
+	// the replica was not updated
+	c.refresh(logger, replica, replicaDir)
+	info, err = c.ABCIInfo(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), info.Response.LastBlockHeight)
+
+	commitBlock(writerDir, 2)
+	copyReplica(t, writerDir, replicaDir)
+
+	c.refresh(logger, replica, replicaDir)
+	info, err = c.ABCIInfo(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), info.Response.LastBlockHeight)
+}
@@ -4,6 +4,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"runtime/pprof"
@@ -33,6 +34,8 @@ import (
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Tendermint full-node start flags
@@ -46,6 +49,12 @@ const (
 	FlagHaltHeight         = "halt-height"
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
+	FlagCommitBatching     = "commit-batching"
+	FlagCommitAsyncFsync   = "commit-async-fsync"
+	FlagIAVLFastIndex      = "iavl-fast-index"
+	FlagErrorStackTraces   = "error-stack-traces"
+	FlagAddrCache          = "addr-cache"
+	FlagEventVerbosity     = "event-verbosity"
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
@@ -55,6 +64,7 @@ const (
 	FlagPruningKeepEvery  = "pruning-keep-every"
 	FlagPruningInterval   = "pruning-interval"
 	FlagIndexEvents       = "index-events"
+	FlagAppDBBackend      = "app-db-backend"
 	FlagMinRetainBlocks   = "min-retain-blocks"
 )
 
@@ -66,6 +76,26 @@ const (
 	flagGRPCWebAddress = "grpc-web.address"
 )
 
+// Query-only node flags.
+const (
+	FlagQueryOnly              = "query-only"
+	FlagReplicaDBDir           = "replica-db-dir"
+	FlagReplicaRefreshInterval = "replica-refresh-interval"
+)
+
+// App mempool-related flags.
+const (
+	FlagAppMempoolMaxTxsPerSigner = "app-mempool.max-txs-per-signer"
+	FlagAppMempoolMsgTypeLimits   = "app-mempool.msg-type-limits"
+)
+
+// Simulate trace-related flags.
+const (
+	FlagSimulateTraceEnable  = "simulate-trace.enable"
+	FlagSimulateTraceMaxGas  = "simulate-trace.max-gas"
+	FlagSimulateTraceMaxSize = "simulate-trace.max-size"
+)
+
 // State sync-related flags.
 const (
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -95,7 +125,16 @@ Node halting configurations exist in the form of two flags: '--halt-height' and
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
 the halt-height or if the current block time is greater than or equal to the halt-time. If so, the
 node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
-will not be able to commit subsequent blocks.
+will not be able to commit subsequent blocks. Before shutting down, the node completes the state sync
+snapshots being taken, including the snapshot of the halt height if it is a snapshot height, and
+closes the application database.
+
+A query-only node can be started with the '--query-only' flag. In this mode, the application
+database is opened read-only from the directory given by '--replica-db-dir' (defaulting to the
+node's data directory) and only the gRPC and gRPC-Web servers are started: Tendermint is not run and
+the node does not participate in consensus. This allows heavy query load to be served from a replica
+of a node's database without affecting validators. The replica must be a copy of the database which
+is not in use by another node, and is served as of the height it was opened at.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
@@ -117,6 +156,21 @@ which accepts a path for the resulting pprof file.
 				return err
 			}
 
+			sdkerrors.SetStackTraceCapture(serverCtx.Viper.GetBool(FlagErrorStackTraces))
+			sdk.SetAddrCacheEnabled(serverCtx.Viper.GetBool(FlagAddrCache))
+
+			eventVerbosity, err := sdk.ParseEventVerbosityConfig(serverCtx.Viper.GetString(FlagEventVerbosity))
+			if err != nil {
+				return err
+			}
+
+			sdk.SetEventVerbosity(eventVerbosity)
+
+			if queryOnly, _ := cmd.Flags().GetBool(FlagQueryOnly); queryOnly {
+				serverCtx.Logger.Info("starting query-only node without Tendermint")
+				return startQueryOnly(serverCtx, clientCtx, appCreator)
+			}
+
 			withTM, _ := cmd.Flags().GetBool(flagWithTendermint)
 			if !withTM {
 				serverCtx.Logger.Info("starting ABCI without Tendermint")
@@ -147,14 +201,21 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Bool(FlagCommitBatching, false, "Write all the changes of a commit to the application database in a single synced batch")
+	cmd.Flags().Bool(FlagCommitAsyncFsync, false, "Do not wait for the commit batch to be synced to disk (requires commit batching)")
+	cmd.Flags().Bool(FlagIAVLFastIndex, false, "Serve queries of the latest version from an in-memory index of the IAVL stores")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
+	cmd.Flags().Bool(FlagErrorStackTraces, true, "Capture stack traces of errors, logged along with failed transactions at debug level")
+	cmd.Flags().Bool(FlagAddrCache, true, "Cache the bech32 encoding and decoding of addresses")
+	cmd.Flags().String(FlagEventVerbosity, sdk.EventVerbosityFull.String(), "Verbosity of the events emitted by the modules (full|standard|minimal), per module with <module>=<verbosity> (e.g. standard,bank=minimal)")
 	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().String(FlagAppDBBackend, "", "The database backend of the application databases (goleveldb|cleveldb|rocksdb|boltdb|badgerdb)")
 
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
@@ -165,6 +226,17 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
+	cmd.Flags().Bool(FlagQueryOnly, false, "Serve gRPC queries from a read-only replica of the application database without running Tendermint")
+	cmd.Flags().String(FlagReplicaDBDir, "", "Directory holding the application database replica served in query-only mode (defaults to the node's data directory)")
+	cmd.Flags().Duration(FlagReplicaRefreshInterval, 5*time.Second, "Interval at which the application database replica is reopened to serve its latest height in query-only mode (0 to disable)")
+
+	cmd.Flags().Uint64(FlagAppMempoolMaxTxsPerSigner, 0, "Maximum number of pending txs per signer in the mempool (0 disables the limit)")
+	cmd.Flags().StringSlice(FlagAppMempoolMsgTypeLimits, []string{}, "Maximum number of pending txs per signer and message type in the mempool, in the form {msgType}={limit}")
+
+	cmd.Flags().Bool(FlagSimulateTraceEnable, false, "Enable the /app/simulate/trace query, recording the store operations of simulated txs")
+	cmd.Flags().Uint64(FlagSimulateTraceMaxGas, 10000000, "Maximum gas consumed by the store operations of a traced tx")
+	cmd.Flags().Uint64(FlagSimulateTraceMaxSize, 1048576, "Maximum size of the store trace of a tx, in bytes")
+
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
 	return cmd
@@ -175,7 +247,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	transport := ctx.Viper.GetString(flagTransport)
 	home := ctx.Viper.GetString(flags.FlagHome)
 
-	db, err := openDB(home)
+	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
@@ -204,6 +276,8 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		closeApp(ctx, app)
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -235,7 +309,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	}
 
 	traceWriterFile := ctx.Viper.GetString(flagTraceStore)
-	db, err := openDB(home)
+	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
@@ -285,6 +359,24 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		app.RegisterTendermintService(clientCtx)
 	}
 
+	var (
+		grpcSrv    *grpc.Server
+		grpcWebSrv *http.Server
+	)
+	if config.GRPC.Enable {
+		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+		if err != nil {
+			return err
+		}
+		if config.GRPCWeb.Enable {
+			grpcWebSrv, err = servergrpc.StartGRPCWeb(grpcSrv, config)
+			if err != nil {
+				ctx.Logger.Error("failed to start grpc-web http server: ", err)
+				return err
+			}
+		}
+	}
+
 	var apiSrv *api.Server
 	if config.API.Enable {
 		genDoc, err := genDocProvider()
@@ -298,6 +390,10 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 
 		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
 		app.RegisterAPIRoutes(apiSrv, config.API)
+		if grpcSrv != nil && config.GRPCWeb.Enable {
+			apiSrv.SetGRPCWebServer(servergrpc.NewGRPCWebServer(grpcSrv, config.GRPCWeb))
+		}
+
 		errCh := make(chan error)
 
 		go func() {
@@ -313,24 +409,6 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
-	var (
-		grpcSrv    *grpc.Server
-		grpcWebSrv *http.Server
-	)
-	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
-		if err != nil {
-			return err
-		}
-		if config.GRPCWeb.Enable {
-			grpcWebSrv, err = servergrpc.StartGRPCWeb(grpcSrv, config)
-			if err != nil {
-				ctx.Logger.Error("failed to start grpc-web http server: ", err)
-				return err
-			}
-		}
-	}
-
 	var rosettaSrv crgserver.Server
 	if config.Rosetta.Enable {
 		offlineMode := config.Rosetta.Offline
@@ -387,9 +465,25 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			}
 		}
 
+		closeApp(ctx, app)
+
 		ctx.Logger.Info("exiting...")
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
 	return WaitForQuitSignals()
 }
+
+// closeApp closes the application, if it can be closed, once it is no longer
+// used, so that its background work, e.g. state sync snapshots, completes and
+// its stores are closed.
+func closeApp(ctx *Context, app types.Application) {
+	closer, ok := app.(io.Closer)
+	if !ok {
+		return
+	}
+
+	if err := closer.Close(); err != nil {
+		ctx.Logger.Error("failed to close application", "err", err)
+	}
+}
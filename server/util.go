@@ -16,6 +16,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -85,6 +86,12 @@ func bindFlags(basename string, cmd *cobra.Command, v *viper.Viper) (err error)
 		// Apply the viper config value to the flag when the flag is not set and viper has a value
 		if !f.Changed && v.IsSet(f.Name) {
 			val := v.Get(f.Name)
+
+			// slice flags expect their values as a comma separated list
+			if vals, ok := val.([]interface{}); ok {
+				val = strings.Join(cast.ToStringSlice(vals), ",")
+			}
+
 			err = cmd.Flags().Set(f.Name, fmt.Sprintf("%v", val))
 			if err != nil {
 				panic(err)
@@ -266,6 +273,9 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		UnsafeResetAllCmd(),
 		flags.LineBreak,
 		tendermintCmd,
+		ConfigCmd(),
+		ChainRegistryCmd(),
+		ConvertDBCmd(defaultNodeHome),
 		ExportCmd(appExport, defaultNodeHome),
 		flags.LineBreak,
 		version.NewVersionCommand(),
@@ -360,9 +370,24 @@ func addrToIP(addr net.Addr) net.IP {
 	return ip
 }
 
-func openDB(rootDir string) (dbm.DB, error) {
+func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
-	return sdk.NewLevelDB("application", dataDir)
+	return sdk.NewDB("application", backendType, dataDir)
+}
+
+// GetAppDBBackend returns the database backend of the application databases
+// configured through the app-db-backend option, defaulting to the backend
+// selected at compile time.
+func GetAppDBBackend(appOpts types.AppOptions) dbm.BackendType {
+	if backendType := cast.ToString(appOpts.Get(FlagAppDBBackend)); backendType != "" {
+		return dbm.BackendType(backendType)
+	}
+
+	if sdk.DBBackend != "" {
+		return dbm.BackendType(sdk.DBBackend)
+	}
+
+	return dbm.GoLevelDBBackend
 }
 
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
@@ -11,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 )
@@ -162,6 +164,34 @@ func TestInterceptConfigsPreRunHandlerReadsAppToml(t *testing.T) {
 	}
 }
 
+func TestInterceptConfigsPreRunHandlerReadsAppTomlSlices(t *testing.T) {
+	tempDir := t.TempDir()
+	err := os.Mkdir(path.Join(tempDir, "config"), os.ModePerm)
+	if err != nil {
+		t.Fatalf("creating config dir failed: %v", err)
+	}
+
+	appToml := "[app-mempool]\nmsg-type-limits = [\"/a=1\", \"/b=2\"]\n"
+	if err := ioutil.WriteFile(path.Join(tempDir, "config", "app.toml"), []byte(appToml), 0600); err != nil {
+		t.Fatalf("writing app.toml file failed: %v", err)
+	}
+
+	cmd := StartCmd(nil, tempDir)
+	cmd.PreRunE = preRunETestImpl
+
+	serverCtx := &Context{}
+	ctx := context.WithValue(context.Background(), ServerContextKey, serverCtx)
+
+	if err := cmd.ExecuteContext(ctx); err != CancelledInPreRun {
+		t.Fatalf("function failed with [%T] %v", err, err)
+	}
+
+	limits, err := cmd.Flags().GetStringSlice(FlagAppMempoolMsgTypeLimits)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/a=1", "/b=2"}, limits)
+	require.Equal(t, []string{"/a=1", "/b=2"}, serverCtx.Viper.GetStringSlice(FlagAppMempoolMsgTypeLimits))
+}
+
 func TestInterceptConfigsPreRunHandlerReadsFlags(t *testing.T) {
 	const testAddr = "tcp://127.1.2.3:12345"
 	tempDir := t.TempDir()
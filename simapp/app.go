@@ -13,7 +13,6 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -86,6 +85,9 @@ import (
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authztypes "github.com/cosmos/cosmos-sdk/x/authz/types"
+	"github.com/cosmos/cosmos-sdk/x/delay"
+	delaykeeper "github.com/cosmos/cosmos-sdk/x/delay/keeper"
+	delaytypes "github.com/cosmos/cosmos-sdk/x/delay/types"
 
 	// unnamed import of statik for swagger UI support
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
@@ -118,6 +120,7 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		authz.AppModuleBasic{},
+		delay.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 	)
 
@@ -173,6 +176,7 @@ type SimApp struct {
 	AuthzKeeper      authzkeeper.Keeper
 	EvidenceKeeper   evidencekeeper.Keeper
 	FeeGrantKeeper   feegrantkeeper.Keeper
+	DelayKeeper      delaykeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -193,6 +197,25 @@ func init() {
 	DefaultNodeHome = filepath.Join(userHomeDir, ".simapp")
 }
 
+// StoreKeyDecoders returns the decoders of the keys of the module stores of the
+// SimApp, by store name. The params and capability stores have none.
+func StoreKeyDecoders() sdk.StoreKeyDecoderRegistry {
+	return sdk.StoreKeyDecoderRegistry{
+		authtypes.StoreKey:     authtypes.DecodeStoreKey,
+		banktypes.StoreKey:     banktypes.DecodeStoreKey,
+		stakingtypes.StoreKey:  stakingtypes.DecodeStoreKey,
+		minttypes.StoreKey:     minttypes.DecodeStoreKey,
+		distrtypes.StoreKey:    distrtypes.DecodeStoreKey,
+		slashingtypes.StoreKey: slashingtypes.DecodeStoreKey,
+		govtypes.StoreKey:      govtypes.DecodeStoreKey,
+		upgradetypes.StoreKey:  upgradetypes.DecodeStoreKey,
+		feegranttypes.StoreKey: feegranttypes.DecodeStoreKey,
+		evidencetypes.StoreKey: evidencetypes.DecodeStoreKey,
+		authztypes.StoreKey:    authztypes.DecodeStoreKey,
+		delaytypes.StoreKey:    delaytypes.DecodeStoreKey,
+	}
+}
+
 // NewSimApp returns a reference to an initialized SimApp.
 func NewSimApp(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, skipUpgradeHeights map[int64]bool,
@@ -208,13 +231,14 @@ func NewSimApp(
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 	bApp.SetInterfaceRegistry(interfaceRegistry)
+	bApp.SetStoreKeyDecoders(StoreKeyDecoders())
 
 	keys := sdk.NewKVStoreKeys(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegranttypes.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
-		authztypes.StoreKey,
+		authztypes.StoreKey, delaytypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -272,6 +296,7 @@ func NewSimApp(
 	)
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authztypes.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
+	app.DelayKeeper = delaykeeper.NewKeeper(keys[delaytypes.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
@@ -319,17 +344,19 @@ func NewSimApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		authz.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		delay.NewAppModule(appCodec, app.DelayKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
+	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
+		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, delaytypes.ModuleName, authztypes.ModuleName, stakingtypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -340,13 +367,14 @@ func NewSimApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authztypes.ModuleName,
-		feegranttypes.ModuleName,
+		feegranttypes.ModuleName, delaytypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	app.UpgradeKeeper.SetModuleConsensusVersions(app.mm.GetVersionMap())
 
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
@@ -368,6 +396,7 @@ func NewSimApp(
 		params.NewAppModule(app.ParamsKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		authz.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		delay.NewAppModule(appCodec, app.DelayKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -392,18 +421,13 @@ func NewSimApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
-
-		// Initialize and seal the capability keeper so all persistent capabilities
-		// are loaded in-memory and prevent any further modules from creating scoped
-		// sub-keepers.
-		// This must be done during creation of baseapp rather than in InitChain so
-		// that in-memory capabilities get regenerated on app restart.
-		// Note that since this reads from the store, we can only perform it when
-		// `loadLatest` is set to true.
-		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
-		app.CapabilityKeeper.InitializeAndSeal(ctx)
 	}
 
+	// Seal the capability keeper to prevent any further modules from creating
+	// scoped sub-keepers. The in-memory capabilities are regenerated from the
+	// persistent store by the capability module in the first BeginBlock.
+	app.CapabilityKeeper.Seal()
+
 	return app
 }
 
@@ -426,6 +450,7 @@ func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.
 	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -547,7 +572,9 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 //
 // `migrateFromVersions` is a map of moduleName to fromVersion (unit64), where
 // fromVersion denotes the version from which we should migrate the module, the
-// target version being the module's latest ConsensusVersion.
+// target version being the module's latest ConsensusVersion. Once migrated,
+// the consensus versions are stored by x/upgrade and returned by its module
+// versions query.
 //
 // Example:
 //   cfg := module.NewConfigurator(...)
@@ -561,7 +588,12 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 //      }
 //   })
 func (app *SimApp) RunMigrations(ctx sdk.Context, migrateFromVersions module.MigrationMap) error {
-	return app.mm.RunMigrations(ctx, app.configurator, migrateFromVersions)
+	if err := app.mm.RunMigrations(ctx, app.configurator, migrateFromVersions); err != nil {
+		return err
+	}
+
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	return nil
 }
 
 // RegisterSwaggerAPI registers swagger route with API Server
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -19,7 +20,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/delay"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrant "github.com/cosmos/cosmos-sdk/x/feegrant"
@@ -27,9 +30,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -76,6 +83,9 @@ func TestRunMigrations(t *testing.T) {
 	bApp := baseapp.NewBaseApp(appName, logger, db, encCfg.TxConfig.TxDecoder())
 	bApp.SetCommitMultiStoreTracer(nil)
 	bApp.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	// x/upgrade stores the module versions once migrated
+	bApp.MountStores(app.GetKey(upgradetypes.StoreKey))
+	require.NoError(t, bApp.LoadLatestVersion())
 	app.BaseApp = bApp
 	app.configurator = module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter())
 
@@ -176,6 +186,7 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"delay":        delay.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -187,3 +198,47 @@ func TestRunMigrations(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreKeyDecoders(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create a validator and unbond part of its self delegation so that the
+	// staking and distribution stores hold entries under most of their prefixes
+	addrs := AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddr := sdk.ValAddress(addrs[0])
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+	createMsg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, CreateTestPubKeys(1)[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)),
+		stakingtypes.Description{Moniker: "validator"}, stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), createMsg)
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgUndelegate(addrs[0], valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))))
+	require.NoError(t, err)
+
+	decoders := StoreKeyDecoders()
+	for _, key := range app.keys {
+		decoder, ok := decoders[key.Name()]
+		if key.Name() == paramstypes.StoreKey || key.Name() == capabilitytypes.StoreKey {
+			require.False(t, ok, key.Name())
+			continue
+		}
+		require.True(t, ok, key.Name())
+
+		iterator := ctx.KVStore(key).Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			decoded, err := decoder(iterator.Key())
+			require.NoError(t, err, "%s %X", key.Name(), iterator.Key())
+
+			if decoded.Value != nil {
+				require.NoError(t, app.AppCodec().UnmarshalBinaryBare(iterator.Value(), decoded.Value), "%s %s", key.Name(), decoded)
+			}
+		}
+		iterator.Close()
+	}
+}
@@ -71,13 +71,14 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(simapp.ModuleBasics, simapp.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
+		genutilcli.GenesisCeremonyCmd(banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
 		genutilcli.MigrateGenesisCmd(),
 		genutilcli.GenTxCmd(simapp.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(simapp.ModuleBasics),
 		AddGenesisAccountCmd(simapp.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd(encodingConfig),
 	)
 
 	a := appCreator{encodingConfig}
@@ -95,6 +96,15 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	rootCmd.AddCommand(server.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Marshaler))
 }
 
+// debugCmd returns the debug command, along with the command decoding store
+// entries with the store key decoders of the simapp modules.
+func debugCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(debug.DecodeStoreCmd(encodingConfig.Marshaler, simapp.StoreKeyDecoders()))
+
+	return cmd
+}
+
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 }
@@ -174,8 +184,13 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		panic(err)
 	}
 
+	mempoolFilter, err := server.GetMempoolFilterFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
-	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	snapshotDB, err := sdk.NewDB("metadata", server.GetAppDBBackend(appOpts), snapshotDir)
 	if err != nil {
 		panic(err)
 	}
@@ -196,11 +211,22 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
 		baseapp.SetInterBlockCache(cache),
+		baseapp.SetCommitBatching(
+			cast.ToBool(appOpts.Get(server.FlagCommitBatching)),
+			cast.ToBool(appOpts.Get(server.FlagCommitAsyncFsync)),
+		),
+		baseapp.SetIAVLFastIndex(cast.ToBool(appOpts.Get(server.FlagIAVLFastIndex))),
+		baseapp.SetSimulateStoreTrace(
+			cast.ToBool(appOpts.Get(server.FlagSimulateTraceEnable)),
+			cast.ToUint64(appOpts.Get(server.FlagSimulateTraceMaxGas)),
+			cast.ToUint64(appOpts.Get(server.FlagSimulateTraceMaxSize)),
+		),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
 		baseapp.SetSnapshotStore(snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
+		baseapp.SetMempoolFilter(mempoolFilter),
 	)
 }
 
@@ -0,0 +1,317 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosmos/iavl"
+	"github.com/google/btree"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+const fastIndexDegree = 32
+
+// leaf is a key/value pair of the fast index.
+type leaf struct {
+	key, value []byte
+}
+
+// Less implements btree.Item.
+func (l leaf) Less(than btree.Item) bool {
+	return bytes.Compare(l.key, than.(leaf).key) < 0
+}
+
+// changeSet holds the changes of a single version, a nil value denoting a
+// deleted key.
+type changeSet struct {
+	version int64
+	changes map[string][]byte
+}
+
+// fastIndex is an in-memory index of the leaves of the latest committed
+// version of an IAVL tree. Reads of the latest version are served from the
+// index, bypassing the traversal of the tree and the loading of its inner
+// nodes from the database.
+//
+// The index is built in the background when it is enabled. The changes
+// committed while it is being built are queued and applied once the build is
+// done. Afterwards, the changes of each commit are applied to the index and a
+// copy-on-write snapshot of it is published for the committed version.
+//
+// The index holds all the leaves of the latest version in memory.
+type fastIndex struct {
+	mtx sync.RWMutex
+
+	tree       Tree
+	generation uint64 // incremented on invalidation to discard stale builds
+	building   bool
+
+	primary  *btree.BTree // nil until built or when invalidated
+	snapshot *btree.BTree // read-only copy of primary at version
+	version  int64
+
+	dirty  map[string][]byte // changes since the last commit
+	queued []changeSet       // changes committed while building
+
+	// afterWrite, if set, runs the builds started by commits once the writes
+	// of the commit are written to the database, see deferBuilds
+	afterWrite func(build func())
+}
+
+func newFastIndex(tree Tree) *fastIndex {
+	idx := &fastIndex{
+		tree:  tree,
+		dirty: make(map[string][]byte),
+	}
+
+	idx.mtx.Lock()
+	idx.startBuild(tree.Version())
+	idx.mtx.Unlock()
+
+	return idx
+}
+
+// set records the setting of key since the last commit.
+func (idx *fastIndex) set(key, value []byte) {
+	idx.mtx.Lock()
+	idx.dirty[string(key)] = value
+	idx.mtx.Unlock()
+}
+
+// remove records the deletion of key since the last commit.
+func (idx *fastIndex) remove(key []byte) {
+	idx.mtx.Lock()
+	idx.dirty[string(key)] = nil
+	idx.mtx.Unlock()
+}
+
+// commit applies the changes recorded since the last commit to the index and
+// publishes a snapshot of it for the given version. If the index has been
+// invalidated, it is rebuilt from the given version.
+func (idx *fastIndex) commit(version int64) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	cs := changeSet{version: version, changes: idx.dirty}
+	idx.dirty = make(map[string][]byte)
+
+	switch {
+	case idx.building:
+		idx.queued = append(idx.queued, cs)
+
+	case idx.primary == nil:
+		idx.scheduleBuild(version)
+
+	default:
+		applyChangeSet(idx.primary, cs)
+		idx.publish(version)
+	}
+}
+
+// deferBuilds makes the builds of the index started by commits wait until
+// afterWrite runs them, which must happen once the writes of the commit are
+// written to the database, e.g. when they are batched by the root multi-store.
+// Otherwise a build would read a version missing from the database.
+func (idx *fastIndex) deferBuilds(afterWrite func(build func())) {
+	idx.mtx.Lock()
+	idx.afterWrite = afterWrite
+	idx.mtx.Unlock()
+}
+
+// invalidate drops the index, e.g. when the tree is replaced by an import.
+// The index is rebuilt on the next commit.
+func (idx *fastIndex) invalidate() {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.generation++
+	idx.building = false
+	idx.primary = nil
+	idx.snapshot = nil
+	idx.queued = nil
+	idx.dirty = make(map[string][]byte)
+}
+
+// get returns the snapshot of the index for the given version, or nil if the
+// index is not built or is at another version.
+func (idx *fastIndex) get(version int64) *btree.BTree {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	if idx.snapshot == nil || idx.version != version {
+		return nil
+	}
+
+	return idx.snapshot
+}
+
+// scheduleBuild starts building the index from the given committed version,
+// once the writes of the commit are written to the database if builds are
+// deferred.
+//
+// CONTRACT: the caller must hold the write lock.
+func (idx *fastIndex) scheduleBuild(version int64) {
+	if idx.afterWrite == nil {
+		idx.startBuild(version)
+		return
+	}
+
+	generation := idx.generation
+	idx.afterWrite(func() {
+		idx.mtx.Lock()
+		defer idx.mtx.Unlock()
+
+		// skip the build if the index was invalidated or rebuilt meanwhile
+		if idx.generation == generation && idx.primary == nil && !idx.building {
+			idx.startBuild(version)
+		}
+	})
+}
+
+// startBuild starts building the index from the given version in the
+// background. The immutable tree of the version is fetched synchronously as the
+// mutable tree must not be accessed concurrently with commits.
+//
+// CONTRACT: the caller must hold the write lock.
+func (idx *fastIndex) startBuild(version int64) {
+	var (
+		iTree *iavl.ImmutableTree
+		err   error
+	)
+
+	if idx.tree.VersionExists(version) {
+		iTree, err = idx.tree.GetImmutable(version)
+	}
+
+	idx.generation++
+	idx.primary = nil
+	idx.snapshot = nil
+	idx.queued = nil
+
+	if err != nil {
+		// leave the index unbuilt, it is rebuilt on the next commit
+		return
+	}
+
+	idx.building = true
+	go idx.build(idx.generation, iTree, version)
+}
+
+func (idx *fastIndex) build(generation uint64, iTree *iavl.ImmutableTree, version int64) {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "fast_index", "build")
+
+	leaves, err := buildLeaves(iTree)
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if idx.generation != generation {
+		return
+	}
+
+	idx.building = false
+	if err != nil {
+		// the version may have been pruned while building, the index is
+		// rebuilt on the next commit
+		idx.queued = nil
+		return
+	}
+
+	for _, cs := range idx.queued {
+		applyChangeSet(leaves, cs)
+		version = cs.version
+	}
+
+	idx.queued = nil
+	idx.primary = leaves
+	idx.publish(version)
+}
+
+// publish makes a snapshot of the primary index readable at the given version.
+//
+// CONTRACT: the caller must hold the write lock.
+func (idx *fastIndex) publish(version int64) {
+	idx.snapshot = idx.primary.Clone()
+	idx.version = version
+}
+
+// buildLeaves returns a btree holding all the leaves of the given tree, which
+// may be nil for an empty tree.
+func buildLeaves(iTree *iavl.ImmutableTree) (leaves *btree.BTree, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to build fast index: %v", r)
+		}
+	}()
+
+	leaves = btree.New(fastIndexDegree)
+	if iTree == nil {
+		return leaves, nil
+	}
+
+	iTree.Iterate(func(key, value []byte) bool {
+		leaves.ReplaceOrInsert(leaf{key: key, value: value})
+		return false
+	})
+
+	return leaves, nil
+}
+
+func applyChangeSet(leaves *btree.BTree, cs changeSet) {
+	for key, value := range cs.changes {
+		if value == nil {
+			leaves.Delete(leaf{key: []byte(key)})
+		} else {
+			leaves.ReplaceOrInsert(leaf{key: []byte(key), value: value})
+		}
+	}
+}
+
+// iterateLeaves calls fn for the leaves within [start, end) in the given order
+// until fn returns true.
+func iterateLeaves(leaves *btree.BTree, start, end []byte, ascending bool, fn func(kv.Pair) bool) {
+	visit := func(item btree.Item) bool {
+		l := item.(leaf)
+		return !fn(kv.Pair{Key: l.key, Value: l.value})
+	}
+
+	if ascending {
+		switch {
+		case start == nil && end == nil:
+			leaves.Ascend(visit)
+		case start == nil:
+			leaves.AscendLessThan(leaf{key: end}, visit)
+		case end == nil:
+			leaves.AscendGreaterOrEqual(leaf{key: start}, visit)
+		default:
+			leaves.AscendRange(leaf{key: start}, leaf{key: end}, visit)
+		}
+
+		return
+	}
+
+	// descending iteration is bounded by (greaterThan, lessOrEqual], so the end
+	// key is skipped and the start key checked explicitly
+	visitRange := func(item btree.Item) bool {
+		l := item.(leaf)
+		if end != nil && bytes.Equal(l.key, end) {
+			return true
+		}
+
+		if start != nil && bytes.Compare(l.key, start) < 0 {
+			return false
+		}
+
+		return visit(item)
+	}
+
+	if end == nil {
+		leaves.Descend(visitRange)
+	} else {
+		leaves.DescendLessOrEqual(leaf{key: end}, visitRange)
+	}
+}
@@ -0,0 +1,175 @@
+package iavl
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/iavl"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func requireFastIndexBuilt(t *testing.T, store *Store) *Store {
+	version := store.LastCommitID().Version
+
+	require.Eventually(t, func() bool {
+		return store.index.get(version) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	iStore, err := store.GetImmutable(version)
+	require.NoError(t, err)
+	require.NotNil(t, iStore.leaves)
+
+	return iStore
+}
+
+func requireSameContents(t *testing.T, expected, actual types.KVStore) {
+	keys := [][]byte{nil, []byte("key05"), []byte("key10"), []byte("key105"), []byte("key17"), []byte("zzz")}
+
+	for _, start := range keys {
+		for _, end := range keys {
+			require.Equal(t, collectKVs(expected.Iterator(start, end)), collectKVs(actual.Iterator(start, end)),
+				"iterator [%s, %s)", start, end)
+			require.Equal(t, collectKVs(expected.ReverseIterator(start, end)), collectKVs(actual.ReverseIterator(start, end)),
+				"reverse iterator [%s, %s)", start, end)
+		}
+
+		if start != nil {
+			require.Equal(t, expected.Get(start), actual.Get(start))
+			require.Equal(t, expected.Has(start), actual.Has(start))
+		}
+	}
+}
+
+func collectKVs(itr types.Iterator) []string {
+	defer itr.Close()
+
+	var kvs []string
+	for ; itr.Valid(); itr.Next() {
+		kvs = append(kvs, fmt.Sprintf("%s=%s", itr.Key(), itr.Value()))
+	}
+
+	return kvs
+}
+
+func TestFastIndex(t *testing.T) {
+	tree, err := iavl.NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.NoError(t, err)
+
+	store := UnsafeNewStore(tree)
+	for i := 0; i < 20; i++ {
+		store.Set([]byte(fmt.Sprintf("key%02d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	store.Commit()
+
+	store.EnableFastIndex()
+
+	// commits landing while the index is built are applied once it is done
+	store.Set([]byte("key05"), []byte("updated"))
+	store.Delete([]byte("key10"))
+	cid := store.Commit()
+
+	fastStore := requireFastIndexBuilt(t, store)
+	treeStore := &Store{tree: fastStore.tree}
+	requireSameContents(t, treeStore, fastStore)
+	require.Equal(t, []byte("updated"), fastStore.Get([]byte("key05")))
+	require.False(t, fastStore.Has([]byte("key10")))
+
+	// uncommitted writes are not visible until committed
+	store.Set([]byte("key105"), []byte("new"))
+	store.Delete([]byte("key17"))
+	require.Equal(t, fastStore.leaves, store.index.get(cid.Version))
+
+	store.Commit()
+	fastStore = requireFastIndexBuilt(t, store)
+	require.Equal(t, []byte("new"), fastStore.Get([]byte("key105")))
+	require.Nil(t, fastStore.Get([]byte("key17")))
+	requireSameContents(t, &Store{tree: fastStore.tree}, fastStore)
+
+	// previous versions are served from the tree
+	oldStore, err := store.GetImmutable(cid.Version)
+	require.NoError(t, err)
+	require.Nil(t, oldStore.leaves)
+	require.Equal(t, []byte("value17"), oldStore.Get([]byte("key17")))
+}
+
+func TestFastIndexInvalidation(t *testing.T) {
+	tree, err := iavl.NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.NoError(t, err)
+
+	store := UnsafeNewStore(tree)
+	store.EnableFastIndex()
+	store.Set([]byte("key01"), []byte("value1"))
+	store.Commit()
+	requireFastIndexBuilt(t, store)
+
+	store.index.invalidate()
+	iStore, err := store.GetImmutable(store.LastCommitID().Version)
+	require.NoError(t, err)
+	require.Nil(t, iStore.leaves)
+
+	// the index is rebuilt on the next commit
+	store.Set([]byte("key02"), []byte("value2"))
+	store.Commit()
+
+	fastStore := requireFastIndexBuilt(t, store)
+	require.Equal(t, []byte("value1"), fastStore.Get([]byte("key01")))
+	require.Equal(t, []byte("value2"), fastStore.Get([]byte("key02")))
+}
+
+func TestFastIndexImportThenCommit(t *testing.T) {
+	source, err := iavl.NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		source.Set([]byte(fmt.Sprintf("key%02d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	_, version, err := source.SaveVersion()
+	require.NoError(t, err)
+
+	tree, err := iavl.NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.NoError(t, err)
+
+	// the writes of the commits are batched, builds wait until they are written
+	var builds []func()
+
+	store := UnsafeNewStore(tree)
+	store.EnableFastIndex()
+	store.DeferFastIndexBuilds(func(build func()) { builds = append(builds, build) })
+
+	importer, err := store.Import(version)
+	require.NoError(t, err)
+
+	iTree, err := source.GetImmutable(version)
+	require.NoError(t, err)
+
+	exporter := iTree.Export()
+	for {
+		node, err := exporter.Next()
+		if err == iavl.ExportDone {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, importer.Add(node))
+	}
+	exporter.Close()
+	require.NoError(t, importer.Commit())
+	importer.Close()
+
+	// the index is rebuilt once the writes of the next commit are written
+	store.Set([]byte("key05"), []byte("updated"))
+	cid := store.Commit()
+	require.Len(t, builds, 1)
+	require.False(t, store.index.building)
+	require.Nil(t, store.index.get(cid.Version))
+
+	builds[0]()
+
+	fastStore := requireFastIndexBuilt(t, store)
+	requireSameContents(t, &Store{tree: fastStore.tree}, fastStore)
+	require.Equal(t, []byte("updated"), fastStore.Get([]byte("key05")))
+	require.Equal(t, []byte("value17"), fastStore.Get([]byte("key17")))
+}
@@ -9,6 +9,7 @@ import (
 
 	ics23 "github.com/confio/ics23/go"
 	"github.com/cosmos/iavl"
+	"github.com/google/btree"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	dbm "github.com/tendermint/tm-db"
@@ -37,6 +38,11 @@ var (
 // Store Implements types.KVStore and CommitKVStore.
 type Store struct {
 	tree Tree
+
+	// index is the fast index of the latest version, if enabled
+	index *fastIndex
+	// leaves is the fast index snapshot of an immutable store, if available
+	leaves *btree.BTree
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally, it will load the
@@ -98,9 +104,35 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 		return nil, err
 	}
 
-	return &Store{
+	store := &Store{
 		tree: &immutableTree{iTree},
-	}, nil
+	}
+
+	if st.index != nil {
+		store.leaves = st.index.get(version)
+	}
+
+	return store, nil
+}
+
+// EnableFastIndex enables an in-memory index of the leaves of the latest
+// version. The immutable stores of the latest version returned by GetImmutable
+// serve Get, Has and iteration from the index instead of traversing the tree.
+// The index is built in the background: until it is, reads go to the tree.
+func (st *Store) EnableFastIndex() {
+	if st.index == nil {
+		st.index = newFastIndex(st.tree)
+	}
+}
+
+// DeferFastIndexBuilds makes the builds of the fast index started by commits,
+// e.g. after an import, wait until afterWrite runs them once the writes of the
+// commit are written to the database. It must be set when the writes of the
+// commits are batched and written after Commit returns.
+func (st *Store) DeferFastIndexBuilds(afterWrite func(build func())) {
+	if st.index != nil {
+		st.index.deferBuilds(afterWrite)
+	}
 }
 
 // Commit commits the current store state and returns a CommitID with the new
@@ -113,6 +145,10 @@ func (st *Store) Commit() types.CommitID {
 		panic(err)
 	}
 
+	if st.index != nil {
+		st.index.commit(version)
+	}
+
 	return types.CommitID{
 		Version: version,
 		Hash:    hash,
@@ -164,11 +200,24 @@ func (st *Store) Set(key, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
 	st.tree.Set(key, value)
+
+	if st.index != nil {
+		st.index.set(key, value)
+	}
 }
 
 // Implements types.KVStore.
 func (st *Store) Get(key []byte) []byte {
 	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "get")
+	if st.leaves != nil {
+		item := st.leaves.Get(leaf{key: key})
+		if item == nil {
+			return nil
+		}
+
+		return item.(leaf).value
+	}
+
 	_, value := st.tree.Get(key)
 	return value
 }
@@ -176,6 +225,10 @@ func (st *Store) Get(key []byte) []byte {
 // Implements types.KVStore.
 func (st *Store) Has(key []byte) (exists bool) {
 	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "has")
+	if st.leaves != nil {
+		return st.leaves.Has(leaf{key: key})
+	}
+
 	return st.tree.Has(key)
 }
 
@@ -183,6 +236,10 @@ func (st *Store) Has(key []byte) (exists bool) {
 func (st *Store) Delete(key []byte) {
 	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "delete")
 	st.tree.Remove(key)
+
+	if st.index != nil {
+		st.index.remove(key)
+	}
 }
 
 // DeleteVersions deletes a series of versions from the MutableTree. An error
@@ -194,6 +251,10 @@ func (st *Store) DeleteVersions(versions ...int64) error {
 
 // Implements types.KVStore.
 func (st *Store) Iterator(start, end []byte) types.Iterator {
+	if st.leaves != nil {
+		return newFastIndexIterator(st.leaves, start, end, true)
+	}
+
 	var iTree *iavl.ImmutableTree
 
 	switch tree := st.tree.(type) {
@@ -208,6 +269,10 @@ func (st *Store) Iterator(start, end []byte) types.Iterator {
 
 // Implements types.KVStore.
 func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	if st.leaves != nil {
+		return newFastIndexIterator(st.leaves, start, end, false)
+	}
+
 	var iTree *iavl.ImmutableTree
 
 	switch tree := st.tree.(type) {
@@ -245,6 +310,11 @@ func (st *Store) Import(version int64) (*iavl.Importer, error) {
 	if !ok {
 		return nil, errors.New("iavl import failed: unable to find mutable tree")
 	}
+
+	if st.index != nil {
+		st.index.invalidate()
+	}
+
 	return tree.Import(version)
 }
 
@@ -381,6 +451,9 @@ type iavlIterator struct {
 	// Underlying store
 	tree *iavl.ImmutableTree
 
+	// Fast index snapshot iterated instead of the tree, if set
+	leaves *btree.BTree
+
 	// Channel to push iteration values.
 	iterCh chan kv.Pair
 
@@ -417,19 +490,46 @@ func newIAVLIterator(tree *iavl.ImmutableTree, start, end []byte, ascending bool
 	return iter
 }
 
+// newFastIndexIterator will create a new iavlIterator over a fast index
+// snapshot.
+// CONTRACT: Caller must release the iavlIterator, as each one creates a new
+// goroutine.
+func newFastIndexIterator(leaves *btree.BTree, start, end []byte, ascending bool) *iavlIterator {
+	iter := &iavlIterator{
+		leaves:    leaves,
+		start:     sdk.CopyBytes(start),
+		end:       sdk.CopyBytes(end),
+		ascending: ascending,
+		iterCh:    make(chan kv.Pair),
+		quitCh:    make(chan struct{}),
+		initCh:    make(chan struct{}),
+	}
+	go iter.iterateRoutine()
+	go iter.initRoutine()
+	return iter
+}
+
 // Run this to funnel items from the tree to iterCh.
 func (iter *iavlIterator) iterateRoutine() {
-	iter.tree.IterateRange(
-		iter.start, iter.end, iter.ascending,
-		func(key, value []byte) bool {
-			select {
-			case <-iter.quitCh:
-				return true // done with iteration.
-			case iter.iterCh <- kv.Pair{Key: key, Value: value}:
-				return false // yay.
-			}
-		},
-	)
+	send := func(pair kv.Pair) bool {
+		select {
+		case <-iter.quitCh:
+			return true // done with iteration.
+		case iter.iterCh <- pair:
+			return false // yay.
+		}
+	}
+
+	if iter.leaves != nil {
+		iterateLeaves(iter.leaves, iter.start, iter.end, iter.ascending, send)
+	} else {
+		iter.tree.IterateRange(
+			iter.start, iter.end, iter.ascending,
+			func(key, value []byte) bool {
+				return send(kv.Pair{Key: key, Value: value})
+			},
+		)
+	}
 	close(iter.iterCh) // done.
 }
 
@@ -0,0 +1,245 @@
+package rootmulti
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+var (
+	errBatchClosed = errors.New("batch has been written or closed")
+	errKeyEmpty    = errors.New("key cannot be empty")
+	errValueNil    = errors.New("value cannot be nil")
+)
+
+// commitBatchDB wraps the database of the root multi-store so that the writes
+// of all the batches written during a Commit, i.e. the writes of every IAVL
+// sub-store and the commit metadata, are accumulated into a single batch which
+// is written once the commit is done. Batches written outside of a commit are
+// written directly to the underlying database.
+//
+// Writing a single batch per commit reduces the number of database writes
+// (and fsyncs) per block, which matters on slow disks. When asyncFsync is set
+// the commit batch is written without waiting for it to be synced to disk: a
+// crash of the operating system may then lose the latest committed heights,
+// which must be replayed from Tendermint on restart.
+type commitBatchDB struct {
+	dbm.DB
+
+	mtx        sync.Mutex
+	asyncFsync bool
+	pending    dbm.Batch // non-nil while a commit is in progress
+	hooks      []func()  // run once the commit in progress is written
+}
+
+func newCommitBatchDB(db dbm.DB, asyncFsync bool) *commitBatchDB {
+	return &commitBatchDB{DB: db, asyncFsync: asyncFsync}
+}
+
+// NewBatch implements dbm.DB.
+func (db *commitBatchDB) NewBatch() dbm.Batch {
+	return &deferredBatch{db: db}
+}
+
+// begin starts accumulating the writes of all batches into the commit batch.
+func (db *commitBatchDB) begin() {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	if db.pending == nil {
+		db.pending = db.DB.NewBatch()
+	}
+}
+
+// flush writes the writes accumulated so far. The commit stays in progress.
+func (db *commitBatchDB) flush() error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	if err := db.writePending(); err != nil {
+		return err
+	}
+
+	db.pending = db.DB.NewBatch()
+	return nil
+}
+
+// end writes the accumulated writes and ends the commit. The hooks registered
+// by afterEnd during the commit are then run.
+func (db *commitBatchDB) end() error {
+	db.mtx.Lock()
+
+	err := db.writePending()
+	hooks := db.hooks
+	db.hooks = nil
+
+	db.mtx.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		hook()
+	}
+
+	return nil
+}
+
+// afterEnd runs hook once the writes of the commit in progress are written by
+// end, or right away if no commit is in progress.
+func (db *commitBatchDB) afterEnd(hook func()) {
+	db.mtx.Lock()
+
+	if db.pending != nil {
+		db.hooks = append(db.hooks, hook)
+		db.mtx.Unlock()
+
+		return
+	}
+
+	db.mtx.Unlock()
+	hook()
+}
+
+func (db *commitBatchDB) writePending() error {
+	if db.pending == nil {
+		return nil
+	}
+
+	defer telemetry.MeasureSince(time.Now(), "store", "rootmulti", "flush")
+
+	batch := db.pending
+	db.pending = nil
+	defer batch.Close()
+
+	if db.asyncFsync {
+		return batch.Write()
+	}
+
+	return batch.WriteSync()
+}
+
+// apply adds the given operations to the commit batch if a commit is in
+// progress, and writes them directly to the underlying database otherwise.
+func (db *commitBatchDB) apply(ops []batchOp, sync bool) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	batch := db.pending
+	if batch == nil {
+		batch = db.DB.NewBatch()
+		defer batch.Close()
+	}
+
+	for _, op := range ops {
+		var err error
+		if op.delete {
+			err = batch.Delete(op.key)
+		} else {
+			err = batch.Set(op.key, op.value)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if db.pending != nil {
+		return nil
+	}
+
+	if sync {
+		return batch.WriteSync()
+	}
+
+	return batch.Write()
+}
+
+type batchOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// deferredBatch records its operations until it is written to its
+// commitBatchDB.
+type deferredBatch struct {
+	db     *commitBatchDB
+	ops    []batchOp
+	closed bool
+}
+
+var _ dbm.Batch = (*deferredBatch)(nil)
+
+// Set implements dbm.Batch.
+func (b *deferredBatch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+
+	if value == nil {
+		return errValueNil
+	}
+
+	if b.closed {
+		return errBatchClosed
+	}
+
+	b.ops = append(b.ops, batchOp{key: copyBytes(key), value: copyBytes(value)})
+	return nil
+}
+
+// Delete implements dbm.Batch.
+func (b *deferredBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+
+	if b.closed {
+		return errBatchClosed
+	}
+
+	b.ops = append(b.ops, batchOp{key: copyBytes(key), delete: true})
+	return nil
+}
+
+// Write implements dbm.Batch.
+func (b *deferredBatch) Write() error {
+	return b.write(false)
+}
+
+// WriteSync implements dbm.Batch.
+func (b *deferredBatch) WriteSync() error {
+	return b.write(true)
+}
+
+func (b *deferredBatch) write(sync bool) error {
+	if b.closed {
+		return errBatchClosed
+	}
+
+	err := b.db.apply(b.ops, sync)
+	b.Close()
+
+	return err
+}
+
+// Close implements dbm.Batch.
+func (b *deferredBatch) Close() error {
+	b.ops = nil
+	b.closed = true
+
+	return nil
+}
+
+func copyBytes(bz []byte) []byte {
+	cp := make([]byte, len(bz))
+	copy(cp, bz)
+
+	return cp
+}
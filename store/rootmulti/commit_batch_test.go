@@ -0,0 +1,138 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// countingDB counts the batches written to the wrapped database.
+type countingDB struct {
+	dbm.DB
+	writes, syncWrites int
+}
+
+func (db *countingDB) NewBatch() dbm.Batch {
+	return &countingBatch{Batch: db.DB.NewBatch(), db: db}
+}
+
+type countingBatch struct {
+	dbm.Batch
+	db *countingDB
+}
+
+func (b *countingBatch) Write() error {
+	b.db.writes++
+	return b.Batch.Write()
+}
+
+func (b *countingBatch) WriteSync() error {
+	b.db.syncWrites++
+	return b.Batch.WriteSync()
+}
+
+func TestMultiStoreCommitBatching(t *testing.T) {
+	for _, asyncFsync := range []bool{false, true} {
+		asyncFsync := asyncFsync
+		t.Run(fmt.Sprintf("async fsync %t", asyncFsync), func(t *testing.T) {
+			db := &countingDB{DB: dbm.NewMemDB()}
+			ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 0, 5))
+			ms.SetCommitBatching(asyncFsync)
+			require.NoError(t, ms.LoadLatestVersion())
+
+			expected := newMultiStoreWithMounts(dbm.NewMemDB(), types.NewPruningOptions(2, 0, 5))
+			require.NoError(t, expected.LoadLatestVersion())
+
+			for i := 1; i <= 10; i++ {
+				for _, s := range []*Store{ms, expected} {
+					s.getStoreByName("store1").(types.KVStore).Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+					s.getStoreByName("store2").(types.KVStore).Set([]byte("height"), []byte(fmt.Sprintf("%d", i)))
+				}
+
+				db.writes, db.syncWrites = 0, 0
+				require.Equal(t, expected.Commit(), ms.Commit())
+
+				// all the changes of a commit are written in a single batch,
+				// unless the stores were pruned in between
+				writes := 1
+				if i%5 == 0 {
+					writes = 2
+				}
+
+				if asyncFsync {
+					require.Equal(t, writes, db.writes)
+					require.Zero(t, db.syncWrites)
+				} else {
+					require.Equal(t, writes, db.syncWrites)
+					require.Zero(t, db.writes)
+				}
+			}
+
+			// "restart" without commit batching
+			restarted := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 0, 5))
+			require.NoError(t, restarted.LoadLatestVersion())
+			require.Equal(t, expected.LastCommitID(), restarted.LastCommitID())
+			require.Equal(t, []byte("10"), restarted.getStoreByName("store2").(types.KVStore).Get([]byte("height")))
+
+			_, err := restarted.CacheMultiStoreWithVersion(9)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommitBatchAfterEnd(t *testing.T) {
+	db := newCommitBatchDB(dbm.NewMemDB(), false)
+
+	// hooks run right away outside of a commit
+	ran := false
+	db.afterEnd(func() { ran = true })
+	require.True(t, ran)
+
+	// hooks run once the writes of the commit are written
+	db.begin()
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("key"), []byte("value")))
+	require.NoError(t, batch.Write())
+
+	var written []byte
+	db.afterEnd(func() {
+		var err error
+		written, err = db.DB.Get([]byte("key"))
+		require.NoError(t, err)
+	})
+	require.Nil(t, written)
+
+	require.NoError(t, db.end())
+	require.Equal(t, []byte("value"), written)
+}
+
+func TestMultiStoreCommitBatchingFastIndexRestore(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	target.SetCommitBatching(false)
+	target.SetIAVLFastIndex(true)
+	require.NoError(t, target.LoadLatestVersion())
+
+	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	require.NoError(t, target.Restore(version, snapshottypes.CurrentFormat, chunks, nil))
+
+	for _, s := range []*Store{source, target} {
+		s.getStoreByName("iavl1").(types.KVStore).Set([]byte("key"), []byte("value"))
+	}
+	require.Equal(t, source.Commit(), target.Commit())
+
+	for key, sourceStore := range source.stores {
+		if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+			assertStoresEqual(t, sourceStore, target.getStoreByName(key.Name()).(types.CommitKVStore), "store %q not equal", key.Name())
+		}
+	}
+}
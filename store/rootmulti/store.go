@@ -9,6 +9,7 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"time"
 
 	iavltree "github.com/cosmos/iavl"
 	protoio "github.com/gogo/protobuf/io"
@@ -26,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -58,6 +60,11 @@ type Store struct {
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	// commitBatchDB is set when commit batching is enabled
+	commitBatchDB *commitBatchDB
+
+	iavlFastIndex bool
 }
 
 var (
@@ -183,6 +190,12 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		}
 	}
 
+	// the inter-block cache must not keep wrapping the stores of a previously
+	// loaded version
+	if rs.interBlockCache != nil {
+		rs.interBlockCache.Reset()
+	}
+
 	// load each Store (note this doesn't panic on unmounted keys now)
 	var newStores = make(map[types.StoreKey]types.CommitKVStore)
 
@@ -284,6 +297,24 @@ func (rs *Store) SetInterBlockCache(c types.MultiStorePersistentCache) {
 	rs.interBlockCache = c
 }
 
+// SetCommitBatching enables writing all the changes of a commit to the
+// database in a single batch, synced to disk unless asyncFsync is set. It must
+// be called before the stores are loaded.
+func (rs *Store) SetCommitBatching(asyncFsync bool) {
+	if rs.commitBatchDB == nil {
+		rs.commitBatchDB = newCommitBatchDB(rs.db, asyncFsync)
+		rs.db = rs.commitBatchDB
+	}
+
+	rs.commitBatchDB.asyncFsync = asyncFsync
+}
+
+// SetIAVLFastIndex enables or disables the in-memory fast index of the latest
+// version of the IAVL stores. It must be called before the stores are loaded.
+func (rs *Store) SetIAVLFastIndex(enabled bool) {
+	rs.iavlFastIndex = enabled
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (rs *Store) SetTracer(w io.Writer) types.MultiStore {
@@ -325,6 +356,8 @@ func (rs *Store) LastCommitID() types.CommitID {
 
 // Commit implements Committer/CommitStore.
 func (rs *Store) Commit() types.CommitID {
+	defer telemetry.MeasureSince(time.Now(), "store", "rootmulti", "commit")
+
 	var previousHeight, version int64
 	if rs.lastCommitInfo.GetVersion() == 0 && rs.initialVersion > 1 {
 		// This case means that no commit has been made in the store, we
@@ -341,6 +374,10 @@ func (rs *Store) Commit() types.CommitID {
 		version = previousHeight + 1
 	}
 
+	if rs.commitBatchDB != nil {
+		rs.commitBatchDB.begin()
+	}
+
 	rs.lastCommitInfo = commitStores(version, rs.stores)
 
 	// Determine if pruneHeight height needs to be added to the list of heights to
@@ -359,11 +396,24 @@ func (rs *Store) Commit() types.CommitID {
 
 	// batch prune if the current height is a pruning interval height
 	if rs.pruningOpts.Interval > 0 && version%int64(rs.pruningOpts.Interval) == 0 {
+		// pruning reads the committed versions from the database
+		if rs.commitBatchDB != nil {
+			if err := rs.commitBatchDB.flush(); err != nil {
+				panic(fmt.Errorf("error on commit batch write %w", err))
+			}
+		}
+
 		rs.pruneStores()
 	}
 
 	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
 
+	if rs.commitBatchDB != nil {
+		if err := rs.commitBatchDB.end(); err != nil {
+			panic(fmt.Errorf("error on commit batch write %w", err))
+		}
+	}
+
 	return types.CommitID{
 		Version: version,
 		Hash:    rs.lastCommitInfo.Hash(),
@@ -838,6 +888,16 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 			return nil, err
 		}
 
+		if rs.iavlFastIndex {
+			store.(*iavl.Store).EnableFastIndex()
+
+			// the writes of the commits are only in the database once the
+			// commit batch is written
+			if rs.commitBatchDB != nil {
+				store.(*iavl.Store).DeferFastIndexBuilds(rs.commitBatchDB.afterEnd)
+			}
+		}
+
 		if rs.interBlockCache != nil {
 			// Wrap and get a CommitKVStore with inter-block caching. Note, this should
 			// only wrap the primary CommitKVStore, not any store that is already
@@ -894,6 +954,12 @@ type storeParams struct {
 	initialVersion uint64
 }
 
+// GetLatestVersion returns the latest version committed to the database of a
+// multistore, or 0 if none was committed.
+func GetLatestVersion(db dbm.DB) int64 {
+	return getLatestVersion(db)
+}
+
 func getLatestVersion(db dbm.DB) int64 {
 	bz, err := db.Get([]byte(latestVersionKey))
 	if err != nil {
@@ -178,6 +178,14 @@ type CommitMultiStore interface {
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
 
+	// SetCommitBatching enables writing all the changes of a commit to the
+	// database in a single batch, synced to disk unless asyncFsync is set.
+	SetCommitBatching(asyncFsync bool)
+
+	// SetIAVLFastIndex enables or disables the in-memory fast index of the
+	// latest version of the IAVL stores.
+	SetIAVLFastIndex(enabled bool)
+
 	// SetInitialVersion sets the initial version of the IAVL tree. It is used when
 	// starting a new chain at an arbitrary height.
 	SetInitialVersion(version int64) error
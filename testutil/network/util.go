@@ -71,9 +71,28 @@ func startInProcess(cfg Config, val *Validator) error {
 		app.RegisterTendermintService(val.ClientCtx)
 	}
 
+	if val.AppConfig.GRPC.Enable {
+		grpcSrv, err := servergrpc.StartGRPCServer(val.ClientCtx, app, val.AppConfig.GRPC.Address)
+		if err != nil {
+			return err
+		}
+
+		val.grpc = grpcSrv
+
+		if val.AppConfig.GRPCWeb.Enable {
+			val.grpcWeb, err = servergrpc.StartGRPCWeb(grpcSrv, *val.AppConfig)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	if val.APIAddress != "" {
 		apiSrv := api.New(val.ClientCtx, logger.With("module", "api-server"))
 		app.RegisterAPIRoutes(apiSrv, val.AppConfig.API)
+		if val.grpc != nil && val.AppConfig.GRPCWeb.Enable {
+			apiSrv.SetGRPCWebServer(servergrpc.NewGRPCWebServer(val.grpc, val.AppConfig.GRPCWeb))
+		}
 
 		errCh := make(chan error)
 
@@ -92,33 +111,6 @@ func startInProcess(cfg Config, val *Validator) error {
 		val.api = apiSrv
 	}
 
-	if val.AppConfig.GRPC.Enable {
-		grpcSrv, err := servergrpc.StartGRPCServer(val.ClientCtx, app, val.AppConfig.GRPC.Address)
-		if err != nil {
-			return err
-		}
-
-		val.grpc = grpcSrv
-
-		if val.AppConfig.GRPCWeb.Enable {
-			errCh1 := make(chan error)
-			go func() {
-				grpcWeb, err := servergrpc.StartGRPCWeb(grpcSrv, *val.AppConfig)
-				if err != nil {
-					errCh1 <- err
-				}
-
-				val.grpcWeb = grpcWeb
-			}()
-			select {
-			case err := <-errCh1:
-				return err
-			case <-time.After(5 * time.Second): // assume server started successfully
-			}
-
-		}
-	}
-
 	return nil
 }
 
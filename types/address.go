@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -84,6 +85,15 @@ var (
 	consAddrCache *simplelru.LRU
 	valAddrMu     sync.Mutex
 	valAddrCache  *simplelru.LRU
+
+	// AccAddressFromBech32 is the counterpart of AccAddress.String() when decoding
+	// messages and query requests, so that its results are cached as well.
+	accAddrBech32Mu    sync.Mutex
+	accAddrBech32Cache *simplelru.LRU
+
+	// addrCacheDisabled is set to 1 when the address caches are disabled. It is
+	// accessed atomically.
+	addrCacheDisabled int32
 )
 
 func init() {
@@ -99,6 +109,48 @@ func init() {
 	if valAddrCache, err = simplelru.NewLRU(500, nil); err != nil {
 		panic(err)
 	}
+	// 20k entries, with keys of around 50-70 bytes and values of 32 bytes, i.e. ~ 4 MB
+	if accAddrBech32Cache, err = simplelru.NewLRU(20000, nil); err != nil {
+		panic(err)
+	}
+}
+
+// SetAddrCacheEnabled enables or disables the caches of the bech32 encoding of
+// AccAddress, ValAddress and ConsAddress and of the decoding of AccAddress.
+// Disabling them purges their entries. The caches are enabled by default.
+func SetAddrCacheEnabled(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&addrCacheDisabled, 0)
+		return
+	}
+
+	atomic.StoreInt32(&addrCacheDisabled, 1)
+
+	for _, c := range []struct {
+		mu    *sync.Mutex
+		cache *simplelru.LRU
+	}{
+		{&accAddrMu, accAddrCache},
+		{&consAddrMu, consAddrCache},
+		{&valAddrMu, valAddrCache},
+		{&accAddrBech32Mu, accAddrBech32Cache},
+	} {
+		c.mu.Lock()
+		c.cache.Purge()
+		c.mu.Unlock()
+	}
+}
+
+// IsAddrCacheEnabled returns true if the address caches are enabled.
+func IsAddrCacheEnabled() bool {
+	return atomic.LoadInt32(&addrCacheDisabled) == 0
+}
+
+// accAddrBech32Entry is an entry of the AccAddressFromBech32 cache, holding the
+// bech32 prefix the address was decoded with.
+type accAddrBech32Entry struct {
+	prefix string
+	bz     []byte
 }
 
 // Address is a common interface for different types of addresses used by the SDK
@@ -163,7 +215,7 @@ func AccAddressFromBech32(address string) (addr AccAddress, err error) {
 
 	bech32PrefixAccAddr := GetConfig().GetBech32AccountAddrPrefix()
 
-	bz, err := GetFromBech32(address, bech32PrefixAccAddr)
+	bz, err := accAddrBytesFromBech32(address, bech32PrefixAccAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +228,30 @@ func AccAddressFromBech32(address string) (addr AccAddress, err error) {
 	return AccAddress(bz), nil
 }
 
+// accAddrBytesFromBech32 decodes the bytes of a bech32 account address, using
+// the AccAddressFromBech32 cache if enabled. The returned bytes are owned by the
+// caller.
+func accAddrBytesFromBech32(address, prefix string) ([]byte, error) {
+	if !IsAddrCacheEnabled() {
+		return GetFromBech32(address, prefix)
+	}
+
+	accAddrBech32Mu.Lock()
+	defer accAddrBech32Mu.Unlock()
+
+	if entry, ok := accAddrBech32Cache.Get(address); ok && entry.(accAddrBech32Entry).prefix == prefix {
+		return append([]byte(nil), entry.(accAddrBech32Entry).bz...), nil
+	}
+
+	bz, err := GetFromBech32(address, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	accAddrBech32Cache.Add(address, accAddrBech32Entry{prefix, append([]byte(nil), bz...)})
+	return bz, nil
+}
+
 // Returns boolean for whether two AccAddresses are Equal
 func (aa AccAddress) Equals(aa2 Address) bool {
 	if aa.Empty() && aa2.Empty() {
@@ -267,13 +343,17 @@ func (aa AccAddress) String() string {
 		return ""
 	}
 
+	if !IsAddrCacheEnabled() {
+		return bech32Addr(GetConfig().GetBech32AccountAddrPrefix(), aa)
+	}
+
 	var key = conv.UnsafeBytesToStr(aa)
 	accAddrMu.Lock()
 	defer accAddrMu.Unlock()
 	if addr, ok := accAddrCache.Get(key); ok {
 		return addr.(string)
 	}
-	return cacheBech32Addr(GetConfig().GetBech32AccountAddrPrefix(), aa, accAddrCache, key)
+	return cacheBech32Addr(GetConfig().GetBech32AccountAddrPrefix(), aa, accAddrCache)
 }
 
 // Format implements the fmt.Formatter interface.
@@ -416,13 +496,17 @@ func (va ValAddress) String() string {
 		return ""
 	}
 
+	if !IsAddrCacheEnabled() {
+		return bech32Addr(GetConfig().GetBech32ValidatorAddrPrefix(), va)
+	}
+
 	var key = conv.UnsafeBytesToStr(va)
 	valAddrMu.Lock()
 	defer valAddrMu.Unlock()
 	if addr, ok := valAddrCache.Get(key); ok {
 		return addr.(string)
 	}
-	return cacheBech32Addr(GetConfig().GetBech32ValidatorAddrPrefix(), va, valAddrCache, key)
+	return cacheBech32Addr(GetConfig().GetBech32ValidatorAddrPrefix(), va, valAddrCache)
 }
 
 // Format implements the fmt.Formatter interface.
@@ -570,13 +654,17 @@ func (ca ConsAddress) String() string {
 		return ""
 	}
 
+	if !IsAddrCacheEnabled() {
+		return bech32Addr(GetConfig().GetBech32ConsensusAddrPrefix(), ca)
+	}
+
 	var key = conv.UnsafeBytesToStr(ca)
 	consAddrMu.Lock()
 	defer consAddrMu.Unlock()
 	if addr, ok := consAddrCache.Get(key); ok {
 		return addr.(string)
 	}
-	return cacheBech32Addr(GetConfig().GetBech32ConsensusAddrPrefix(), ca, consAddrCache, key)
+	return cacheBech32Addr(GetConfig().GetBech32ConsensusAddrPrefix(), ca, consAddrCache)
 }
 
 // Bech32ifyAddressBytes returns a bech32 representation of address bytes.
@@ -722,11 +810,18 @@ func addressBytesFromHexString(address string) ([]byte, error) {
 	return hex.DecodeString(address)
 }
 
-func cacheBech32Addr(prefix string, addr []byte, cache *simplelru.LRU, cacheKey string) string {
-	bech32Addr, err := bech32.ConvertAndEncode(prefix, addr)
+func bech32Addr(prefix string, addr []byte) string {
+	addrStr, err := bech32.ConvertAndEncode(prefix, addr)
 	if err != nil {
 		panic(err)
 	}
-	cache.Add(cacheKey, bech32Addr)
-	return bech32Addr
+	return addrStr
+}
+
+// cacheBech32Addr encodes and caches addr. The cache key is a copy of addr, as
+// the caller may modify it afterwards.
+func cacheBech32Addr(prefix string, addr []byte, cache *simplelru.LRU) string {
+	addrStr := bech32Addr(prefix, addr)
+	cache.Add(string(addr), addrStr)
+	return addrStr
 }
@@ -30,6 +30,19 @@ func BenchmarkAccAddressString(b *testing.B) {
 	require.NotEmpty(b, str2)
 }
 
+func BenchmarkAccAddressFromBech32(b *testing.B) {
+	b.ReportAllocs()
+	pk := &ed25519.PubKey{Key: make([]byte, ed25519.PubKeySize)}
+	str := types.AccAddress(pk.Address()).String()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := types.AccAddressFromBech32(str)
+		require.NoError(b, err)
+	}
+}
+
 func BenchmarkBech32ifyPubKey(b *testing.B) {
 	b.ReportAllocs()
 	pkBz := make([]byte, ed25519.PubKeySize)
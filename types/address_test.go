@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -166,6 +167,56 @@ func (s *addressTestSuite) TestRandBech32AccAddrConsistency() {
 	s.Require().Equal("decoding Bech32 address failed: must provide an address", err.Error())
 }
 
+func (s *addressTestSuite) TestAccAddrCache() {
+	pubBz := make([]byte, ed25519.PubKeySize)
+	pub := &ed25519.PubKey{Key: pubBz}
+
+	var acc types.AccAddress
+	for _, enabled := range []bool{true, false} {
+		types.SetAddrCacheEnabled(enabled)
+		s.Require().Equal(enabled, types.IsAddrCacheEnabled())
+
+		rand.Read(pub.Key)
+		acc = types.AccAddress(pub.Address())
+
+		// modifying an address must not affect the cached encoding of its bytes
+		modified := types.AccAddress(append([]byte(nil), acc...))
+		str := modified.String()
+		modified[0]++
+		s.Require().NotEqual(str, modified.String())
+		s.Require().Equal(str, acc.String())
+
+		// nor modifying a decoded address the cached decoding of its string
+		res, err := types.AccAddressFromBech32(str)
+		s.Require().NoError(err)
+		s.Require().Equal(acc, res)
+		res[0]++
+
+		res, err = types.AccAddressFromBech32(str)
+		s.Require().NoError(err)
+		s.Require().Equal(acc, res)
+	}
+
+	types.SetAddrCacheEnabled(true)
+
+	// addresses are encoded and decoded concurrently
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				res, err := types.AccAddressFromBech32(acc.String())
+				s.Require().NoError(err)
+				s.Require().Equal(acc, res)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
 func (s *addressTestSuite) TestValAddr() {
 	pubBz := make([]byte, ed25519.PubKeySize)
 	pub := &ed25519.PubKey{Key: pubBz}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
-	"strings"
 )
 
 //-----------------------------------------------------------------------------
@@ -255,51 +254,59 @@ func (coins Coins) Add(coinsB ...Coin) Coins {
 // denomination and addition only occurs when the denominations match, otherwise
 // the coin is simply added to the sum assuming it's not zero.
 func (coins Coins) safeAdd(coinsB Coins) Coins {
-	sum := ([]Coin)(nil)
+	return coins.merge(coinsB, false)
+}
+
+// merge adds coinsB to coins, or subtracts it if sub is true, in a single pass
+// over both sorted sets. Zero coins are left out of the result, which is nil
+// if empty. Coins that are not modified are shared with the operands rather
+// than copied, and no intermediate set is allocated.
+func (coins Coins) merge(coinsB Coins, sub bool) Coins {
 	indexA, indexB := 0, 0
 	lenA, lenB := len(coins), len(coinsB)
 
-	for {
-		if indexA == lenA {
-			if indexB == lenB {
-				// return nil coins if both sets are empty
-				return sum
-			}
-
-			// return set B (excluding zero coins) if set A is empty
-			return append(sum, removeZeroCoins(coinsB[indexB:])...)
-		} else if indexB == lenB {
-			// return set A (excluding zero coins) if set B is empty
-			return append(sum, removeZeroCoins(coins[indexA:])...)
-		}
+	var res []Coin
+	for indexA < lenA || indexB < lenB {
+		var coin Coin
 
-		coinA, coinB := coins[indexA], coinsB[indexB]
+		switch {
+		case indexB == lenB || (indexA < lenA && coins[indexA].Denom < coinsB[indexB].Denom):
+			coin = coins[indexA]
+			indexA++
 
-		switch strings.Compare(coinA.Denom, coinB.Denom) {
-		case -1: // coin A denom < coin B denom
-			if !coinA.IsZero() {
-				sum = append(sum, coinA)
+		case indexA == lenA || coins[indexA].Denom > coinsB[indexB].Denom:
+			coin = coinsB[indexB]
+			if sub && !coin.IsZero() {
+				coin = Coin{coin.Denom, coin.Amount.Neg()}
 			}
 
-			indexA++
+			indexB++
 
-		case 0: // coin A denom == coin B denom
-			res := coinA.Add(coinB)
-			if !res.IsZero() {
-				sum = append(sum, res)
+		default: // coin A denom == coin B denom
+			coinA, coinB := coins[indexA], coinsB[indexB]
+			if sub {
+				coin = Coin{coinA.Denom, coinA.Amount.Sub(coinB.Amount)}
+			} else {
+				coin = coinA.Add(coinB)
 			}
 
 			indexA++
 			indexB++
+		}
 
-		case 1: // coin A denom > coin B denom
-			if !coinB.IsZero() {
-				sum = append(sum, coinB)
-			}
+		if coin.IsZero() {
+			continue
+		}
 
-			indexB++
+		if res == nil {
+			res = make([]Coin, 0, lenA+lenB)
 		}
+
+		res = append(res, coin)
 	}
+
+	// res is nil if both sets are empty or only hold zero coins
+	return res
 }
 
 // DenomsSubsetOf returns true if receiver's denom set
@@ -311,7 +318,7 @@ func (coins Coins) DenomsSubsetOf(coinsB Coins) bool {
 	}
 
 	for _, coin := range coins {
-		if coinsB.AmountOf(coin.Denom).IsZero() {
+		if coinsB.amountOf(coin.Denom).IsZero() {
 			return false
 		}
 	}
@@ -340,7 +347,7 @@ func (coins Coins) Sub(coinsB Coins) Coins {
 // SafeSub performs the same arithmetic as Sub but returns a boolean if any
 // negative coin amount was returned.
 func (coins Coins) SafeSub(coinsB Coins) (Coins, bool) {
-	diff := coins.safeAdd(coinsB.negative())
+	diff := coins.merge(coinsB, true)
 	return diff, diff.IsAnyNegative()
 }
 
@@ -360,7 +367,7 @@ func (coins Coins) IsAllGT(coinsB Coins) bool {
 	}
 
 	for _, coinB := range coinsB {
-		amountA, amountB := coins.AmountOf(coinB.Denom), coinB.Amount
+		amountA, amountB := coins.amountOf(coinB.Denom), coinB.Amount
 		if !amountA.GT(amountB) {
 			return false
 		}
@@ -372,6 +379,10 @@ func (coins Coins) IsAllGT(coinsB Coins) bool {
 // IsAllGTE returns false if for any denom in coinsB,
 // the denom is present at a smaller amount in coins;
 // else returns true.
+//
+// NOTE: IsAllGTE operates under the invariant that coins are sorted by
+// denominations. coinsB may be unsorted, each of its denoms is looked up in
+// coins.
 func (coins Coins) IsAllGTE(coinsB Coins) bool {
 	if len(coinsB) == 0 {
 		return true
@@ -416,7 +427,7 @@ func (coins Coins) IsAnyGT(coinsB Coins) bool {
 	}
 
 	for _, coin := range coins {
-		amt := coinsB.AmountOf(coin.Denom)
+		amt := coinsB.amountOf(coin.Denom)
 		if coin.Amount.GT(amt) && !amt.IsZero() {
 			return true
 		}
@@ -436,7 +447,7 @@ func (coins Coins) IsAnyGTE(coinsB Coins) bool {
 	}
 
 	for _, coin := range coins {
-		amt := coinsB.AmountOf(coin.Denom)
+		amt := coinsB.amountOf(coin.Denom)
 		if coin.Amount.GTE(amt) && !amt.IsZero() {
 			return true
 		}
@@ -481,30 +492,30 @@ func (coins Coins) Empty() bool {
 // AmountOf returns the amount of a denom from coins
 func (coins Coins) AmountOf(denom string) Int {
 	mustValidateDenom(denom)
+	return coins.amountOf(denom)
+}
 
-	switch len(coins) {
-	case 0:
-		return ZeroInt()
-
-	case 1:
-		coin := coins[0]
-		if coin.Denom == denom {
-			return coin.Amount
-		}
-		return ZeroInt()
-
-	default:
+// amountOf returns the amount of a denom from coins, which are sorted, without
+// validating the denom.
+func (coins Coins) amountOf(denom string) Int {
+	for len(coins) > 1 {
 		midIdx := len(coins) / 2 // 2:1, 3:1, 4:2
 		coin := coins[midIdx]
 		switch {
 		case denom < coin.Denom:
-			return coins[:midIdx].AmountOf(denom)
+			coins = coins[:midIdx]
 		case denom == coin.Denom:
 			return coin.Amount
 		default:
-			return coins[midIdx+1:].AmountOf(denom)
+			coins = coins[midIdx+1:]
 		}
 	}
+
+	if len(coins) == 1 && coins[0].Denom == denom {
+		return coins[0].Amount
+	}
+
+	return ZeroInt()
 }
 
 // GetDenomByIndex returns the Denom of the certain coin to make the findDup generic
@@ -543,22 +554,6 @@ func (coins Coins) IsAnyNegative() bool {
 	return false
 }
 
-// negative returns a set of coins with all amount negative.
-//
-// TODO: Remove once unsigned integers are used.
-func (coins Coins) negative() Coins {
-	res := make([]Coin, 0, len(coins))
-
-	for _, coin := range coins {
-		res = append(res, Coin{
-			Denom:  coin.Denom,
-			Amount: coin.Amount.Neg(),
-		})
-	}
-
-	return res
-}
-
 // removeZeroCoins removes all zero coins from the given coin set in-place.
 func removeZeroCoins(coins Coins) Coins {
 	result := make([]Coin, 0, len(coins))
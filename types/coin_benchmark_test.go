@@ -68,3 +68,63 @@ func BenchmarkCoinsAdditionNoIntersect(b *testing.B) {
 		b.Run(fmt.Sprintf("sizes: A_%d, B_%d", sizeA, sizeB), benchmarkingFunc(sizeA, sizeB))
 	}
 }
+
+func BenchmarkCoinsSubtraction(b *testing.B) {
+	b.ReportAllocs()
+	benchmarkingFunc := func(numCoinsA int, numCoinsB int) func(b *testing.B) {
+		return func(b *testing.B) {
+			coinsA := Coins(make([]Coin, numCoinsA))
+			coinsB := Coins(make([]Coin, numCoinsB))
+
+			for i := 0; i < numCoinsA; i++ {
+				coinsA[i] = NewCoin(coinName(i), NewInt(int64(i+1)*2))
+			}
+			for i := 0; i < numCoinsB; i++ {
+				coinsB[i] = NewCoin(coinName(i), NewInt(int64(i+1)))
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				coinsA.Sub(coinsB)
+			}
+		}
+	}
+
+	benchmarkSizes := [][]int{{1, 1}, {5, 5}, {20, 5}, {1000, 1}, {1000, 1000}}
+	for i := 0; i < len(benchmarkSizes); i++ {
+		sizeA := benchmarkSizes[i][0]
+		sizeB := benchmarkSizes[i][1]
+		b.Run(fmt.Sprintf("sizes: A_%d, B_%d", sizeA, sizeB), benchmarkingFunc(sizeA, sizeB))
+	}
+}
+
+func BenchmarkCoinsIsAllGTE(b *testing.B) {
+	b.ReportAllocs()
+	benchmarkingFunc := func(numCoinsA int, numCoinsB int) func(b *testing.B) {
+		return func(b *testing.B) {
+			coinsA := Coins(make([]Coin, numCoinsA))
+			coinsB := Coins(make([]Coin, numCoinsB))
+
+			for i := 0; i < numCoinsA; i++ {
+				coinsA[i] = NewCoin(coinName(i), NewInt(int64(i+1)))
+			}
+			for i := 0; i < numCoinsB; i++ {
+				coinsB[i] = NewCoin(coinName(i), NewInt(int64(i+1)))
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				coinsA.IsAllGTE(coinsB)
+			}
+		}
+	}
+
+	benchmarkSizes := [][]int{{1, 1}, {5, 5}, {20, 5}, {1000, 1}, {1000, 1000}}
+	for i := 0; i < len(benchmarkSizes); i++ {
+		sizeA := benchmarkSizes[i][0]
+		sizeB := benchmarkSizes[i][1]
+		b.Run(fmt.Sprintf("sizes: A_%d, B_%d", sizeA, sizeB), benchmarkingFunc(sizeA, sizeB))
+	}
+}
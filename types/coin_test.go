@@ -423,6 +423,37 @@ func (s *coinTestSuite) TestSubCoins() {
 	}
 }
 
+func (s *coinTestSuite) TestSafeSubCoins() {
+	zero := sdk.NewInt(0)
+	one := sdk.OneInt()
+	two := sdk.NewInt(2)
+
+	testCases := []struct {
+		inputOne sdk.Coins
+		inputTwo sdk.Coins
+		expected sdk.Coins
+		hasNeg   bool
+	}{
+		{sdk.Coins{{testDenom1, two}}, sdk.Coins{{testDenom1, one}, {testDenom2, two}}, sdk.Coins{{testDenom1, one}, {testDenom2, two.Neg()}}, true},
+		{sdk.Coins{{testDenom1, one}, {testDenom2, one}}, sdk.Coins{{testDenom1, two}}, sdk.Coins{{testDenom1, one.Neg()}, {testDenom2, one}}, true},
+		{sdk.Coins{{testDenom1, one}, {testDenom2, one}}, sdk.Coins{{testDenom1, one}, {testDenom2, zero}}, sdk.Coins{{testDenom2, one}}, false},
+		{sdk.Coins{{testDenom1, one}}, sdk.Coins{{testDenom1, one}}, sdk.Coins(nil), false},
+		{sdk.Coins{}, sdk.Coins{{testDenom1, zero}}, sdk.Coins(nil), false},
+	}
+
+	for i, tc := range testCases {
+		inputOne, inputTwo := tc.inputOne.String(), tc.inputTwo.String()
+
+		res, hasNeg := tc.inputOne.SafeSub(tc.inputTwo)
+		s.Require().Equal(tc.hasNeg, hasNeg, "tc #%d", i)
+		s.Require().Equal(tc.expected, res, "difference of coins is incorrect, tc #%d", i)
+
+		// operands are left untouched
+		s.Require().Equal(inputOne, tc.inputOne.String(), "tc #%d", i)
+		s.Require().Equal(inputTwo, tc.inputTwo.String(), "tc #%d", i)
+	}
+}
+
 func (s *coinTestSuite) TestCoins_Validate() {
 	testCases := []struct {
 		name    string
@@ -845,6 +876,12 @@ func (s *coinTestSuite) TestCoinsIsAllGTE() {
 	s.Require().True(sdk.Coins{{testDenom1, one}, {testDenom2, two}}.IsAllGTE(sdk.Coins{{testDenom1, one}, {testDenom2, one}}))
 	s.Require().False(sdk.Coins{{testDenom1, one}, {testDenom2, one}}.IsAllGTE(sdk.Coins{{testDenom1, one}, {testDenom2, two}}))
 	s.Require().False(sdk.Coins{{"xxx", one}, {"yyy", one}}.IsAllGTE(sdk.Coins{{testDenom2, one}, {"ccc", one}, {"yyy", one}, {"zzz", one}}))
+	s.Require().True(sdk.Coins{{"aaa", two}, {"bbb", one}, {"ccc", two}}.IsAllGTE(sdk.Coins{{"aaa", one}, {"ccc", two}}))
+	s.Require().False(sdk.Coins{{"aaa", two}, {"ccc", two}}.IsAllGTE(sdk.Coins{{"aaa", one}, {"bbb", one}, {"ccc", two}}))
+
+	// coinsB need not be sorted
+	s.Require().True(sdk.Coins{{testDenom1, two}, {testDenom2, two}}.IsAllGTE(sdk.Coins{{testDenom2, one}, {testDenom1, one}}))
+	s.Require().False(sdk.Coins{{testDenom1, two}, {testDenom2, one}}.IsAllGTE(sdk.Coins{{testDenom2, two}, {testDenom1, one}}))
 }
 
 func (s *coinTestSuite) TestNewCoins() {
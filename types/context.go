@@ -36,6 +36,10 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	features      FeatureActivations
+
+	kvGasConfig          stypes.GasConfig
+	transientKVGasConfig stypes.GasConfig
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -57,6 +61,24 @@ func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
 
+// KVGasConfig returns the gas costs of the operations on the KVStores of the
+// context.
+func (c Context) KVGasConfig() stypes.GasConfig { return c.kvGasConfig }
+
+// TransientKVGasConfig returns the gas costs of the operations on the
+// transient stores of the context.
+func (c Context) TransientKVGasConfig() stypes.GasConfig { return c.transientKVGasConfig }
+
+// FeatureActivations returns the protocol feature activation schedule.
+func (c Context) FeatureActivations() FeatureActivations { return c.features }
+
+// IsFeatureEnabled returns true if the given protocol feature is activated at
+// the context's block height. In CheckTx and query contexts the block height is
+// the one of the last committed block, or the queried height respectively.
+func (c Context) IsFeatureEnabled(name string) bool {
+	return c.features.IsActive(name, c.header.Height)
+}
+
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
 	var msg = proto.Clone(&c.header).(*tmproto.Header)
@@ -81,6 +103,9 @@ func NewContext(ms MultiStore, header tmproto.Header, isCheckTx bool, logger log
 		gasMeter:     stypes.NewInfiniteGasMeter(),
 		minGasPrice:  DecCoins{},
 		eventManager: NewEventManager(),
+
+		kvGasConfig:          stypes.KVGasConfig(),
+		transientKVGasConfig: stypes.TransientGasConfig(),
 	}
 }
 
@@ -162,6 +187,20 @@ func (c Context) WithBlockGasMeter(meter GasMeter) Context {
 	return c
 }
 
+// WithKVGasConfig returns a Context with an updated gas configuration for
+// the KVStores.
+func (c Context) WithKVGasConfig(gasConfig stypes.GasConfig) Context {
+	c.kvGasConfig = gasConfig
+	return c
+}
+
+// WithTransientKVGasConfig returns a Context with an updated gas
+// configuration for the transient stores.
+func (c Context) WithTransientKVGasConfig(gasConfig stypes.GasConfig) Context {
+	c.transientKVGasConfig = gasConfig
+	return c
+}
+
 // WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
@@ -196,6 +235,13 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithFeatureActivations returns a Context with an updated feature activation
+// schedule.
+func (c Context) WithFeatureActivations(features FeatureActivations) Context {
+	c.features = features
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -226,12 +272,12 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.kvGasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.transientKVGasConfig)
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
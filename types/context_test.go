@@ -153,6 +153,19 @@ func (s *contextTestSuite) TestContextHeader() {
 	s.Require().Equal(proposer.Bytes(), ctx.BlockHeader().ProposerAddress)
 }
 
+func (s *contextTestSuite) TestContextFeatureActivations() {
+	ctx := types.NewContext(nil, tmproto.Header{}, false, nil)
+	s.Require().False(ctx.IsFeatureEnabled("foo"))
+
+	ctx = ctx.WithFeatureActivations(types.FeatureActivations{{Name: "foo", Height: 10}})
+	s.Require().Len(ctx.FeatureActivations(), 1)
+
+	s.Require().False(ctx.WithBlockHeight(9).IsFeatureEnabled("foo"))
+	s.Require().True(ctx.WithBlockHeight(10).IsFeatureEnabled("foo"))
+	s.Require().True(ctx.WithBlockHeight(11).IsFeatureEnabled("foo"))
+	s.Require().False(ctx.WithBlockHeight(11).IsFeatureEnabled("bar"))
+}
+
 func (s *contextTestSuite) TestContextHeaderClone() {
 	cases := map[string]struct {
 		h tmproto.Header
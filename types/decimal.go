@@ -28,6 +28,9 @@ const (
 
 	// max number of iterations in ApproxRoot function
 	maxApproxRootIterations = 100
+
+	// max number of terms of the binomial series in ApproxPow function
+	maxApproxPowIterations = 300
 )
 
 var (
@@ -400,10 +403,97 @@ func (d Dec) Power(power uint64) Dec {
 	return d.Mul(tmp)
 }
 
-// ApproxSqrt is a wrapper around ApproxRoot for the common special case
-// of finding the square root of a number. It returns -(sqrt(abs(d)) if input is negative.
+// ApproxSqrt returns the square root of a number, rounded to the nearest
+// decimal of precision Precision. Unlike ApproxRoot, it is computed exactly
+// with integer arithmetic, whatever the magnitude of the number. It returns
+// -(sqrt(abs(d)) if input is negative.
 func (d Dec) ApproxSqrt() (Dec, error) {
-	return d.ApproxRoot(2)
+	if d.IsNegative() {
+		absSqrt, err := d.Neg().ApproxSqrt()
+		return absSqrt.Neg(), err
+	}
+
+	// sqrt(i / 10^18) * 10^18 = sqrt(i * 10^18), rounded up if the remainder
+	// exceeds the root, i.e. i * 10^18 >= root^2 + root + 1
+	n := new(big.Int).Mul(d.i, precisionReuse)
+	root := new(big.Int).Sqrt(n)
+
+	rem := new(big.Int).Mul(root, root)
+	rem.Sub(n, rem)
+
+	if rem.Cmp(root) > 0 {
+		root.Add(root, oneInt)
+	}
+
+	return Dec{root}, nil
+}
+
+// ApproxPow returns an approximation of d raised to the power exp, where d
+// and exp are non-negative. Integer powers are computed with Power. The
+// fractional part of the exponent is handled by taking square roots of the
+// base until it is close to one and summing the binomial series of the
+// resulting power, up to maxApproxPowIterations terms. An error is returned
+// if d or exp is negative or if the result overflows.
+func (d Dec) ApproxPow(exp Dec) (res Dec, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var ok bool
+			err, ok = r.(error)
+			if !ok {
+				err = errors.New("out of bounds")
+			}
+		}
+	}()
+
+	if d.IsNegative() || exp.IsNegative() {
+		return Dec{}, errors.New("base and exponent must be non-negative")
+	}
+
+	intExp, fracExp := exp.TruncateInt(), exp.Sub(exp.TruncateDec())
+	if !intExp.IsUint64() {
+		return Dec{}, errors.New("exponent is too large")
+	}
+
+	res = d.Power(intExp.Uint64())
+	if fracExp.IsZero() || res.IsZero() {
+		return res, nil
+	}
+
+	if d.IsZero() {
+		return ZeroDec(), nil
+	}
+
+	return res.Mul(d.approxFracPow(fracExp)), nil
+}
+
+// approxFracPow returns an approximation of d raised to the power exp, where
+// d is positive and exp is in (0, 1).
+func (d Dec) approxFracPow(exp Dec) Dec {
+	// d^exp = (1/d)^-exp, so that the base is at least one
+	if d.LT(OneDec()) {
+		return OneDec().Quo(OneDec().Quo(d).approxFracPow(exp))
+	}
+
+	// d^exp = sqrt(d)^(2 * exp), until the base is close enough to one for the
+	// binomial series to converge quickly
+	for maxBase := NewDecWithPrec(15, 1); d.GT(maxBase); {
+		d, _ = d.ApproxSqrt()
+		exp = exp.MulInt64(2)
+	}
+
+	intExp, fracExp := exp.TruncateInt(), exp.Sub(exp.TruncateDec())
+	res := d.Power(intExp.Uint64())
+
+	// (1 + x)^a = sum over k of a * (a - 1) * ... * (a - k + 1) / k! * x^k
+	x := d.Sub(OneDec())
+	term, sum := OneDec(), OneDec()
+
+	for k := int64(1); !term.IsZero() && k <= maxApproxPowIterations; k++ {
+		term = term.Mul(fracExp.Sub(NewDec(k - 1))).Mul(x).QuoInt64(k)
+		sum = sum.Add(term)
+	}
+
+	return res.Mul(sum)
 }
 
 // is integer, e.g. decimals are zero
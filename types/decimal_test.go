@@ -383,6 +383,26 @@ func (s *decimalTestSuite) TestApproxSqrt() {
 		{sdk.NewDecFromInt(sdk.NewInt(9)), sdk.NewDecFromInt(sdk.NewInt(3))},            // 9 => 3
 		{sdk.NewDecFromInt(sdk.NewInt(-9)), sdk.NewDecFromInt(sdk.NewInt(-3))},          // -9 => -3
 		{sdk.NewDecFromInt(sdk.NewInt(2)), sdk.NewDecWithPrec(1414213562373095049, 18)}, // 2 => 1.414213562373095049
+		{sdk.SmallestDec(), sdk.NewDecWithPrec(1, 9)},                                   // 10^-18 => 10^-9
+		{sdk.NewDecWithPrec(3, 18), sdk.NewDecWithPrec(1732050808, 18)},                 // 3 * 10^-18 => 1.732050808 * 10^-9
+		{sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 41)), sdk.MustNewDecFromStr("316227766016837933199.889354443271853372")},
+		{sdk.NewDecWithPrec(5, 1), sdk.MustNewDecFromStr("0.707106781186547524")},
+		{sdk.NewDec(3), sdk.MustNewDecFromStr("1.732050807568877294")},
+		{sdk.NewDecWithPrec(2, 18), sdk.MustNewDecFromStr("0.000000001414213562")},
+		{sdk.NewDecWithPrec(99, 18), sdk.MustNewDecFromStr("0.000000009949874371")},
+		{sdk.NewDecWithPrec(100, 18), sdk.MustNewDecFromStr("0.000000010000000000")},
+		// results within half a unit of the last decimal of the nearest decimal
+		{sdk.MustNewDecFromStr("0.999999999999999999"), sdk.MustNewDecFromStr("0.999999999999999999")},
+		{sdk.MustNewDecFromStr("1.000000000000000001"), sdk.OneDec()},
+		{sdk.MustNewDecFromStr("1.999999999999999999"), sdk.MustNewDecFromStr("1.414213562373095048")},
+		{sdk.MustNewDecFromStr("99.999999999999999999"), sdk.NewDec(10)},
+		{sdk.MustNewDecFromStr("100.000000000000000001"), sdk.NewDec(10)},
+		{sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 36)), sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 18))},
+		// (2^256 - 1) * 10^-18
+		{
+			sdk.MustNewDecFromStr("115792089237316195423570985008687907853269984665640564039457.584007913129639935"),
+			sdk.MustNewDecFromStr("340282366920938463463374607431.768211456000000000"),
+		},
 	}
 
 	for i, tc := range testCases {
@@ -392,6 +412,53 @@ func (s *decimalTestSuite) TestApproxSqrt() {
 	}
 }
 
+func (s *decimalTestSuite) TestApproxPow() {
+	testCases := []struct {
+		base     sdk.Dec
+		exp      sdk.Dec
+		expected sdk.Dec
+		expErr   bool
+	}{
+		{sdk.NewDec(3), sdk.ZeroDec(), sdk.OneDec(), false},
+		{sdk.ZeroDec(), sdk.ZeroDec(), sdk.OneDec(), false},
+		{sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), sdk.ZeroDec(), false},
+		{sdk.OneDec(), sdk.MustNewDecFromStr("123.456"), sdk.OneDec(), false},
+		{sdk.NewDec(2), sdk.NewDec(10), sdk.NewDec(1024), false},
+		{sdk.NewDec(2), sdk.NewDecWithPrec(5, 1), sdk.MustNewDecFromStr("1.414213562373095049"), false},
+		{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.MustNewDecFromStr("0.707106781186547524"), false},
+		{sdk.NewDecWithPrec(15, 1), sdk.NewDecWithPrec(25, 1), sdk.MustNewDecFromStr("2.755675960631075356"), false},
+		{sdk.NewDec(2), sdk.NewDecWithPrec(105, 1), sdk.MustNewDecFromStr("1448.154687870049330176"), false},
+		{sdk.NewDec(10), sdk.MustNewDecFromStr("1.333333333333333333"), sdk.MustNewDecFromStr("21.544346900318837200"), false},
+		{sdk.NewDec(1000000), sdk.NewDecWithPrec(1, 1), sdk.MustNewDecFromStr("3.981071705534972493"), false},
+		{sdk.NewDecWithPrec(1, 6), sdk.NewDecWithPrec(75, 2), sdk.MustNewDecFromStr("0.000031622776601684"), false},
+		{sdk.NewDec(123456789), sdk.SmallestDec(), sdk.MustNewDecFromStr("1.000000000000000019"), false},
+		{sdk.NewDec(-2), sdk.NewDecWithPrec(5, 1), sdk.Dec{}, true},
+		{sdk.NewDec(2), sdk.NewDecWithPrec(-5, 1), sdk.Dec{}, true},
+		{sdk.NewDec(10), sdk.NewDec(1000), sdk.Dec{}, true},
+	}
+
+	for i, tc := range testCases {
+		base, exp := tc.base.String(), tc.exp.String()
+
+		res, err := tc.base.ApproxPow(tc.exp)
+		if tc.expErr {
+			s.Require().Error(err, "expected error for test case %d, base: %v, exp: %v", i, tc.base, tc.exp)
+			continue
+		}
+
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expected, res, "unexpected result for test case %d, base: %v, exp: %v", i, tc.base, tc.exp)
+
+		// inputs must not be mutated, and results must be deterministic
+		s.Require().Equal(base, tc.base.String())
+		s.Require().Equal(exp, tc.exp.String())
+
+		again, err := tc.base.ApproxPow(tc.exp)
+		s.Require().NoError(err)
+		s.Require().Equal(res, again)
+	}
+}
+
 func (s *decimalTestSuite) TestDecSortableBytes() {
 	tests := []struct {
 		d    sdk.Dec
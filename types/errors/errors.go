@@ -284,7 +284,7 @@ func Wrap(err error, description string) error {
 	// If this error does not carry the stacktrace information yet, attach
 	// one. This should be done only once per error at the lowest frame
 	// possible (most inner wrap).
-	if stackTrace(err) == nil {
+	if IsStackTraceCaptureEnabled() && stackTrace(err) == nil {
 		err = errors.WithStack(err)
 	}
 
@@ -5,10 +5,36 @@ import (
 	"io"
 	"runtime"
 	"strings"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 )
 
+// stackTraceDisabled is set to 1 when Wrap does not capture stack traces. It is
+// accessed atomically.
+var stackTraceDisabled int32
+
+// SetStackTraceCapture enables or disables the capture of a stack trace by Wrap
+// and Wrapf when wrapping an error which does not carry one yet. Capturing a
+// stack trace is costly on hot paths, yet stack traces greatly help diagnosing
+// errors returned deep inside keepers. They are formatted by the %+v and %v
+// verbs only, so that they never reach the ABCI responses of a node unless it
+// runs with trace enabled. Capture is enabled by default.
+func SetStackTraceCapture(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&stackTraceDisabled, 0)
+		return
+	}
+
+	atomic.StoreInt32(&stackTraceDisabled, 1)
+}
+
+// IsStackTraceCaptureEnabled returns true if Wrap and Wrapf capture stack
+// traces.
+func IsStackTraceCaptureEnabled() bool {
+	return atomic.LoadInt32(&stackTraceDisabled) == 0
+}
+
 func matchesFunc(f errors.Frame, prefixes ...string) bool {
 	fn := funcName(f)
 	for _, prefix := range prefixes {
@@ -46,7 +72,7 @@ func fileLine(f errors.Frame) (string, int) {
 func trimInternal(st errors.StackTrace) errors.StackTrace {
 	// trim our internal parts here
 	// manual error creation, or runtime for caught panics
-	for matchesFunc(st[0],
+	for len(st) > 0 && matchesFunc(st[0],
 		// where we create errors
 		"github.com/cosmos/cosmos-sdk/types/errors.Wrap",
 		"github.com/cosmos/cosmos-sdk/types/errors.Wrapf",
@@ -91,6 +117,12 @@ func (e *wrappedError) Format(s fmt.State, verb rune) {
 	}
 	// work with the stack trace... whole or part
 	stack := trimInternal(stackTrace(e))
+	if len(stack) == 0 {
+		// stack trace capture was disabled when the error was wrapped
+		fmt.Fprint(s, e.Error())
+		return
+	}
+
 	if s.Flag('+') {
 		fmt.Fprintf(s, "%+v\n", stack)
 		fmt.Fprint(s, e.Error())
@@ -60,3 +60,20 @@ func (s *errorsTestSuite) TestStackTrace() {
 		s.Require().True(strings.Contains(tinyStack, thisTestSrc))
 	}
 }
+
+func (s *errorsTestSuite) TestStackTraceCaptureDisabled() {
+	SetStackTraceCapture(false)
+	defer SetStackTraceCapture(true)
+	s.Require().False(IsStackTraceCaptureEnabled())
+
+	err := Wrap(Wrap(fmt.Errorf("foo"), "bar"), "baz")
+	s.Require().Nil(stackTrace(err))
+	s.Require().Equal("baz: bar: foo", fmt.Sprintf("%v", err))
+	s.Require().Equal("baz: bar: foo", fmt.Sprintf("%+v", err))
+
+	// wrapping the error once capture is enabled again attaches a stack trace
+	SetStackTraceCapture(true)
+	err = Wrap(err, "qux")
+	s.Require().NotNil(stackTrace(err))
+	s.Require().True(strings.Contains(fmt.Sprintf("%+v", err), "types/errors/stacktrace_test.go"))
+}
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// EventVerbosity defines how verbose the events emitted by a module are. It is a
+// node-local setting: events are not part of the consensus state, so nodes may
+// emit, and index, fewer of them to save storage.
+type EventVerbosity uint8
+
+const (
+	// EventVerbosityFull emits all the events, including those duplicating the
+	// information of others, e.g. the balance changes of a transfer.
+	EventVerbosityFull EventVerbosity = iota
+	// EventVerbosityStandard emits the events describing the outcome of
+	// messages, e.g. transfers, but not their fine-grained details.
+	EventVerbosityStandard
+	// EventVerbosityMinimal only emits the events clients need to find txs, e.g.
+	// the message events holding their sender.
+	EventVerbosityMinimal
+)
+
+var eventVerbosityNames = map[EventVerbosity]string{
+	EventVerbosityFull:     "full",
+	EventVerbosityStandard: "standard",
+	EventVerbosityMinimal:  "minimal",
+}
+
+// ParseEventVerbosity parses an event verbosity: full, standard or minimal.
+func ParseEventVerbosity(s string) (EventVerbosity, error) {
+	for v, name := range eventVerbosityNames {
+		if strings.EqualFold(strings.TrimSpace(s), name) {
+			return v, nil
+		}
+	}
+
+	return EventVerbosityFull, fmt.Errorf("invalid event verbosity %q, expected full, standard or minimal", s)
+}
+
+// String implements the Stringer interface.
+func (v EventVerbosity) String() string {
+	if name, ok := eventVerbosityNames[v]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("EventVerbosity(%d)", uint8(v))
+}
+
+// Includes returns whether the events of the given verbosity are emitted when
+// v is the configured verbosity, e.g. standard events are emitted when the
+// verbosity is full or standard.
+func (v EventVerbosity) Includes(events EventVerbosity) bool {
+	return v <= events
+}
+
+// EventVerbosityConfig is the event verbosity of the modules of a node.
+type EventVerbosityConfig struct {
+	// Default is the verbosity of the modules without their own verbosity.
+	Default EventVerbosity
+	// Modules is the verbosity of modules, by module name.
+	Modules map[string]EventVerbosity
+}
+
+// ParseEventVerbosityConfig parses an event verbosity config: a list of
+// verbosities separated by commas, each prefixed by a module name and an
+// equal sign, except the default verbosity. For instance "standard,bank=minimal"
+// sets the verbosity of bank to minimal and of other modules to standard. The
+// default verbosity is full if not set.
+func ParseEventVerbosityConfig(s string) (EventVerbosityConfig, error) {
+	cfg := EventVerbosityConfig{Default: EventVerbosityFull, Modules: map[string]EventVerbosity{}}
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		module, verbosityStr := "", entry
+		if i := strings.Index(entry, "="); i >= 0 {
+			module, verbosityStr = strings.TrimSpace(entry[:i]), entry[i+1:]
+			if module == "" {
+				return EventVerbosityConfig{}, fmt.Errorf("invalid event verbosity %q: empty module name", entry)
+			}
+		}
+
+		verbosity, err := ParseEventVerbosity(verbosityStr)
+		if err != nil {
+			return EventVerbosityConfig{}, err
+		}
+
+		if module == "" {
+			cfg.Default = verbosity
+		} else {
+			cfg.Modules[module] = verbosity
+		}
+	}
+
+	return cfg, nil
+}
+
+// Verbosity returns the event verbosity of the given module.
+func (cfg EventVerbosityConfig) Verbosity(module string) EventVerbosity {
+	if verbosity, ok := cfg.Modules[module]; ok {
+		return verbosity
+	}
+
+	return cfg.Default
+}
+
+// String implements the Stringer interface. It returns the config in the format
+// parsed by ParseEventVerbosityConfig.
+func (cfg EventVerbosityConfig) String() string {
+	entries := []string{cfg.Default.String()}
+
+	modules := make([]string, 0, len(cfg.Modules))
+	for module := range cfg.Modules {
+		modules = append(modules, module)
+	}
+
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		entries = append(entries, fmt.Sprintf("%s=%s", module, cfg.Modules[module]))
+	}
+
+	return strings.Join(entries, ",")
+}
+
+// eventVerbosityConfig holds the EventVerbosityConfig of the node.
+var eventVerbosityConfig atomic.Value
+
+// SetEventVerbosity sets the event verbosity of the modules of the node, which
+// keepers consult through the EventManager. All the events are emitted by
+// default.
+func SetEventVerbosity(cfg EventVerbosityConfig) {
+	modules := make(map[string]EventVerbosity, len(cfg.Modules))
+	for module, verbosity := range cfg.Modules {
+		modules[module] = verbosity
+	}
+
+	eventVerbosityConfig.Store(EventVerbosityConfig{Default: cfg.Default, Modules: modules})
+}
+
+// GetEventVerbosity returns the event verbosity of the modules of the node.
+func GetEventVerbosity() EventVerbosityConfig {
+	cfg, _ := eventVerbosityConfig.Load().(EventVerbosityConfig)
+	return cfg
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseEventVerbosityConfig(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected sdk.EventVerbosityConfig
+		expErr   bool
+	}{
+		{"", sdk.EventVerbosityConfig{Default: sdk.EventVerbosityFull, Modules: map[string]sdk.EventVerbosity{}}, false},
+		{"minimal", sdk.EventVerbosityConfig{Default: sdk.EventVerbosityMinimal, Modules: map[string]sdk.EventVerbosity{}}, false},
+		{
+			"Standard, bank=minimal,staking=full",
+			sdk.EventVerbosityConfig{
+				Default: sdk.EventVerbosityStandard,
+				Modules: map[string]sdk.EventVerbosity{"bank": sdk.EventVerbosityMinimal, "staking": sdk.EventVerbosityFull},
+			},
+			false,
+		},
+		{"verbose", sdk.EventVerbosityConfig{}, true},
+		{"bank=", sdk.EventVerbosityConfig{}, true},
+		{"=minimal", sdk.EventVerbosityConfig{}, true},
+	}
+
+	for _, tc := range testCases {
+		cfg, err := sdk.ParseEventVerbosityConfig(tc.input)
+		if tc.expErr {
+			require.Error(t, err, tc.input)
+			continue
+		}
+
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.expected, cfg, tc.input)
+
+		// the config round-trips through its string
+		parsed, err := sdk.ParseEventVerbosityConfig(cfg.String())
+		require.NoError(t, err)
+		require.Equal(t, cfg, parsed)
+	}
+}
+
+func TestEventVerbosityIncludes(t *testing.T) {
+	require.True(t, sdk.EventVerbosityFull.Includes(sdk.EventVerbosityFull))
+	require.True(t, sdk.EventVerbosityFull.Includes(sdk.EventVerbosityMinimal))
+	require.True(t, sdk.EventVerbosityStandard.Includes(sdk.EventVerbosityStandard))
+	require.False(t, sdk.EventVerbosityStandard.Includes(sdk.EventVerbosityFull))
+	require.True(t, sdk.EventVerbosityMinimal.Includes(sdk.EventVerbosityMinimal))
+	require.False(t, sdk.EventVerbosityMinimal.Includes(sdk.EventVerbosityStandard))
+}
+
+func TestEventManagerVerbosity(t *testing.T) {
+	em := sdk.NewEventManager()
+	require.Equal(t, sdk.EventVerbosityFull, em.Verbosity("bank"))
+
+	modules := map[string]sdk.EventVerbosity{"bank": sdk.EventVerbosityMinimal}
+	sdk.SetEventVerbosity(sdk.EventVerbosityConfig{Default: sdk.EventVerbosityStandard, Modules: modules})
+	defer sdk.SetEventVerbosity(sdk.EventVerbosityConfig{})
+
+	// the config is copied
+	modules["bank"] = sdk.EventVerbosityFull
+
+	require.Equal(t, sdk.EventVerbosityMinimal, em.Verbosity("bank"))
+	require.Equal(t, sdk.EventVerbosityStandard, sdk.NewEventManager().Verbosity("staking"))
+}
@@ -30,6 +30,16 @@ func NewEventManager() *EventManager {
 
 func (em *EventManager) Events() Events { return em.events }
 
+// Verbosity returns the event verbosity configured on the node for the given
+// module. Keepers use it to decide which events to emit, e.g.:
+//
+//	if ctx.EventManager().Verbosity(types.ModuleName).Includes(sdk.EventVerbosityFull) {
+//		ctx.EventManager().EmitEvent(...)
+//	}
+func (em *EventManager) Verbosity(module string) EventVerbosity {
+	return GetEventVerbosity().Verbosity(module)
+}
+
 // EmitEvent stores a single Event object.
 // Deprecated: Use EmitTypedEvent
 func (em *EventManager) EmitEvent(event Event) {
@@ -299,8 +309,14 @@ func StringifyEvents(events []abci.Event) StringEvents {
 	return res.Flatten()
 }
 
+// EventIndexWildcard is the attribute key matching all the attributes of an
+// event type in a set of events to index, e.g. "send_packet.*".
+const EventIndexWildcard = "*"
+
 // MarkEventsToIndex returns the set of ABCI events, where each event's attribute
-// has it's index value marked based on the provided set of events to index.
+// has it's index value marked based on the provided set of events to index. The
+// set holds entries in the form {eventType}.{attributeKey}, or
+// {eventType}.* to index all the attributes of an event type.
 func MarkEventsToIndex(events []abci.Event, indexSet map[string]struct{}) []abci.Event {
 	indexAll := len(indexSet) == 0
 	updatedEvents := make([]abci.Event, len(events))
@@ -311,12 +327,14 @@ func MarkEventsToIndex(events []abci.Event, indexSet map[string]struct{}) []abci
 			Attributes: make([]abci.EventAttribute, len(e.Attributes)),
 		}
 
+		_, indexType := indexSet[fmt.Sprintf("%s.%s", e.Type, EventIndexWildcard)]
+
 		for j, attr := range e.Attributes {
 			_, index := indexSet[fmt.Sprintf("%s.%s", e.Type, attr.Key)]
 			updatedAttr := abci.EventAttribute{
 				Key:   attr.Key,
 				Value: attr.Value,
-				Index: index || indexAll,
+				Index: index || indexType || indexAll,
 			}
 
 			updatedEvent.Attributes[j] = updatedAttr
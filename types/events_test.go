@@ -185,6 +185,29 @@ func (s *eventsTestSuite) TestMarkEventsToIndex() {
 				"staking.deposit": {},
 			},
 		},
+		"index event types": {
+			events: events,
+			expected: []abci.Event{
+				{
+					Type: "message",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("sender"), Value: []byte("foo")},
+						{Key: []byte("recipient"), Value: []byte("bar"), Index: true},
+					},
+				},
+				{
+					Type: "staking",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("deposit"), Value: []byte("5"), Index: true},
+						{Key: []byte("unbond"), Value: []byte("10"), Index: true},
+					},
+				},
+			},
+			indexSet: map[string]struct{}{
+				"message.recipient": {},
+				"staking.*":         {},
+			},
+		},
 		"index all events": {
 			events: events,
 			expected: []abci.Event{
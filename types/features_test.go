@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeatureActivationsValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		features  sdk.FeatureActivations
+		expectErr bool
+	}{
+		{"empty schedule", sdk.FeatureActivations{}, false},
+		{"valid schedule", sdk.FeatureActivations{{"bar", 5}, {"baz", 10}, {"foo", 10}}, false},
+		{"unsorted heights", sdk.FeatureActivations{{"foo", 10}, {"bar", 5}}, true},
+		{"unsorted names", sdk.FeatureActivations{{"foo", 10}, {"bar", 10}}, true},
+		{"blank name", sdk.FeatureActivations{{"", 10}}, true},
+		{"zero height", sdk.FeatureActivations{{"foo", 0}}, true},
+		{"negative height", sdk.FeatureActivations{{"foo", -1}}, true},
+		{"duplicate name", sdk.FeatureActivations{{"foo", 10}, {"foo", 20}}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.features.Validate() != nil)
+		})
+	}
+}
+
+func TestFeatureActivationsValidateUpdate(t *testing.T) {
+	current := sdk.FeatureActivations{{"foo", 10}, {"bar", 20}}
+
+	testCases := []struct {
+		name      string
+		next      sdk.FeatureActivations
+		height    int64
+		expectErr bool
+	}{
+		{"unchanged", current, 15, false},
+		{"invalid schedule", sdk.FeatureActivations{{"foo", 10}, {"foo", 30}}, 15, true},
+		{"add future feature", sdk.FeatureActivations{{"foo", 10}, {"bar", 20}, {"baz", 30}}, 15, false},
+		{"add feature at current height", sdk.FeatureActivations{{"foo", 10}, {"baz", 15}, {"bar", 20}}, 15, true},
+		{"add past feature", sdk.FeatureActivations{{"baz", 5}, {"foo", 10}, {"bar", 20}}, 15, true},
+		{"reschedule pending feature", sdk.FeatureActivations{{"foo", 10}, {"bar", 25}}, 15, false},
+		{"reschedule pending feature to the past", sdk.FeatureActivations{{"foo", 10}, {"bar", 12}}, 15, true},
+		{"remove pending feature", sdk.FeatureActivations{{"foo", 10}}, 15, false},
+		{"reschedule active feature", sdk.FeatureActivations{{"bar", 20}, {"foo", 30}}, 15, true},
+		{"remove active feature", sdk.FeatureActivations{{"bar", 20}}, 15, true},
+		{"reschedule feature activated at current height", sdk.FeatureActivations{{"foo", 10}, {"bar", 25}}, 20, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, current.ValidateUpdate(tc.next, tc.height) != nil)
+		})
+	}
+}
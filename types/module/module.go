@@ -337,6 +337,16 @@ type MigrationHandler func(sdk.Context) error
 // version from which we should perform the migration for each module.
 type MigrationMap map[string]uint64
 
+// GetVersionMap returns the consensus version of every module of the manager.
+func (m Manager) GetVersionMap() MigrationMap {
+	vm := make(MigrationMap, len(m.Modules))
+	for moduleName, module := range m.Modules {
+		vm[moduleName] = module.ConsensusVersion()
+	}
+
+	return vm
+}
+
 // RunMigrations performs in-place store migrations for all modules.
 func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, migrateFromVersions MigrationMap) error {
 	c, ok := cfg.(configurator)
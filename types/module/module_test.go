@@ -239,6 +239,20 @@ func TestManager_ExportGenesis(t *testing.T) {
 	require.Equal(t, want, mm.ExportGenesis(ctx, cdc))
 }
 
+func TestManager_GetVersionMap(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	require.Equal(t, module.MigrationMap{"module1": 1, "module2": 1}, mm.GetVersionMap())
+}
+
 func TestManager_BeginBlock(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
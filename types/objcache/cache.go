@@ -0,0 +1,188 @@
+// Package objcache implements a bounded read-through cache of decoded objects
+// which keepers can use to avoid repeatedly reading and unmarshalling hot
+// objects, such as parameters, from their KVStore.
+package objcache
+
+import (
+	"fmt"
+	"sync"
+
+	metrics "github.com/armon/go-metrics"
+	lru "github.com/hashicorp/golang-lru"
+
+	stypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DecodeFn decodes the raw bytes stored under a key into an object.
+type DecodeFn func(bz []byte) (interface{}, error)
+
+// EncodeFn encodes an object into the raw bytes stored under a key.
+type EncodeFn func(obj interface{}) ([]byte, error)
+
+type entry struct {
+	obj    interface{}
+	size   int
+	height int64
+}
+
+// Cache is a bounded LRU cache of decoded objects read from a single KVStore.
+//
+// Entries are keyed on the block height of the context they were read from
+// and only serve reads at that height, so that they are shared by all the
+// transactions of a block, and by the CheckTx and query contexts once the
+// block is committed, but never outlive the state they were read from. Writes
+// performed through the cache evict the entry of the key, which is then no
+// longer cached until the next height, so that reads never observe a cached
+// value written by another, possibly discarded, branch of the store.
+//
+// Writes performed directly on the store bypass the cache; hence all writes
+// of a cached key, including genesis and migrations, must go through the
+// cache.
+//
+// Reads served from the cache consume the same amount of gas as a store read
+// so that gas consumption does not depend on the state of the cache. Cached
+// objects are shared between callers and must be treated as immutable.
+type Cache struct {
+	mtx sync.Mutex
+
+	storeKey sdk.StoreKey
+	encode   EncodeFn
+	decode   DecodeFn
+
+	cache *lru.Cache
+	// written holds the height of the last write of the keys written at the
+	// latest heights.
+	written map[string]int64
+	height  int64
+}
+
+// NewCache returns a Cache holding at most size objects read from the store
+// of the given key.
+func NewCache(storeKey sdk.StoreKey, size int, encode EncodeFn, decode DecodeFn) *Cache {
+	if size <= 0 {
+		panic(fmt.Errorf("invalid object cache size: %d", size))
+	}
+
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Cache{
+		storeKey: storeKey,
+		encode:   encode,
+		decode:   decode,
+		cache:    cache,
+		written:  make(map[string]int64),
+	}
+}
+
+// Get returns the object stored under key, decoding and caching it on a cache
+// miss. It returns nil if no value is stored under key.
+func (c *Cache) Get(ctx sdk.Context, key []byte) (interface{}, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	height := ctx.BlockHeight()
+	c.advance(height)
+
+	if e, ok := c.cache.Get(string(key)); ok && e.(entry).height == height {
+		ent := e.(entry)
+		gasConfig := ctx.KVGasConfig()
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, stypes.GasReadCostFlatDesc)
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*stypes.Gas(ent.size), stypes.GasReadPerByteDesc)
+		c.incrCounter("hit")
+
+		return ent.obj, nil
+	}
+
+	c.incrCounter("miss")
+
+	bz := ctx.KVStore(c.storeKey).Get(key)
+	if bz == nil {
+		return nil, nil
+	}
+
+	obj, err := c.decode(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	// the value may have been written by the store branch of ctx, which can
+	// still be discarded
+	if written, ok := c.written[string(key)]; !ok || written < height {
+		c.cache.Add(string(key), entry{obj: obj, size: len(bz), height: height})
+	}
+
+	return obj, nil
+}
+
+// Set encodes and stores obj under key and evicts the cached entry.
+func (c *Cache) Set(ctx sdk.Context, key []byte, obj interface{}) error {
+	bz, err := c.encode(obj)
+	if err != nil {
+		return err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.invalidate(ctx.BlockHeight(), key)
+	ctx.KVStore(c.storeKey).Set(key, bz)
+
+	return nil
+}
+
+// Delete removes the value stored under key and evicts the cached entry.
+func (c *Cache) Delete(ctx sdk.Context, key []byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.invalidate(ctx.BlockHeight(), key)
+	ctx.KVStore(c.storeKey).Delete(key)
+}
+
+// Len returns the number of cached objects.
+func (c *Cache) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.cache.Len()
+}
+
+// invalidate evicts the cached entry of key and prevents it from being cached
+// again at the given height.
+func (c *Cache) invalidate(height int64, key []byte) {
+	c.advance(height)
+	c.cache.Remove(string(key))
+
+	if written, ok := c.written[string(key)]; !ok || written < height {
+		c.written[string(key)] = height
+	}
+}
+
+// advance forgets the writes which can no longer affect the reads of the
+// contexts in use once height is reached: the DeliverTx context of a block
+// and the CheckTx context of the previous height.
+func (c *Cache) advance(height int64) {
+	if height <= c.height {
+		return
+	}
+
+	c.height = height
+	for key, written := range c.written {
+		if written < height-1 {
+			delete(c.written, key)
+		}
+	}
+}
+
+func (c *Cache) incrCounter(result string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"store", "objcache", result},
+		1,
+		[]metrics.Label{telemetry.NewLabel("store", c.storeKey.Name())},
+	)
+}
@@ -0,0 +1,139 @@
+package objcache_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/objcache"
+)
+
+func newIntCache(key sdk.StoreKey, decodes *int) *objcache.Cache {
+	return objcache.NewCache(
+		key, 2,
+		func(obj interface{}) ([]byte, error) {
+			return []byte(strconv.Itoa(obj.(int))), nil
+		},
+		func(bz []byte) (interface{}, error) {
+			*decodes++
+			return strconv.Atoi(string(bz))
+		},
+	)
+}
+
+func TestCache(t *testing.T) {
+	key := sdk.NewKVStoreKey("objcache")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_objcache")).WithBlockHeight(1)
+	ctx.KVStore(key).Set([]byte("a"), []byte("1"))
+
+	decodes := 0
+	cache := newIntCache(key, &decodes)
+
+	obj, err := cache.Get(ctx, []byte("missing"))
+	require.NoError(t, err)
+	require.Nil(t, obj)
+
+	// the second read is served from the cache and consumes the same gas
+	gasBefore := ctx.GasMeter().GasConsumed()
+	obj, err = cache.Get(ctx, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, 1, obj)
+	readGas := ctx.GasMeter().GasConsumed() - gasBefore
+
+	gasBefore = ctx.GasMeter().GasConsumed()
+	obj, err = cache.Get(ctx, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, 1, obj)
+	require.Equal(t, readGas, ctx.GasMeter().GasConsumed()-gasBefore)
+	require.Equal(t, 1, decodes)
+
+	// cache hits consume gas according to the gas config of the context
+	gasConfig := ctx.KVGasConfig()
+	gasConfig.ReadCostFlat *= 2
+	gasCtx := ctx.WithKVGasConfig(gasConfig)
+	gasBefore = gasCtx.GasMeter().GasConsumed()
+	_, err = cache.Get(gasCtx, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, readGas+ctx.KVGasConfig().ReadCostFlat, gasCtx.GasMeter().GasConsumed()-gasBefore)
+	require.Equal(t, 1, decodes)
+
+	// writes through the cache evict the key until the next height
+	require.NoError(t, cache.Set(ctx, []byte("a"), 2))
+	require.Equal(t, []byte("2"), ctx.KVStore(key).Get([]byte("a")))
+	for i := 0; i < 2; i++ {
+		obj, err = cache.Get(ctx, []byte("a"))
+		require.NoError(t, err)
+		require.Equal(t, 2, obj)
+	}
+	require.Equal(t, 3, decodes)
+
+	ctx = ctx.WithBlockHeight(2)
+	for i := 0; i < 2; i++ {
+		obj, err = cache.Get(ctx, []byte("a"))
+		require.NoError(t, err)
+		require.Equal(t, 2, obj)
+	}
+	require.Equal(t, 4, decodes)
+
+	cache.Delete(ctx, []byte("a"))
+	obj, err = cache.Get(ctx, []byte("a"))
+	require.NoError(t, err)
+	require.Nil(t, obj)
+
+	// the cache is bounded
+	ctx = ctx.WithBlockHeight(3)
+	for i := 0; i < 5; i++ {
+		ctx.KVStore(key).Set([]byte(strconv.Itoa(i)), []byte(strconv.Itoa(i)))
+		_, err = cache.Get(ctx, []byte(strconv.Itoa(i)))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, cache.Len())
+}
+
+func TestCacheHeight(t *testing.T) {
+	key := sdk.NewKVStoreKey("objcache")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_objcache")).WithBlockHeight(1)
+	ctx.KVStore(key).Set([]byte("a"), []byte("1"))
+
+	decodes := 0
+	cache := newIntCache(key, &decodes)
+
+	obj, err := cache.Get(ctx, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, 1, obj)
+
+	// entries only serve reads at the height they were read at
+	ctx.KVStore(key).Set([]byte("a"), []byte("2"))
+	obj, err = cache.Get(ctx.WithBlockHeight(2), []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, 2, obj)
+	require.Equal(t, 2, decodes)
+}
+
+func TestCacheBranch(t *testing.T) {
+	key := sdk.NewKVStoreKey("objcache")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_objcache")).WithBlockHeight(1)
+	ctx.KVStore(key).Set([]byte("a"), []byte("1"))
+
+	decodes := 0
+	cache := newIntCache(key, &decodes)
+
+	_, err := cache.Get(ctx, []byte("a"))
+	require.NoError(t, err)
+
+	// a value written by a branch is never cached, as it may be discarded
+	branch, _ := ctx.CacheContext()
+	require.NoError(t, cache.Set(branch, []byte("a"), 2))
+	obj, err := cache.Get(branch, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, 2, obj)
+
+	// the discarded branch must not leak into the parent context
+	obj, err = cache.Get(ctx, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, 1, obj)
+	require.Equal(t, 3, decodes)
+}
@@ -0,0 +1,80 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// GRPCQueryFn runs the gRPC queries serving a legacy REST endpoint through the
+// given client context, passing the given call options to them, and returns
+// their result translated to the JSON shape of the legacy endpoint, i.e. the
+// legacy (amino) type of the result.
+//
+// Errors caused by the arguments of the request, e.g. an invalid address, must
+// be returned as gRPC InvalidArgument errors to be reported as bad requests.
+type GRPCQueryFn func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error)
+
+// NewGRPCQueryHandlerFn returns a legacy REST query handler backed by gRPC
+// queries instead of legacy queriers. The handler supports the height query
+// parameter and wraps the result returned by query with the height of the
+// query, as legacy REST query handlers do. Failed queries are reported with
+// the HTTP status of their gRPC code, see GRPCErrorHTTPStatus, and the message
+// of their gRPC status.
+func NewGRPCQueryHandlerFn(clientCtx client.Context, query GRPCQueryFn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+
+		res, err := query(r, clientCtx, grpc.Header(&header))
+		if err != nil {
+			WriteErrorResponse(w, GRPCErrorHTTPStatus(err), status.Convert(err).Message())
+			return
+		}
+
+		if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+			height, err := strconv.ParseInt(heights[0], 10, 64)
+			if CheckInternalServerError(w, err) {
+				return
+			}
+
+			clientCtx = clientCtx.WithHeight(height)
+		}
+
+		PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+// GRPCErrorHTTPStatus returns the HTTP status of a failed gRPC query: 400 for
+// InvalidArgument errors, 404 for NotFound errors and 500 otherwise.
+func GRPCErrorHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+
+	case codes.NotFound:
+		return http.StatusNotFound
+
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// NewPageRequest returns the page request of a gRPC query serving the given
+// page, of limit results, of a legacy REST endpoint. A limit of 0 selects the
+// default limit of gRPC queries.
+func NewPageRequest(page, limit int) *query.PageRequest {
+	return &query.PageRequest{Offset: uint64((page - 1) * limit), Limit: uint64(limit)}
+}
@@ -0,0 +1,85 @@
+package rest_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+func TestNewGRPCQueryHandlerFn(t *testing.T) {
+	t.Parallel()
+
+	clientCtx := client.Context{}.WithLegacyAmino(codec.NewLegacyAmino())
+	coins := types.NewCoins(types.NewInt64Coin("atom", 100))
+
+	// the handler mimics client.Context.Invoke, which sets the height header
+	handler := rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		switch r.FormValue("fail") {
+		case "internal":
+			return nil, errors.New("query failed")
+		case "argument":
+			return nil, status.Error(codes.InvalidArgument, "invalid argument")
+		case "missing":
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		height := "7"
+		if clientCtx.Height != 0 {
+			height = "3"
+		}
+
+		for _, opt := range opts {
+			if header, ok := opt.(grpc.HeaderCallOption); ok {
+				*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, height)
+			}
+		}
+
+		return coins, nil
+	})
+
+	expected := func(height int64) string {
+		result, err := clientCtx.LegacyAmino.MarshalJSON(coins)
+		require.NoError(t, err)
+
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(rest.NewResponseWithHeight(height, result))
+		require.NoError(t, err)
+
+		return string(bz)
+	}
+
+	testCases := []struct {
+		url      string
+		code     int
+		expected string
+	}{
+		{"/test", http.StatusOK, expected(7)},
+		{"/test?height=3", http.StatusOK, expected(3)},
+		{"/test?height=-1", http.StatusBadRequest, ""},
+		{"/test?fail=internal", http.StatusInternalServerError, ""},
+		{"/test?fail=argument", http.StatusBadRequest, ""},
+		{"/test?fail=missing", http.StatusNotFound, ""},
+	}
+
+	for _, tc := range testCases {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, tc.url, nil))
+
+		require.Equal(t, tc.code, w.Code, tc.url)
+		if tc.expected != "" {
+			require.Equal(t, tc.expected, w.Body.String(), tc.url)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// StoreKeyDecoder decodes the key of an entry of a module store into the name
+// of its prefix and the addresses, ids, etc. embedded in it. It returns an
+// error if the key does not belong to the module store.
+type StoreKeyDecoder func(key []byte) (DecodedStoreKey, error)
+
+// StoreKeyDecoderRegistry defines the store key decoders of the modules, by
+// store name.
+type StoreKeyDecoderRegistry map[string]StoreKeyDecoder
+
+// StoreKeyField is a field embedded in a store key, e.g. an address or an id.
+type StoreKeyField struct {
+	Name  string
+	Value string
+}
+
+// DecodedStoreKey is a store key decoded by a StoreKeyDecoder.
+type DecodedStoreKey struct {
+	// Prefix is the name of the prefix of the key, e.g. Balances.
+	Prefix string
+	// Fields are the fields embedded in the key after its prefix, in order.
+	Fields []StoreKeyField
+	// Value is an empty instance of the type of the value stored under the
+	// key, e.g. a *Coin, or nil if the value is not a protobuf message.
+	Value codec.ProtoMarshaler
+}
+
+// String implements the Stringer interface, e.g. Balances(address=cosmos1...,
+// denom=stake).
+func (k DecodedStoreKey) String() string {
+	fields := make([]string, len(k.Fields))
+	for i, field := range k.Fields {
+		fields[i] = fmt.Sprintf("%s=%s", field.Name, field.Value)
+	}
+
+	return fmt.Sprintf("%s(%s)", k.Prefix, strings.Join(fields, ", "))
+}
+
+// lenTimeBytes is the length of the times formatted by FormatTimeBytes.
+var lenTimeBytes = len(FormatTimeBytes(time.Time{}))
+
+// StoreKeyParser parses the fields of a store key following its prefix into a
+// DecodedStoreKey. The parsing stops at the first invalid field, whose error
+// is returned by Decode.
+type StoreKeyParser struct {
+	decoded DecodedStoreKey
+	rest    []byte
+	err     error
+}
+
+// NewStoreKeyParser returns a StoreKeyParser of the fields of a key whose
+// prefix is already parsed, and under which values of the given type are
+// stored.
+func NewStoreKeyParser(prefix string, fields []byte, value codec.ProtoMarshaler) *StoreKeyParser {
+	return &StoreKeyParser{
+		decoded: DecodedStoreKey{Prefix: prefix, Value: value},
+		rest:    fields,
+	}
+}
+
+// Field parses a field of n bytes, formatted with format.
+func (p *StoreKeyParser) Field(name string, n int, format func([]byte) string) *StoreKeyParser {
+	if p.err != nil {
+		return p
+	}
+
+	if len(p.rest) < n {
+		p.err = fmt.Errorf("%s key too short for %s: expected %d bytes, got %d", p.decoded.Prefix, name, n, len(p.rest))
+		return p
+	}
+
+	p.decoded.Fields = append(p.decoded.Fields, StoreKeyField{Name: name, Value: format(p.rest[:n])})
+	p.rest = p.rest[n:]
+
+	return p
+}
+
+// LengthPrefixedField parses a field prefixed by its length on one byte,
+// formatted with format.
+func (p *StoreKeyParser) LengthPrefixedField(name string, format func([]byte) string) *StoreKeyParser {
+	if p.err != nil {
+		return p
+	}
+
+	if len(p.rest) == 0 {
+		p.err = fmt.Errorf("%s key too short for the length of %s", p.decoded.Prefix, name)
+		return p
+	}
+
+	n := int(p.rest[0])
+	p.rest = p.rest[1:]
+
+	return p.Field(name, n, format)
+}
+
+// AccAddress parses a length-prefixed account address.
+func (p *StoreKeyParser) AccAddress(name string) *StoreKeyParser {
+	return p.LengthPrefixedField(name, func(bz []byte) string { return AccAddress(bz).String() })
+}
+
+// ValAddress parses a length-prefixed validator operator address.
+func (p *StoreKeyParser) ValAddress(name string) *StoreKeyParser {
+	return p.LengthPrefixedField(name, func(bz []byte) string { return ValAddress(bz).String() })
+}
+
+// ConsAddress parses a length-prefixed validator consensus address.
+func (p *StoreKeyParser) ConsAddress(name string) *StoreKeyParser {
+	return p.LengthPrefixedField(name, func(bz []byte) string { return ConsAddress(bz).String() })
+}
+
+// Uint64 parses a big endian uint64, e.g. an id.
+func (p *StoreKeyParser) Uint64(name string) *StoreKeyParser {
+	return p.Field(name, 8, func(bz []byte) string { return strconv.FormatUint(BigEndianToUint64(bz), 10) })
+}
+
+// Time parses a time formatted by FormatTimeBytes.
+func (p *StoreKeyParser) Time(name string) *StoreKeyParser {
+	return p.Field(name, lenTimeBytes, func(bz []byte) string {
+		t, err := ParseTimeBytes(bz)
+		if err != nil {
+			return fmt.Sprintf("%X (invalid time: %s)", bz, err)
+		}
+
+		return t.String()
+	})
+}
+
+// String parses the remaining bytes of the key as a string, e.g. a denom.
+func (p *StoreKeyParser) String(name string) *StoreKeyParser {
+	return p.Field(name, len(p.rest), func(bz []byte) string { return string(bz) })
+}
+
+// Decode returns the decoded store key, or the error of the first invalid
+// field. An error is also returned if bytes remain after the last field.
+func (p *StoreKeyParser) Decode() (DecodedStoreKey, error) {
+	if p.err != nil {
+		return DecodedStoreKey{}, p.err
+	}
+
+	if len(p.rest) > 0 {
+		return DecodedStoreKey{}, fmt.Errorf("%s key has %d unexpected trailing bytes", p.decoded.Prefix, len(p.rest))
+	}
+
+	return p.decoded, nil
+}
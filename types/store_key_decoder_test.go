@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestStoreKeyParser(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	valAddr := sdk.ValAddress("val_________________")
+	now := time.Date(2021, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	key := append(address.MustLengthPrefix(addr), address.MustLengthPrefix(valAddr)...)
+	key = append(key, sdk.Uint64ToBigEndian(42)...)
+	key = append(key, sdk.FormatTimeBytes(now)...)
+	key = append(key, []byte("stake")...)
+
+	decoded, err := sdk.NewStoreKeyParser("Test", key, &sdk.Coin{}).
+		AccAddress("address").
+		ValAddress("validator").
+		Uint64("id").
+		Time("time").
+		String("denom").
+		Decode()
+	require.NoError(t, err)
+	require.Equal(t, "Test", decoded.Prefix)
+	require.Equal(t, &sdk.Coin{}, decoded.Value)
+	require.Equal(t, []sdk.StoreKeyField{
+		{Name: "address", Value: addr.String()},
+		{Name: "validator", Value: valAddr.String()},
+		{Name: "id", Value: "42"},
+		{Name: "time", Value: now.String()},
+		{Name: "denom", Value: "stake"},
+	}, decoded.Fields)
+	require.Equal(t, "Test(address="+addr.String()+", validator="+valAddr.String()+", id=42, time="+now.String()+", denom=stake)", decoded.String())
+
+	_, err = sdk.NewStoreKeyParser("Test", key[:len(key)-1], nil).AccAddress("address").Decode()
+	require.Error(t, err, "trailing bytes")
+
+	_, err = sdk.NewStoreKeyParser("Test", key[:10], nil).AccAddress("address").Decode()
+	require.Error(t, err, "key too short")
+
+	_, err = sdk.NewStoreKeyParser("Test", nil, nil).AccAddress("address").String("denom").Decode()
+	require.Error(t, err, "missing length")
+
+	_, err = sdk.NewStoreKeyParser("Test", []byte{1, 2, 3}, nil).Uint64("id").Decode()
+	require.Error(t, err, "uint64 too short")
+}
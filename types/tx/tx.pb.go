@@ -28,6 +28,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Compression defines the algorithms the body of a transaction can be
+// compressed with.
+type Compression int32
+
+const (
+	// COMPRESSION_NONE specifies an uncompressed transaction body
+	Compression_COMPRESSION_NONE Compression = 0
+	// COMPRESSION_GZIP specifies a transaction body compressed with gzip
+	Compression_COMPRESSION_GZIP Compression = 1
+	// COMPRESSION_ZSTD specifies a transaction body compressed with zstd
+	Compression_COMPRESSION_ZSTD Compression = 2
+)
+
+var Compression_name = map[int32]string{
+	0: "COMPRESSION_NONE",
+	1: "COMPRESSION_GZIP",
+	2: "COMPRESSION_ZSTD",
+}
+
+var Compression_value = map[string]int32{
+	"COMPRESSION_NONE": 0,
+	"COMPRESSION_GZIP": 1,
+	"COMPRESSION_ZSTD": 2,
+}
+
+func (x Compression) String() string {
+	return proto.EnumName(Compression_name, int32(x))
+}
+
+func (Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_96d1575ffde80842, []int{0}
+}
+
 // Tx is the standard type used for broadcasting transactions.
 type Tx struct {
 	// body is the processable content of the transaction
@@ -111,6 +144,9 @@ type TxRaw struct {
 	// AuthInfo's signer_infos to allow connecting signature meta information like
 	// public key and signing mode by position.
 	Signatures [][]byte `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// compression is the algorithm body_bytes is compressed with. The
+	// signatures of a compressed transaction cover its compressed body_bytes.
+	Compression Compression `protobuf:"varint,4,opt,name=compression,proto3,enum=cosmos.tx.v1beta1.Compression" json:"compression,omitempty"`
 }
 
 func (m *TxRaw) Reset()         { *m = TxRaw{} }
@@ -167,6 +203,13 @@ func (m *TxRaw) GetSignatures() [][]byte {
 	return nil
 }
 
+func (m *TxRaw) GetCompression() Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return Compression_COMPRESSION_NONE
+}
+
 // SignDoc is the type used for generating sign bytes for SIGN_MODE_DIRECT.
 type SignDoc struct {
 	// body_bytes is protobuf serialization of a TxBody that matches the
@@ -743,6 +786,7 @@ func (m *Fee) GetGranter() string {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.tx.v1beta1.Compression", Compression_name, Compression_value)
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.v1beta1.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.v1beta1.TxRaw")
 	proto.RegisterType((*SignDoc)(nil), "cosmos.tx.v1beta1.SignDoc")
@@ -758,60 +802,64 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0x5e, 0xef, 0x5f, 0xd6, 0x27, 0x49, 0x4b, 0x47, 0x11, 0xda, 0x6c, 0x54, 0x37, 0x18, 0x15,
-	0xf6, 0x26, 0x76, 0x9b, 0x5e, 0xf0, 0x23, 0x24, 0xc8, 0x16, 0xaa, 0x54, 0xa5, 0x20, 0x4d, 0x72,
-	0xd5, 0x1b, 0x6b, 0xec, 0x9d, 0x78, 0x47, 0x5d, 0xcf, 0x2c, 0x9e, 0x71, 0xb1, 0x1f, 0x02, 0xa9,
-	0x42, 0x42, 0xbc, 0x03, 0x2f, 0xc0, 0x2b, 0xf4, 0xb2, 0x97, 0x5c, 0x41, 0x95, 0x3c, 0x08, 0x68,
-	0xc6, 0x63, 0x27, 0x82, 0x55, 0x72, 0xc3, 0x95, 0xe7, 0x9c, 0xf9, 0xce, 0x37, 0x9f, 0xcf, 0x1f,
-	0x4c, 0x12, 0x21, 0x33, 0x21, 0x43, 0x55, 0x86, 0xaf, 0x1e, 0xc6, 0x54, 0x91, 0x87, 0xa1, 0x2a,
-	0x83, 0x55, 0x2e, 0x94, 0x40, 0x77, 0xea, 0xbb, 0x40, 0x95, 0x81, 0xbd, 0x9b, 0xec, 0xa4, 0x22,
-	0x15, 0xe6, 0x36, 0xd4, 0xa7, 0x1a, 0x38, 0x39, 0xb0, 0x24, 0x49, 0x5e, 0xad, 0x94, 0x08, 0xb3,
-	0x62, 0xa9, 0x98, 0x64, 0x69, 0xcb, 0xd8, 0x38, 0x2c, 0xdc, 0xb3, 0xf0, 0x98, 0x48, 0xda, 0x62,
-	0x12, 0xc1, 0xb8, 0xbd, 0xff, 0xf8, 0x52, 0x93, 0x64, 0x29, 0x67, 0xfc, 0x92, 0xc9, 0xda, 0x16,
-	0xb8, 0x9b, 0x0a, 0x91, 0x2e, 0x69, 0x68, 0xac, 0xb8, 0x38, 0x0b, 0x09, 0xaf, 0xea, 0x2b, 0xff,
-	0x27, 0x07, 0xba, 0xa7, 0x25, 0x3a, 0x80, 0x7e, 0x2c, 0xe6, 0xd5, 0xd8, 0xd9, 0x77, 0xa6, 0x9b,
-	0x87, 0xbb, 0xc1, 0x7f, 0xfe, 0x28, 0x38, 0x2d, 0x67, 0x62, 0x5e, 0x61, 0x03, 0x43, 0x9f, 0x82,
-	0x4b, 0x0a, 0xb5, 0x88, 0x18, 0x3f, 0x13, 0xe3, 0xae, 0x89, 0xd9, 0x5b, 0x13, 0x73, 0x54, 0xa8,
-	0xc5, 0x53, 0x7e, 0x26, 0xf0, 0x88, 0xd8, 0x13, 0xf2, 0x00, 0xb4, 0x36, 0xa2, 0x8a, 0x9c, 0xca,
-	0x71, 0x6f, 0xbf, 0x37, 0xdd, 0xc2, 0x57, 0x3c, 0x3e, 0x87, 0xc1, 0x69, 0x89, 0xc9, 0x8f, 0xe8,
-	0x2e, 0x80, 0x7e, 0x2a, 0x8a, 0x2b, 0x45, 0xa5, 0xd1, 0xb5, 0x85, 0x5d, 0xed, 0x99, 0x69, 0x07,
-	0xfa, 0x08, 0x6e, 0xb7, 0x0a, 0x2c, 0xa6, 0x6b, 0x30, 0xdb, 0xcd, 0x53, 0x35, 0xee, 0xa6, 0xf7,
-	0x7e, 0x76, 0x60, 0xe3, 0x84, 0xa5, 0xfc, 0x6b, 0x91, 0xfc, 0x5f, 0x4f, 0xee, 0xc2, 0x28, 0x59,
-	0x10, 0xc6, 0x23, 0x36, 0x1f, 0xf7, 0xf6, 0x9d, 0xa9, 0x8b, 0x37, 0x8c, 0xfd, 0x74, 0x8e, 0xee,
-	0xc3, 0x2d, 0x92, 0x24, 0xa2, 0xe0, 0x2a, 0xe2, 0x45, 0x16, 0xd3, 0x7c, 0xdc, 0xdf, 0x77, 0xa6,
-	0x7d, 0xbc, 0x6d, 0xbd, 0xdf, 0x19, 0xa7, 0xff, 0x4b, 0x17, 0x86, 0x75, 0xbe, 0xd1, 0x03, 0x18,
-	0x65, 0x54, 0x4a, 0x92, 0x1a, 0x45, 0xbd, 0xe9, 0xe6, 0xe1, 0x4e, 0x50, 0x57, 0x33, 0x68, 0xaa,
-	0x19, 0x1c, 0xf1, 0x0a, 0xb7, 0x28, 0x84, 0xa0, 0x9f, 0xd1, 0xac, 0x2e, 0x8b, 0x8b, 0xcd, 0x59,
-	0xbf, 0xab, 0x58, 0x46, 0x45, 0xa1, 0xa2, 0x05, 0x65, 0xe9, 0x42, 0x19, 0x61, 0x7d, 0xbc, 0x6d,
-	0xbd, 0xc7, 0xc6, 0x89, 0x66, 0x70, 0x87, 0x96, 0x8a, 0x72, 0xc9, 0x04, 0x8f, 0xc4, 0x4a, 0x31,
-	0xc1, 0xe5, 0xf8, 0xef, 0x8d, 0x6b, 0x9e, 0x7d, 0xaf, 0xc5, 0x7f, 0x5f, 0xc3, 0xd1, 0x0b, 0xf0,
-	0xb8, 0xe0, 0x51, 0x92, 0x33, 0xc5, 0x12, 0xb2, 0x8c, 0xd6, 0x10, 0xde, 0xbe, 0x86, 0x70, 0x8f,
-	0x0b, 0xfe, 0xd8, 0xc6, 0x7e, 0xf3, 0x2f, 0x6e, 0xff, 0x15, 0x8c, 0x9a, 0x96, 0x42, 0x5f, 0xc1,
-	0x96, 0x2e, 0x23, 0xcd, 0x4d, 0x3d, 0x9a, 0xe4, 0xdc, 0x5d, 0xd3, 0x85, 0x27, 0x06, 0x66, 0xfa,
-	0x70, 0x53, 0xb6, 0x67, 0x89, 0xa6, 0xd0, 0x3b, 0xa3, 0xd4, 0xb6, 0xef, 0xfb, 0x6b, 0x02, 0x9f,
-	0x50, 0x8a, 0x35, 0xc4, 0xff, 0xd5, 0x01, 0xb8, 0x64, 0x41, 0x8f, 0x00, 0x56, 0x45, 0xbc, 0x64,
-	0x49, 0xf4, 0x92, 0x36, 0x23, 0xb3, 0xfe, 0x6f, 0xdc, 0x1a, 0xf7, 0x8c, 0x9a, 0x91, 0xc9, 0xc4,
-	0x9c, 0xde, 0x34, 0x32, 0xcf, 0xc5, 0x9c, 0xd6, 0x23, 0x93, 0xd9, 0x13, 0x9a, 0xc0, 0x48, 0xd2,
-	0x1f, 0x0a, 0xca, 0x13, 0x6a, 0xcb, 0xd6, 0xda, 0xfe, 0xbb, 0x2e, 0x8c, 0x9a, 0x10, 0xf4, 0x05,
-	0x0c, 0x25, 0xe3, 0xe9, 0x92, 0x5a, 0x4d, 0xfe, 0x35, 0xfc, 0xc1, 0x89, 0x41, 0x1e, 0x77, 0xb0,
-	0x8d, 0x41, 0x9f, 0xc1, 0xc0, 0xec, 0x1f, 0x2b, 0xee, 0x83, 0xeb, 0x82, 0x9f, 0x6b, 0xe0, 0x71,
-	0x07, 0xd7, 0x11, 0x93, 0x23, 0x18, 0xd6, 0x74, 0xe8, 0x13, 0xe8, 0x6b, 0xdd, 0x46, 0xc0, 0xad,
-	0xc3, 0x0f, 0xaf, 0x70, 0x34, 0x1b, 0xe9, 0x6a, 0x55, 0x34, 0x1f, 0x36, 0x01, 0x93, 0xd7, 0x0e,
-	0x0c, 0x0c, 0x2b, 0x7a, 0x06, 0xa3, 0x98, 0x29, 0x92, 0xe7, 0xa4, 0xc9, 0x6d, 0xd8, 0xd0, 0xd4,
-	0x7b, 0x33, 0x68, 0xd7, 0x64, 0xc3, 0xf5, 0x58, 0x64, 0x2b, 0x92, 0xa8, 0x19, 0x53, 0x47, 0x3a,
-	0x0c, 0xb7, 0x04, 0xe8, 0x73, 0x80, 0x36, 0xeb, 0x7a, 0x5c, 0x7b, 0x37, 0xa5, 0xdd, 0x6d, 0xd2,
-	0x2e, 0x67, 0x03, 0xe8, 0xc9, 0x22, 0xf3, 0x7f, 0x77, 0xa0, 0xf7, 0x84, 0x52, 0x94, 0xc0, 0x90,
-	0x64, 0x7a, 0x48, 0x6d, 0xab, 0xb5, 0x4b, 0x52, 0xaf, 0xe7, 0x2b, 0x52, 0x18, 0x9f, 0x3d, 0x78,
-	0xf3, 0xe7, 0xbd, 0xce, 0x6f, 0x7f, 0xdd, 0x9b, 0xa6, 0x4c, 0x2d, 0x8a, 0x38, 0x48, 0x44, 0x16,
-	0x36, 0xab, 0xdf, 0x7c, 0x0e, 0xe4, 0xfc, 0x65, 0xa8, 0xaa, 0x15, 0x95, 0x26, 0x40, 0x62, 0x4b,
-	0x8d, 0xf6, 0xc0, 0x4d, 0x89, 0x8c, 0x96, 0x2c, 0x63, 0xca, 0x14, 0xa2, 0x8f, 0x47, 0x29, 0x91,
-	0xdf, 0x6a, 0x1b, 0xed, 0xc0, 0x60, 0x45, 0x2a, 0x9a, 0xdb, 0xad, 0x52, 0x1b, 0x68, 0x0c, 0x1b,
-	0x69, 0x4e, 0xb8, 0xb2, 0xcb, 0xc4, 0xc5, 0x8d, 0x39, 0xfb, 0xf2, 0xcd, 0xb9, 0xe7, 0xbc, 0x3d,
-	0xf7, 0x9c, 0x77, 0xe7, 0x9e, 0xf3, 0xfa, 0xc2, 0xeb, 0xbc, 0xbd, 0xf0, 0x3a, 0x7f, 0x5c, 0x78,
-	0x9d, 0x17, 0xf7, 0x6f, 0x16, 0x16, 0xaa, 0x32, 0x1e, 0x9a, 0x66, 0x7e, 0xf4, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0xb7, 0x75, 0x7d, 0xfd, 0x06, 0x00, 0x00,
+	// 911 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0xb7, 0x6c, 0xc7, 0xb1, 0x9f, 0x93, 0xd4, 0x25, 0x82, 0xc1, 0x71, 0x50, 0x35, 0xf3, 0xd0,
+	0xcd, 0x18, 0x10, 0xa9, 0x4d, 0x0f, 0xfb, 0x83, 0x01, 0x6b, 0x9c, 0xa6, 0x4b, 0xd0, 0x25, 0x2e,
+	0xe8, 0x9c, 0x72, 0x11, 0x28, 0x99, 0x91, 0x89, 0x5a, 0xa4, 0x27, 0x52, 0x9d, 0xfd, 0x21, 0x06,
+	0x14, 0x03, 0x86, 0x7d, 0x87, 0x9d, 0x76, 0xdb, 0x57, 0xe8, 0xb1, 0xc7, 0x9d, 0xb6, 0x22, 0xf9,
+	0x20, 0x1b, 0x44, 0x51, 0x8a, 0x91, 0x19, 0xc9, 0x65, 0x27, 0xf3, 0x3d, 0xfe, 0x7e, 0x3f, 0xfe,
+	0xc4, 0xc7, 0xf7, 0x0c, 0x9d, 0x40, 0xc8, 0x48, 0x48, 0x57, 0xcd, 0xdc, 0x37, 0x4f, 0x7c, 0xaa,
+	0xc8, 0x13, 0x57, 0xcd, 0x9c, 0x69, 0x2c, 0x94, 0x40, 0xf7, 0xb3, 0x3d, 0x47, 0xcd, 0x1c, 0xb3,
+	0xd7, 0xd9, 0x0c, 0x45, 0x28, 0xf4, 0xae, 0x9b, 0xae, 0x32, 0x60, 0x67, 0xd7, 0x88, 0x04, 0xf1,
+	0x7c, 0xaa, 0x84, 0x1b, 0x25, 0x13, 0xc5, 0x24, 0x0b, 0x0b, 0xc5, 0x3c, 0x61, 0xe0, 0xb6, 0x81,
+	0xfb, 0x44, 0xd2, 0x02, 0x13, 0x08, 0xc6, 0xcd, 0xfe, 0x67, 0xd7, 0x9e, 0x24, 0x0b, 0x39, 0xe3,
+	0xd7, 0x4a, 0x26, 0x36, 0xc0, 0xad, 0x50, 0x88, 0x70, 0x42, 0x5d, 0x1d, 0xf9, 0xc9, 0x85, 0x4b,
+	0xf8, 0x3c, 0xdb, 0xea, 0xfe, 0x64, 0x41, 0xf9, 0x6c, 0x86, 0x76, 0xa1, 0xea, 0x8b, 0xd1, 0xbc,
+	0x6d, 0xed, 0x58, 0xbd, 0xe6, 0xde, 0x96, 0xf3, 0x9f, 0x2f, 0x72, 0xce, 0x66, 0x7d, 0x31, 0x9a,
+	0x63, 0x0d, 0x43, 0x5f, 0x42, 0x83, 0x24, 0x6a, 0xec, 0x31, 0x7e, 0x21, 0xda, 0x65, 0xcd, 0xd9,
+	0x5e, 0xc2, 0xd9, 0x4f, 0xd4, 0xf8, 0x98, 0x5f, 0x08, 0x5c, 0x27, 0x66, 0x85, 0x6c, 0x80, 0xd4,
+	0x1b, 0x51, 0x49, 0x4c, 0x65, 0xbb, 0xb2, 0x53, 0xe9, 0xad, 0xe1, 0x85, 0x4c, 0xf7, 0x77, 0x0b,
+	0x56, 0xce, 0x66, 0x98, 0xfc, 0x88, 0x1e, 0x00, 0xa4, 0x67, 0x79, 0xfe, 0x5c, 0x51, 0xa9, 0x8d,
+	0xad, 0xe1, 0x46, 0x9a, 0xe9, 0xa7, 0x09, 0xf4, 0x29, 0xdc, 0x2b, 0x2c, 0x18, 0x4c, 0x59, 0x63,
+	0xd6, 0xf3, 0xb3, 0x32, 0xdc, 0x1d, 0x07, 0xa2, 0x67, 0xd0, 0x0c, 0x44, 0x34, 0x8d, 0xa9, 0x94,
+	0x4c, 0xf0, 0x76, 0x75, 0xc7, 0xea, 0x6d, 0xec, 0xd9, 0x4b, 0x3e, 0xe6, 0xe0, 0x1a, 0x85, 0x17,
+	0x29, 0xdd, 0x9f, 0x2d, 0x58, 0x1d, 0xb2, 0x90, 0x3f, 0x17, 0xc1, 0xff, 0x65, 0x7a, 0x0b, 0xea,
+	0xc1, 0x98, 0x30, 0xee, 0xb1, 0x51, 0xbb, 0xb2, 0x63, 0xf5, 0x1a, 0x78, 0x55, 0xc7, 0xc7, 0x23,
+	0xf4, 0x08, 0x36, 0x48, 0x10, 0x88, 0x84, 0x2b, 0x8f, 0x27, 0x91, 0x4f, 0x63, 0x6d, 0xb9, 0x8a,
+	0xd7, 0x4d, 0xf6, 0x54, 0x27, 0xbb, 0xbf, 0x94, 0xa1, 0x96, 0x95, 0x0c, 0x3d, 0x86, 0x7a, 0x44,
+	0xa5, 0x24, 0xa1, 0x76, 0x54, 0xe9, 0x35, 0xf7, 0x36, 0x9d, 0xec, 0x41, 0x38, 0xf9, 0x83, 0x70,
+	0xf6, 0xf9, 0x1c, 0x17, 0x28, 0x84, 0xa0, 0x1a, 0xd1, 0x28, 0xab, 0x6c, 0x03, 0xeb, 0x75, 0x7a,
+	0xae, 0x62, 0x11, 0x15, 0x89, 0xf2, 0xc6, 0x94, 0x85, 0x63, 0xa5, 0x8d, 0x55, 0xf1, 0xba, 0xc9,
+	0x1e, 0xe9, 0x24, 0xea, 0xc3, 0x7d, 0x3a, 0x53, 0x94, 0xa7, 0x37, 0xe3, 0x89, 0xa9, 0x62, 0x82,
+	0xcb, 0xf6, 0x3f, 0xab, 0xb7, 0x1c, 0xdb, 0x2a, 0xf0, 0x83, 0x0c, 0x8e, 0xce, 0xc1, 0xe6, 0x82,
+	0x7b, 0x41, 0xcc, 0x14, 0x0b, 0xc8, 0xc4, 0x5b, 0x22, 0x78, 0xef, 0x16, 0xc1, 0x6d, 0x2e, 0xf8,
+	0x81, 0xe1, 0x1e, 0xde, 0xd0, 0xee, 0xbe, 0x81, 0x7a, 0xfe, 0x2a, 0xd1, 0x33, 0x58, 0x4b, 0x1f,
+	0x02, 0x8d, 0x75, 0x3d, 0xf2, 0xcb, 0x79, 0xb0, 0xa4, 0xf6, 0x43, 0x0d, 0xd3, 0x4f, 0xb9, 0x29,
+	0x8b, 0xb5, 0x44, 0x3d, 0xa8, 0x5c, 0x50, 0x6a, 0x3a, 0xe0, 0xa3, 0x25, 0xc4, 0x17, 0x94, 0xe2,
+	0x14, 0xd2, 0xfd, 0xd5, 0x02, 0xb8, 0x56, 0x41, 0x4f, 0x01, 0xa6, 0x89, 0x3f, 0x61, 0x81, 0xf7,
+	0x9a, 0xe6, 0x5d, 0xb7, 0xfc, 0x6b, 0x1a, 0x19, 0xee, 0x25, 0xd5, 0x5d, 0x17, 0x89, 0x11, 0xbd,
+	0xab, 0xeb, 0x4e, 0xc4, 0x88, 0x66, 0x5d, 0x17, 0x99, 0x15, 0xea, 0x40, 0x5d, 0xd2, 0x1f, 0x12,
+	0xca, 0x03, 0x6a, 0xca, 0x56, 0xc4, 0xdd, 0x0f, 0x65, 0xa8, 0xe7, 0x14, 0xf4, 0x0d, 0xd4, 0x24,
+	0xe3, 0xe1, 0x84, 0x1a, 0x4f, 0xdd, 0x5b, 0xf4, 0x9d, 0xa1, 0x46, 0x1e, 0x95, 0xb0, 0xe1, 0xa0,
+	0xaf, 0x60, 0x45, 0x8f, 0x30, 0x63, 0xee, 0xe3, 0xdb, 0xc8, 0x27, 0x29, 0xf0, 0xa8, 0x84, 0x33,
+	0x46, 0x67, 0x1f, 0x6a, 0x99, 0x1c, 0xfa, 0x02, 0xaa, 0xa9, 0x6f, 0x6d, 0x60, 0x63, 0xef, 0x93,
+	0x05, 0x8d, 0x7c, 0xa8, 0x2d, 0x56, 0x25, 0xd5, 0xc3, 0x9a, 0xd0, 0x79, 0x6b, 0xc1, 0x8a, 0x56,
+	0x45, 0x2f, 0xa1, 0xee, 0x33, 0x45, 0xe2, 0x98, 0xe4, 0x77, 0xeb, 0xe6, 0x32, 0xd9, 0xe8, 0x75,
+	0x8a, 0x49, 0xbb, 0xd8, 0xdd, 0x24, 0x50, 0x7d, 0xa6, 0xf6, 0x53, 0x1a, 0x2e, 0x04, 0xd0, 0xd7,
+	0x00, 0xc5, 0xad, 0xa7, 0xed, 0x5a, 0xb9, 0xeb, 0xda, 0x1b, 0xf9, 0xb5, 0xcb, 0xfe, 0x0a, 0x54,
+	0x64, 0x12, 0x75, 0xff, 0xb0, 0xa0, 0xf2, 0x82, 0x52, 0x14, 0x40, 0x8d, 0x44, 0x69, 0x93, 0x9a,
+	0xa7, 0x56, 0xcc, 0xd9, 0x74, 0xc2, 0x2f, 0x58, 0x61, 0xbc, 0xff, 0xf8, 0xdd, 0x5f, 0x0f, 0x4b,
+	0xbf, 0xfd, 0xfd, 0xb0, 0x17, 0x32, 0x35, 0x4e, 0x7c, 0x27, 0x10, 0x91, 0x9b, 0xff, 0x7b, 0xe8,
+	0x9f, 0x5d, 0x39, 0x7a, 0xed, 0xaa, 0xf9, 0x94, 0x4a, 0x4d, 0x90, 0xd8, 0x48, 0xa3, 0x6d, 0x68,
+	0x84, 0x44, 0x7a, 0x13, 0x16, 0x31, 0xa5, 0x0b, 0x51, 0xc5, 0xf5, 0x90, 0xc8, 0xef, 0xd3, 0x18,
+	0x6d, 0xc2, 0xca, 0x94, 0xcc, 0x69, 0x6c, 0xa6, 0x4a, 0x16, 0xa0, 0x36, 0xac, 0x86, 0x31, 0xe1,
+	0xca, 0x0c, 0x93, 0x06, 0xce, 0xc3, 0xcf, 0x07, 0xd0, 0x5c, 0x98, 0x7b, 0x68, 0x13, 0x5a, 0x07,
+	0x83, 0x93, 0x57, 0xf8, 0x70, 0x38, 0x3c, 0x1e, 0x9c, 0x7a, 0xa7, 0x83, 0xd3, 0xc3, 0x56, 0xe9,
+	0x66, 0xf6, 0xbb, 0xf3, 0xe3, 0x57, 0x2d, 0xeb, 0x66, 0xf6, 0x7c, 0x78, 0xf6, 0xbc, 0x55, 0xee,
+	0x7f, 0xfb, 0xee, 0xd2, 0xb6, 0xde, 0x5f, 0xda, 0xd6, 0x87, 0x4b, 0xdb, 0x7a, 0x7b, 0x65, 0x97,
+	0xde, 0x5f, 0xd9, 0xa5, 0x3f, 0xaf, 0xec, 0xd2, 0xf9, 0xa3, 0xbb, 0xbf, 0xd4, 0x55, 0x33, 0xbf,
+	0xa6, 0xbb, 0xe3, 0xe9, 0xbf, 0x03, 0x00, 0xd7, 0x61, 0x3c, 0x77, 0x91, 0x07, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -890,6 +938,11 @@ func (m *TxRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Compression != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Signatures) > 0 {
 		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signatures[iNdEx])
@@ -1408,6 +1461,9 @@ func (m *TxRaw) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Compression != 0 {
+		n += 1 + sovTx(uint64(m.Compression))
+	}
 	return n
 }
 
@@ -1890,6 +1946,25 @@ func (m *TxRaw) Unmarshal(dAtA []byte) error {
 			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
 			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= Compression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -78,6 +78,19 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithCompression extends the Tx interface with the compression of the
+	// body of transactions broadcast compressed.
+	TxWithCompression interface {
+		Tx
+
+		// IsCompressed returns true if the body of the transaction was
+		// broadcast compressed.
+		IsCompressed() bool
+		// GetDecompressedBodySize returns the size of the body of the
+		// transaction once decompressed, or 0 if it was not compressed.
+		GetDecompressedBodySize() uint64
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/syndtr/goleveldb/leveldb/opt"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -86,13 +87,24 @@ func ParseTimeBytes(bz []byte) (time.Time, error) {
 
 // NewLevelDB instantiate a new LevelDB instance according to DBBackend.
 func NewLevelDB(name, dir string) (db dbm.DB, err error) {
+	return NewDB(name, backend, dir)
+}
+
+// NewDB instantiates a new database of the given backend type. An error is
+// returned if the backend is unknown or was not compiled in.
+func NewDB(name string, backendType dbm.BackendType, dir string) (db dbm.DB, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("couldn't create db: %v", r)
 		}
 	}()
 
-	return dbm.NewDB(name, backend, dir)
+	return dbm.NewDB(name, backendType, dir)
+}
+
+// NewReadOnlyLevelDB opens an existing goleveldb database in read-only mode.
+func NewReadOnlyLevelDB(name, dir string) (dbm.DB, error) {
+	return dbm.NewGoLevelDBWithOpts(name, dir, &opt.Options{ReadOnly: true, ErrorIfMissing: true})
 }
 
 // copy bytes
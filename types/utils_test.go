@@ -109,3 +109,24 @@ func (s *utilsTestSuite) TestParseTimeBytes() {
 	_, err = sdk.ParseTimeBytes([]byte{})
 	s.Require().Error(err)
 }
+
+func (s *utilsTestSuite) TestNewReadOnlyLevelDB() {
+	dir := s.T().TempDir()
+
+	_, err := sdk.NewReadOnlyLevelDB("application", dir)
+	s.Require().Error(err, "missing databases must not be created")
+
+	db, err := sdk.NewLevelDB("application", dir)
+	s.Require().NoError(err)
+	s.Require().NoError(db.Set([]byte("key"), []byte("value")))
+	s.Require().NoError(db.Close())
+
+	db, err = sdk.NewReadOnlyLevelDB("application", dir)
+	s.Require().NoError(err)
+	defer db.Close()
+
+	value, err := db.Get([]byte("key"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("value"), value)
+	s.Require().Error(db.Set([]byte("key"), []byte("other")))
+}
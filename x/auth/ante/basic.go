@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // ValidateBasicDecorator will call tx.ValidateBasic and return any non-nil error.
@@ -69,7 +70,9 @@ func (vmd ValidateMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 // ConsumeTxSizeGasDecorator will take in parameters and consume gas proportional
 // to the size of tx before calling next AnteHandler. Note, the gas costs will be
 // slightly over estimated due to the fact that any given signing account may need
-// to be retrieved from state.
+// to be retrieved from state. Transactions broadcast compressed are charged for
+// every byte of their body once decompressed on top of their size, and are
+// rejected unless the FeatureTxCompression protocol feature is enabled.
 //
 // CONTRACT: If simulate=true, then signatures must either be completely filled
 // in or empty.
@@ -92,7 +95,16 @@ func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 	}
 	params := cgts.ak.GetParams(ctx)
 
-	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(ctx.TxBytes())), "txSize")
+	txSize := uint64(len(ctx.TxBytes()))
+	if compressedTx, ok := tx.(sdk.TxWithCompression); ok && compressedTx.IsCompressed() {
+		if !ctx.IsFeatureEnabled(types.FeatureTxCompression) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "compressed transactions require the %s feature", types.FeatureTxCompression)
+		}
+
+		txSize += compressedTx.GetDecompressedBodySize()
+	}
+
+	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(txSize), "txSize")
 
 	// simulate gas cost for signatures in simulate mode
 	if simulate {
@@ -182,17 +194,26 @@ type (
 // AnteHandle implements an AnteHandler decorator for the TxHeightTimeoutDecorator
 // type where the current block height is checked against the tx's height timeout.
 // If a height timeout is provided (non-zero) and is less than the current block
-// height, then an error is returned.
+// height, then an error is returned. In CheckTx and ReCheckTx, the height checked
+// is the one of the next block, as the context holds the height of the last
+// committed block, or the one before the initial height of the chain before its
+// first block, and a tx can only be included in the next one: a tx is evicted
+// from the mempool as soon as it can no longer be included in a block.
 func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	timeoutTx, ok := tx.(TxWithTimeoutHeight)
 	if !ok {
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "expected tx to implement TxWithTimeoutHeight")
 	}
 
+	height := ctx.BlockHeight()
+	if ctx.IsCheckTx() {
+		height++
+	}
+
 	timeoutHeight := timeoutTx.GetTimeoutHeight()
-	if timeoutHeight > 0 && uint64(ctx.BlockHeight()) > timeoutHeight {
+	if timeoutHeight > 0 && uint64(height) > timeoutHeight {
 		return ctx, sdkerrors.Wrapf(
-			sdkerrors.ErrTxTimeoutHeight, "block height: %d, timeout height: %d", ctx.BlockHeight(), timeoutHeight,
+			sdkerrors.ErrTxTimeoutHeight, "block height: %d, timeout height: %d", height, timeoutHeight,
 		)
 	}
 
@@ -1,14 +1,25 @@
 package ante_test
 
 import (
+	"encoding/json"
 	"strings"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (suite *AnteTestSuite) TestValidateBasic() {
@@ -174,6 +185,56 @@ func (suite *AnteTestSuite) TestConsumeGasForTxSize() {
 
 }
 
+func (suite *AnteTestSuite) TestConsumeGasForCompressedTxSize() {
+	suite.SetupTest(true) // setup
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewConsumeGasForTxSizeDecorator(suite.app.AccountKeeper))
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetMemo(strings.Repeat("01234567890", 10))
+	suite.txBuilder.(authtx.CompressionTxBuilder).SetCompression(txtypes.Compression_COMPRESSION_ZSTD)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	decodedTx, err := suite.clientCtx.TxConfig.TxDecoder()(txBytes)
+	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithTxBytes(txBytes)
+
+	// compressed txs are rejected until the compression feature is enabled
+	_, err = antehandler(suite.ctx, decodedTx, false)
+	suite.Require().True(sdkerrors.ErrNotSupported.Is(err), err)
+
+	suite.ctx = suite.ctx.WithFeatureActivations(sdk.FeatureActivations{
+		{Name: authtypes.FeatureTxCompression, Height: suite.ctx.BlockHeight()},
+	})
+
+	// compressed txs are charged for their bytes and their decompressed body
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	decompressedBodySize := decodedTx.(sdk.TxWithCompression).GetDecompressedBodySize()
+	suite.Require().NotZero(decompressedBodySize)
+	expectedGas := sdk.Gas(uint64(len(txBytes))+decompressedBodySize) * params.TxSizeCostPerByte
+
+	// track how much gas is necessary to retrieve parameters
+	beforeGas := suite.ctx.GasMeter().GasConsumed()
+	suite.app.AccountKeeper.GetParams(suite.ctx)
+	expectedGas += suite.ctx.GasMeter().GasConsumed() - beforeGas
+
+	beforeGas = suite.ctx.GasMeter().GasConsumed()
+	suite.ctx, err = antehandler(suite.ctx, decodedTx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedGas, suite.ctx.GasMeter().GasConsumed()-beforeGas)
+}
+
 func (suite *AnteTestSuite) TestTxHeightTimeoutDecorator() {
 	suite.SetupTest(true)
 
@@ -191,12 +252,17 @@ func (suite *AnteTestSuite) TestTxHeightTimeoutDecorator() {
 		name      string
 		timeout   uint64
 		height    int64
+		checkTx   bool
 		expectErr bool
 	}{
-		{"default value", 0, 10, false},
-		{"no timeout (greater height)", 15, 10, false},
-		{"no timeout (same height)", 10, 10, false},
-		{"timeout (smaller height)", 9, 10, true},
+		{"default value", 0, 10, false, false},
+		{"no timeout (greater height)", 15, 10, false, false},
+		{"no timeout (same height)", 10, 10, false, false},
+		{"timeout (smaller height)", 9, 10, false, true},
+		{"check tx: default value", 0, 10, true, false},
+		{"check tx: no timeout (next height)", 11, 10, true, false},
+		{"check tx: timeout (same height)", 10, 10, true, true},
+		{"check tx: timeout (smaller height)", 9, 10, true, true},
 	}
 
 	for _, tc := range testCases {
@@ -216,9 +282,52 @@ func (suite *AnteTestSuite) TestTxHeightTimeoutDecorator() {
 			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 			suite.Require().NoError(err)
 
-			ctx := suite.ctx.WithBlockHeight(tc.height)
+			ctx := suite.ctx.WithBlockHeight(tc.height).WithIsCheckTx(tc.checkTx)
 			_, err = antehandler(ctx, tx, true)
 			suite.Require().Equal(tc.expectErr, err != nil, err)
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestTxHeightTimeoutDecoratorInitialHeight() {
+	suite.SetupTest(true)
+
+	// a chain starting at height 10 accepts txs timing out at height 10 in
+	// CheckTx before its first block, as they can be included in it
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 0, encCfg, simapp.EmptyAppOptions{})
+	stateBytes, err := json.Marshal(simapp.NewDefaultGenesisState(encCfg.Marshaler))
+	suite.Require().NoError(err)
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         "test-chain",
+		InitialHeight:   10,
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	checkTx := func(timeout uint64) abci.ResponseCheckTx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetTimeoutHeight(timeout)
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, "test-chain")
+		suite.Require().NoError(err)
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		return app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	}
+
+	// the tx passes the timeout height check, failing further down the ante
+	// handler chain as the genesis state is only committed with the first block
+	res := checkTx(10)
+	suite.Require().NotEqual(sdkerrors.ErrTxTimeoutHeight.ABCICode(), res.Code, res.Log)
+
+	res = checkTx(9)
+	suite.Require().Equal(sdkerrors.ErrTxTimeoutHeight.ABCICode(), res.Code, res.Log)
+}
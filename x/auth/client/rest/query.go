@@ -7,6 +7,9 @@ import (
 	"strings"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
@@ -20,39 +23,29 @@ import (
 
 // QueryAccountRequestHandlerFn is the query accountREST Handler.
 func QueryAccountRequestHandlerFn(storeName string, clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		bech32addr := vars["address"]
-
-		addr, err := sdk.AccAddressFromBech32(bech32addr)
-		if rest.CheckInternalServerError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+		res, err := types.NewQueryClient(clientCtx).Account(r.Context(), &types.QueryAccountRequest{Address: addr.String()}, opts...)
+		if status.Code(err) == codes.NotFound {
+			// unknown accounts are returned empty
+			return types.BaseAccount{}, nil
 		}
 
-		accGetter := types.AccountRetriever{}
-
-		account, height, err := accGetter.GetAccountWithHeight(clientCtx, addr)
 		if err != nil {
-			// TODO: Handle more appropriately based on the error type.
-			// Ref: https://github.com/cosmos/cosmos-sdk/issues/4923
-			if err := accGetter.EnsureExists(clientCtx, addr); err != nil {
-				clientCtx = clientCtx.WithHeight(height)
-				rest.PostProcessResponse(w, clientCtx, types.BaseAccount{})
-				return
-			}
+			return nil, err
+		}
 
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
+		var account types.AccountI
+		if err := clientCtx.InterfaceRegistry.UnpackAny(res.Account, &account); err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, account)
-	}
+		return account, nil
+	})
 }
 
 // QueryTxsRequestHandlerFn implements a REST handler that searches for transactions.
@@ -163,21 +156,14 @@ func QueryTxRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
 }
 
 func queryParamsHandler(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
-		res, height, err := clientCtx.QueryWithData(route, nil)
-		if rest.CheckInternalServerError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		res, err := types.NewQueryClient(clientCtx).Params(r.Context(), &types.QueryParamsRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Params, nil
+	})
 }
 
 // packStdTxResponse takes a sdk.TxResponse, converts the Tx into a StdTx, and
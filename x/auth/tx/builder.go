@@ -27,6 +27,13 @@ type wrapper struct {
 	authInfoBz []byte
 
 	txBodyHasUnknownNonCriticals bool
+
+	// compression is the algorithm bodyBz is compressed with
+	compression tx.Compression
+
+	// decompressedBodySize represents the size of the body once decompressed
+	// if the tx was decoded from a compressed body, and 0 otherwise
+	decompressedBodySize int
 }
 
 var (
@@ -35,6 +42,8 @@ var (
 	_ ante.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
 	_ ProtoTxProvider            = &wrapper{}
+	_ sdk.TxWithCompression      = &wrapper{}
+	_ CompressionTxBuilder       = &wrapper{}
 )
 
 // ExtensionOptionsTxBuilder defines a TxBuilder that can also set extensions.
@@ -45,6 +54,14 @@ type ExtensionOptionsTxBuilder interface {
 	SetNonCriticalExtensionOptions(...*codectypes.Any)
 }
 
+// CompressionTxBuilder defines a TxBuilder that can also compress the body of
+// the transaction.
+type CompressionTxBuilder interface {
+	client.TxBuilder
+
+	SetCompression(tx.Compression)
+}
+
 func newBuilder() *wrapper {
 	return &wrapper{
 		tx: &tx.Tx{
@@ -76,6 +93,12 @@ func (w *wrapper) getBodyBytes() []byte {
 		if err != nil {
 			panic(err)
 		}
+
+		// the signatures of compressed txs cover the compressed body
+		w.bodyBz, err = CompressTxBody(w.bodyBz, w.compression)
+		if err != nil {
+			panic(err)
+		}
 	}
 	return w.bodyBz
 }
@@ -162,6 +185,14 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+func (w *wrapper) IsCompressed() bool {
+	return w.compression != tx.Compression_COMPRESSION_NONE
+}
+
+func (w *wrapper) GetDecompressedBodySize() uint64 {
+	return uint64(w.decompressedBodySize)
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -232,6 +263,16 @@ func (w *wrapper) SetMemo(memo string) {
 	w.bodyBz = nil
 }
 
+// SetCompression sets the algorithm the body of the transaction is compressed
+// with when encoded. The signatures of a compressed transaction cover its
+// compressed body, hence the compression must be set before signing.
+func (w *wrapper) SetCompression(compression tx.Compression) {
+	w.compression = compression
+
+	// set bodyBz to nil because the cached bodyBz no longer matches the compression
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetGasLimit(limit uint64) {
 	if w.tx.AuthInfo.Fee == nil {
 		w.tx.AuthInfo.Fee = &tx.Fee{}
@@ -0,0 +1,190 @@
+package tx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	// MaxDecompressedBodySize defines the maximum size of the body of a
+	// transaction once decompressed.
+	MaxDecompressedBodySize = 8 << 20
+
+	// MaxCompressionRatio defines the maximum ratio between the size of the
+	// body of a transaction once decompressed and its compressed size, so that
+	// the work of decompressing a transaction remains proportional to its size.
+	MaxCompressionRatio = 16
+)
+
+var (
+	// zstdDecoder decodes the zstd compressed bodies of all transactions, as
+	// zstd decoders are expensive to create. Its DecodeAll method is safe for
+	// concurrent use.
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+	zstdDecoderOnce sync.Once
+
+	// gzipReaders pools the gzip readers of the gzip compressed bodies.
+	gzipReaders sync.Pool
+)
+
+// CompressTxBody compresses the given transaction body bytes with the given
+// algorithm.
+func CompressTxBody(bodyBz []byte, compression txtypes.Compression) ([]byte, error) {
+	var buf bytes.Buffer
+
+	switch compression {
+	case txtypes.Compression_COMPRESSION_NONE:
+		return bodyBz, nil
+
+	case txtypes.Compression_COMPRESSION_GZIP:
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(bodyBz); err != nil {
+			return nil, err
+		}
+
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+
+	case txtypes.Compression_COMPRESSION_ZSTD:
+		w, err := zstd.NewWriter(&buf, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := w.Write(bodyBz); err != nil {
+			return nil, err
+		}
+
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown tx compression %s", compression)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DecompressTxBody decompresses the given transaction body bytes compressed
+// with the given algorithm. An error is returned if the decompressed bytes
+// exceed MaxDecompressedBodySize, or MaxCompressionRatio times the size of
+// the compressed bytes.
+func DecompressTxBody(bz []byte, compression txtypes.Compression) ([]byte, error) {
+	maxSize := MaxCompressionRatio * len(bz)
+	if maxSize > MaxDecompressedBodySize {
+		maxSize = MaxDecompressedBodySize
+	}
+
+	var (
+		bodyBz []byte
+		err    error
+	)
+
+	switch compression {
+	case txtypes.Compression_COMPRESSION_NONE:
+		return bz, nil
+
+	case txtypes.Compression_COMPRESSION_GZIP:
+		bodyBz, err = gunzip(bz, maxSize)
+
+	case txtypes.Compression_COMPRESSION_ZSTD:
+		bodyBz, err = unzstd(bz)
+
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "unknown tx compression %s", compression)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bodyBz) > maxSize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxTooLarge, "decompressed tx body exceeds %d bytes", maxSize)
+	}
+
+	return bodyBz, nil
+}
+
+// gunzip decompresses the given gzip stream, reading at most one byte past
+// maxSize to detect oversized payloads.
+func gunzip(bz []byte, maxSize int) ([]byte, error) {
+	var (
+		r   *gzip.Reader
+		err error
+	)
+
+	if pooled, ok := gzipReaders.Get().(*gzip.Reader); ok {
+		r = pooled
+		err = r.Reset(bytes.NewReader(bz))
+	} else {
+		r, err = gzip.NewReader(bytes.NewReader(bz))
+	}
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	defer gzipReaders.Put(r)
+
+	bodyBz, err := ioutil.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	return bodyBz, nil
+}
+
+// unzstd decompresses the given zstd frames with the shared zstd decoder,
+// whose memory is bounded by MaxDecompressedBodySize.
+func unzstd(bz []byte) ([]byte, error) {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxDecompressedBodySize))
+	})
+
+	if zstdDecoderErr != nil {
+		return nil, zstdDecoderErr
+	}
+
+	bodyBz, err := zstdDecoder.DecodeAll(bz, nil)
+	switch {
+	case err == zstd.ErrDecoderSizeExceeded:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxTooLarge, "decompressed tx body exceeds %d bytes", MaxDecompressedBodySize)
+
+	case err != nil:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	return bodyBz, nil
+}
+
+// SetCompressionAboveThreshold sets the compression of the transaction built
+// by builder to the given algorithm if the encoding of its body exceeds
+// threshold bytes, and leaves it uncompressed otherwise as compressing small
+// transactions rarely pays off. It must be called once the body of the
+// transaction is complete and before it is signed, as the signatures of a
+// compressed transaction cover its compressed body.
+func SetCompressionAboveThreshold(builder client.TxBuilder, compression txtypes.Compression, threshold int) error {
+	w, ok := builder.(*wrapper)
+	if !ok {
+		return fmt.Errorf("expected %T, got %T", &wrapper{}, builder)
+	}
+
+	w.SetCompression(txtypes.Compression_COMPRESSION_NONE)
+	if len(w.getBodyBytes()) > threshold {
+		w.SetCompression(compression)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package tx
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var compressions = []txtypes.Compression{txtypes.Compression_COMPRESSION_GZIP, txtypes.Compression_COMPRESSION_ZSTD}
+
+func TestCompressedTx(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	decoder := DefaultTxDecoder(codec.NewProtoCodec(registry))
+
+	builder := newBuilder()
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg()))
+	var memo strings.Builder
+	for i := 0; i < 128; i++ {
+		fmt.Fprintf(&memo, "%d,", i*i*i)
+	}
+	builder.SetMemo(memo.String())
+
+	rawBz, err := DefaultTxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	bodySize := len(builder.getBodyBytes())
+
+	for _, compression := range compressions {
+		compression := compression
+		t.Run(compression.String(), func(t *testing.T) {
+			// below the threshold the tx is left uncompressed
+			require.NoError(t, SetCompressionAboveThreshold(builder, compression, bodySize))
+			txBz, err := DefaultTxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+			require.Equal(t, rawBz, txBz)
+
+			require.NoError(t, SetCompressionAboveThreshold(builder, compression, 256))
+			txBz, err = DefaultTxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+			require.Less(t, len(txBz), len(rawBz))
+
+			decoded, err := decoder(txBz)
+			require.NoError(t, err)
+			require.Equal(t, builder.GetMemo(), decoded.(sdk.TxWithMemo).GetMemo())
+			require.True(t, decoded.(sdk.TxWithCompression).IsCompressed())
+			require.Equal(t, uint64(bodySize), decoded.(sdk.TxWithCompression).GetDecompressedBodySize())
+
+			// the signed body bytes are the compressed bytes of the tx
+			require.Equal(t, builder.getBodyBytes(), decoded.(*wrapper).getBodyBytes())
+			reencoded, err := DefaultTxEncoder()(decoded)
+			require.NoError(t, err)
+			require.Equal(t, txBz, reencoded)
+
+			// the compressed body can not be decoded with another compression
+			var raw txtypes.TxRaw
+			require.NoError(t, proto.Unmarshal(txBz, &raw))
+			for _, other := range append(compressions, txtypes.Compression_COMPRESSION_NONE) {
+				if other == compression {
+					continue
+				}
+
+				raw.Compression = other
+				bz, err := proto.Marshal(&raw)
+				require.NoError(t, err)
+				_, err = decoder(bz)
+				require.Error(t, err, other.String())
+			}
+		})
+	}
+
+	decoded, err := decoder(rawBz)
+	require.NoError(t, err)
+	require.False(t, decoded.(sdk.TxWithCompression).IsCompressed())
+	require.Equal(t, uint64(0), decoded.(sdk.TxWithCompression).GetDecompressedBodySize())
+}
+
+func TestDecompressTxBodyLimits(t *testing.T) {
+	random := make([]byte, MaxDecompressedBodySize+1)
+	_, err := rand.Read(random)
+	require.NoError(t, err)
+
+	for _, compression := range compressions {
+		// the decompressed size is bounded by the size of the compressed bytes
+		payload := bytes.Repeat([]byte{0x0a}, 4096)
+		bz, err := CompressTxBody(payload, compression)
+		require.NoError(t, err)
+		require.Greater(t, len(payload), MaxCompressionRatio*len(bz))
+
+		_, err = DecompressTxBody(bz, compression)
+		require.True(t, sdkerrors.ErrTxTooLarge.Is(err), compression.String())
+
+		payload = random[:1024]
+		bz, err = CompressTxBody(payload, compression)
+		require.NoError(t, err)
+
+		decompressed, err := DecompressTxBody(bz, compression)
+		require.NoError(t, err)
+		require.Equal(t, payload, decompressed)
+
+		// and by MaxDecompressedBodySize
+		bz, err = CompressTxBody(random, compression)
+		require.NoError(t, err)
+
+		_, err = DecompressTxBody(bz, compression)
+		require.True(t, sdkerrors.ErrTxTooLarge.Is(err), compression.String())
+
+		// corrupted streams must be rejected
+		_, err = DecompressTxBody([]byte{0x00, 0x01, 0x02, 0x03}, compression)
+		require.True(t, sdkerrors.ErrTxDecode.Is(err), compression.String())
+	}
+
+	_, err = DecompressTxBody([]byte{0x00}, txtypes.Compression(42))
+	require.True(t, sdkerrors.ErrTxDecode.Is(err))
+}
@@ -9,6 +9,8 @@ import (
 )
 
 // DefaultTxDecoder returns a default protobuf TxDecoder using the provided Marshaler.
+// The body of transactions flagged as compressed in their TxRaw is decompressed
+// before being decoded.
 func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 	return func(txBytes []byte) (sdk.Tx, error) {
 		var raw tx.TxRaw
@@ -24,15 +26,25 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 			return nil, err
 		}
 
+		bodyBz, err := DecompressTxBody(raw.BodyBytes, raw.Compression)
+		if err != nil {
+			return nil, err
+		}
+
+		var decompressedBodySize int
+		if raw.Compression != tx.Compression_COMPRESSION_NONE {
+			decompressedBodySize = len(bodyBz)
+		}
+
 		var body tx.TxBody
 
 		// allow non-critical unknown fields in TxBody
-		txBodyHasUnknownNonCriticals, err := unknownproto.RejectUnknownFields(raw.BodyBytes, &body, true, cdc.InterfaceRegistry())
+		txBodyHasUnknownNonCriticals, err := unknownproto.RejectUnknownFields(bodyBz, &body, true, cdc.InterfaceRegistry())
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
 
-		err = cdc.UnmarshalBinaryBare(raw.BodyBytes, &body)
+		err = cdc.UnmarshalBinaryBare(bodyBz, &body)
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
@@ -61,6 +73,8 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 			bodyBz:                       raw.BodyBytes,
 			authInfoBz:                   raw.AuthInfoBytes,
 			txBodyHasUnknownNonCriticals: txBodyHasUnknownNonCriticals,
+			compression:                  raw.Compression,
+			decompressedBodySize:         decompressedBodySize,
 		}, nil
 	}
 }
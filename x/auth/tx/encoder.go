@@ -22,6 +22,7 @@ func DefaultTxEncoder() sdk.TxEncoder {
 			BodyBytes:     txWrapper.getBodyBytes(),
 			AuthInfoBytes: txWrapper.getAuthInfoBytes(),
 			Signatures:    txWrapper.tx.Signatures,
+			Compression:   txWrapper.compression,
 		}
 
 		return proto.Marshal(raw)
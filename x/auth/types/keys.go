@@ -1,6 +1,12 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,6 +22,10 @@ const (
 
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
+
+	// FeatureTxCompression is the name of the protocol feature enabling txs
+	// with a compressed body.
+	FeatureTxCompression = "tx-compression"
 )
 
 var (
@@ -30,3 +40,20 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// DecodeStoreKey decodes the key of an entry of the auth store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	switch {
+	case bytes.HasPrefix(key, AddressStoreKeyPrefix):
+		// account addresses are not length-prefixed
+		return sdk.NewStoreKeyParser("Accounts", key[1:], &codectypes.Any{}).
+			Field("address", len(key)-1, func(bz []byte) string { return sdk.AccAddress(bz).String() }).
+			Decode()
+
+	case bytes.HasPrefix(key, GlobalAccountNumberKey):
+		return sdk.NewStoreKeyParser("GlobalAccountNumber", key[len(GlobalAccountNumberKey):], &gogotypes.UInt64Value{}).Decode()
+
+	default:
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+}
@@ -25,6 +25,7 @@ import (
 const FlagSpendLimit = "spend-limit"
 const FlagMsgType = "msg-type"
 const FlagExpiration = "expiration"
+const FlagMaxGas = "max-gas"
 const FlagAllowedValidators = "allowed-validators"
 const FlagDenyValidators = "deny-validators"
 const delegate = "delegate"
@@ -80,6 +81,11 @@ Examples:
 				return err
 			}
 
+			maxGas, err := cmd.Flags().GetUint64(FlagMaxGas)
+			if err != nil {
+				return err
+			}
+
 			var authorization exported.Authorization
 			switch args[1] {
 			case "send":
@@ -160,7 +166,7 @@ Examples:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
 
-			msg, err := types.NewMsgGrantAuthorization(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0))
+			msg, err := types.NewMsgGrantAuthorization(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0), maxGas)
 			if err != nil {
 				return err
 			}
@@ -181,6 +187,7 @@ Examples:
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+	cmd.Flags().Uint64(FlagMaxGas, 0, "The maximum gas the execution of a message under the grant may consume. Default is no limit.")
 	return cmd
 }
 
@@ -23,7 +23,7 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 			panic("expected authorization")
 		}
 
-		err = keeper.Grant(ctx, grantee, granter, authorization, entry.Expiration)
+		err = keeper.Grant(ctx, grantee, granter, authorization, entry.Expiration, entry.MaxGas)
 		if err != nil {
 			panic(err)
 		}
@@ -40,6 +40,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 			Grantee:       grantee.String(),
 			Expiration:    exp,
 			Authorization: grant.Authorization,
+			MaxGas:        grant.MaxGas,
 		})
 		return false
 	})
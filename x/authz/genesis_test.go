@@ -42,7 +42,7 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 
 	now := suite.ctx.BlockHeader().Time
 	grant := &bank.SendAuthorization{SpendLimit: coins}
-	err := suite.keeper.Grant(suite.ctx, granteeAddr, granterAddr, grant, now.Add(time.Hour))
+	err := suite.keeper.Grant(suite.ctx, granteeAddr, granterAddr, grant, now.Add(time.Hour), 0)
 	suite.Require().NoError(err)
 	genesis := authz.ExportGenesis(suite.ctx, suite.keeper)
 
@@ -58,7 +58,7 @@ func (suite *TestSuite) TestGRPCQueryAuthorization() {
 				now := ctx.BlockHeader().Time
 				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
 				expAuthorization = &banktypes.SendAuthorization{SpendLimit: newCoins}
-				err := app.AuthzKeeper.Grant(ctx, addrs[0], addrs[1], expAuthorization, now.Add(time.Hour))
+				err := app.AuthzKeeper.Grant(ctx, addrs[0], addrs[1], expAuthorization, now.Add(time.Hour), 0)
 				suite.Require().NoError(err)
 				req = &types.QueryAuthorizationRequest{
 					Granter:    addrs[1].String(),
@@ -126,7 +126,7 @@ func (suite *TestSuite) TestGRPCQueryAuthorizations() {
 				now := ctx.BlockHeader().Time
 				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
 				expAuthorization = &banktypes.SendAuthorization{SpendLimit: newCoins}
-				err := app.AuthzKeeper.Grant(ctx, addrs[0], addrs[1], expAuthorization, now.Add(time.Hour))
+				err := app.AuthzKeeper.Grant(ctx, addrs[0], addrs[1], expAuthorization, now.Add(time.Hour), 0)
 				suite.Require().NoError(err)
 				req = &types.QueryAuthorizationsRequest{
 					Granter: addrs[1].String(),
@@ -18,17 +18,17 @@ import (
 )
 
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      codec.BinaryMarshaler
-	router   *baseapp.MsgServiceRouter
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryMarshaler
+	dispatcher baseapp.MsgDispatcher
 }
 
 // NewKeeper constructs a message authorization Keeper
 func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter) Keeper {
 	return Keeper{
-		storeKey: storeKey,
-		cdc:      cdc,
-		router:   router,
+		storeKey:   storeKey,
+		cdc:        cdc,
+		dispatcher: baseapp.NewMsgDispatcher(router),
 	}
 }
 
@@ -75,18 +75,23 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 // grants from the message signer to the grantee.
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, serviceMsgs []sdk.ServiceMsg) (*sdk.Result, error) {
 	var msgResult *sdk.Result
-	var err error
 	for _, serviceMsg := range serviceMsgs {
 		signers := serviceMsg.GetSigners()
 		if len(signers) != 1 {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "authorization can be given to msg with only one signer")
 		}
 		granter := signers[0]
+		var maxGas uint64
 		if !granter.Equals(grantee) {
-			authorization, _ := k.GetOrRevokeAuthorization(ctx, grantee, granter, serviceMsg.MethodName)
+			grant, found := k.getOrRevokeAuthorizationGrant(ctx, grantee, granter, serviceMsg.MethodName)
+			if !found {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authorization not found")
+			}
+			authorization := grant.GetAuthorizationGrant()
 			if authorization == nil {
 				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authorization not found")
 			}
+			maxGas = grant.MaxGas
 			updated, del, err := authorization.Accept(serviceMsg, ctx.BlockHeader())
 			if err != nil {
 				return nil, err
@@ -100,35 +105,76 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, service
 				}
 			}
 		}
-		handler := k.router.Handler(serviceMsg.Route())
+		res, err := execWithMaxGas(ctx, maxGas, func(ctx sdk.Context) (baseapp.DispatchResult, error) {
+			return k.dispatcher.Dispatch(ctx, granter, serviceMsg)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		msgResult = res.Result
+	}
+
+	return msgResult, nil
+}
+
+// execWithMaxGas executes the message with dispatch under a gas meter limited to
+// maxGas, unless maxGas is 0, and charges the consumed gas to the gas meter of
+// ctx. The limit is further capped to the gas remaining in ctx, and running out
+// of it is reported as the tx running out of gas.
+func execWithMaxGas(ctx sdk.Context, maxGas uint64, dispatch func(ctx sdk.Context) (baseapp.DispatchResult, error)) (res baseapp.DispatchResult, err error) {
+	if maxGas == 0 {
+		return dispatch(ctx)
+	}
 
-		if handler == nil {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", serviceMsg.Route())
+	limit, txLimited := maxGas, false
+	if txGasMeter := ctx.GasMeter(); txGasMeter.Limit() > 0 {
+		remaining := uint64(0)
+		if !txGasMeter.IsOutOfGas() {
+			remaining = txGasMeter.Limit() - txGasMeter.GasConsumed()
 		}
 
-		msgResult, err = handler(ctx, serviceMsg.Request)
-		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed to execute message; message %s", serviceMsg.MethodName)
+		if remaining < limit {
+			limit, txLimited = remaining, true
 		}
 	}
 
-	return msgResult, nil
+	gasMeter := sdk.NewGasMeter(limit)
+	defer func() {
+		r := recover()
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "authorization max gas")
+
+		if r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok || txLimited {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v; max gas of the grant: %d", oog.Descriptor, maxGas)
+		}
+	}()
+
+	return dispatch(ctx.WithGasMeter(gasMeter))
 }
 
 // Grant method grants the provided authorization to the grantee on the granter's account with the provided expiration
-// time. If there is an existing authorization grant for the same `sdk.Msg` type, this grant
-// overwrites that.
-func (k Keeper) Grant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization exported.Authorization, expiration time.Time) error {
+// time, limiting the gas of each message executed under the grant to maxGas if not 0. If there is an existing
+// authorization grant for the same `sdk.Msg` type, this grant overwrites that.
+func (k Keeper) Grant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization exported.Authorization, expiration time.Time, maxGas uint64) error {
 	store := ctx.KVStore(k.storeKey)
 
-	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	grant, err := types.NewAuthorizationGrant(authorization, expiration, maxGas)
 	if err != nil {
 		return err
 	}
 
 	bz := k.cdc.MustMarshalBinaryBare(&grant)
 	grantStoreKey := types.GetAuthorizationStoreKey(grantee, granter, authorization.MethodName())
+	if oldGrant, found := k.getAuthorizationGrant(ctx, grantStoreKey); found {
+		store.Delete(types.GetGrantQueueKey(oldGrant.Expiration, grantee, granter, authorization.MethodName()))
+	}
 	store.Set(grantStoreKey, bz)
+	store.Set(types.GetGrantQueueKey(expiration, grantee, granter, authorization.MethodName()), grantStoreKey)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -146,11 +192,12 @@ func (k Keeper) Grant(ctx sdk.Context, grantee, granter sdk.AccAddress, authoriz
 func (k Keeper) Revoke(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error {
 	store := ctx.KVStore(k.storeKey)
 	grantStoreKey := types.GetAuthorizationStoreKey(grantee, granter, msgType)
-	_, found := k.getAuthorizationGrant(ctx, grantStoreKey)
+	grant, found := k.getAuthorizationGrant(ctx, grantStoreKey)
 	if !found {
 		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "authorization not found")
 	}
 	store.Delete(grantStoreKey)
+	store.Delete(types.GetGrantQueueKey(grant.Expiration, grantee, granter, msgType))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -182,16 +229,48 @@ func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, grant
 // granted to the grantee by the granter for the provided msg type.
 // If the Authorization is expired already, it will revoke the authorization and return nil
 func (k Keeper) GetOrRevokeAuthorization(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) (cap exported.Authorization, expiration time.Time) {
-	grant, found := k.getAuthorizationGrant(ctx, types.GetAuthorizationStoreKey(grantee, granter, msgType))
+	grant, found := k.getOrRevokeAuthorizationGrant(ctx, grantee, granter, msgType)
 	if !found {
 		return nil, time.Time{}
 	}
+
+	return grant.GetAuthorizationGrant(), grant.Expiration
+}
+
+// getOrRevokeAuthorizationGrant returns the grant for the provided msg type, revoking it if it is expired already.
+func (k Keeper) getOrRevokeAuthorizationGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) (grant types.AuthorizationGrant, found bool) {
+	grant, found = k.getAuthorizationGrant(ctx, types.GetAuthorizationStoreKey(grantee, granter, msgType))
+	if !found {
+		return grant, false
+	}
 	if grant.Expiration.Before(ctx.BlockHeader().Time) {
 		k.Revoke(ctx, grantee, granter, msgType)
-		return nil, time.Time{}
+		return types.AuthorizationGrant{}, false
 	}
 
-	return grant.GetAuthorizationGrant(), grant.Expiration
+	return grant, true
+}
+
+// DequeueAndDeleteExpiredGrants revokes the authorization grants which are
+// expired at the time of the block, i.e. whose expiration is before it.
+func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GrantQueuePrefix, types.GrantQueueTimePrefix(ctx.BlockHeader().Time))
+
+	// collect the expired grants first, as revoking them deletes their queue
+	// entries
+	var grantStoreKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		grantStoreKeys = append(grantStoreKeys, iter.Value())
+	}
+	iter.Close()
+
+	for _, grantStoreKey := range grantStoreKeys {
+		granter, grantee := types.ExtractAddressesFromGrantKey(grantStoreKey)
+		if err := k.Revoke(ctx, grantee, granter, types.ExtractMsgTypeFromGrantKey(grantStoreKey)); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // IterateGrants iterates over all authorization grants
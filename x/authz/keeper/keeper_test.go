@@ -15,8 +15,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 type TestSuite struct {
@@ -62,14 +64,14 @@ func (s *TestSuite) TestKeeper() {
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
 	s.T().Log("verify if expired authorization is rejected")
 	x := &banktypes.SendAuthorization{SpendLimit: newCoins}
-	err := app.AuthzKeeper.Grant(ctx, granterAddr, granteeAddr, x, now.Add(-1*time.Hour))
+	err := app.AuthzKeeper.Grant(ctx, granterAddr, granteeAddr, x, now.Add(-1*time.Hour), 0)
 	s.Require().NoError(err)
 	authorization, _ = app.AuthzKeeper.GetOrRevokeAuthorization(ctx, granteeAddr, granterAddr, banktypes.SendAuthorization{}.MethodName())
 	s.Require().Nil(authorization)
 
 	s.T().Log("verify if authorization is accepted")
 	x = &banktypes.SendAuthorization{SpendLimit: newCoins}
-	err = app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, x, now.Add(time.Hour))
+	err = app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, x, now.Add(time.Hour), 0)
 	s.Require().NoError(err)
 	authorization, _ = app.AuthzKeeper.GetOrRevokeAuthorization(ctx, granteeAddr, granterAddr, banktypes.SendAuthorization{}.MethodName())
 	s.Require().NotNil(authorization)
@@ -113,7 +115,7 @@ func (s *TestSuite) TestKeeperIter() {
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
 	s.T().Log("verify if expired authorization is rejected")
 	x := &banktypes.SendAuthorization{SpendLimit: newCoins}
-	err := app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, x, now.Add(-1*time.Hour))
+	err := app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, x, now.Add(-1*time.Hour), 0)
 	s.Require().NoError(err)
 	authorization, _ = app.AuthzKeeper.GetOrRevokeAuthorization(ctx, granteeAddr, granterAddr, "abcd")
 	s.Require().Nil(authorization)
@@ -162,7 +164,7 @@ func (s *TestSuite) TestKeeperFees() {
 
 	s.T().Log("verify dispatch executes with correct information")
 	// grant authorization
-	err = app.AuthzKeeper.Grant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: smallCoin}, now)
+	err = app.AuthzKeeper.Grant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: smallCoin}, now, 0)
 	s.Require().NoError(err)
 	authorization, _ := app.AuthzKeeper.GetOrRevokeAuthorization(s.ctx, granteeAddr, granterAddr, banktypes.SendAuthorization{}.MethodName())
 	s.Require().NotNil(authorization)
@@ -205,6 +207,149 @@ func (s *TestSuite) TestKeeperFees() {
 	s.Require().NotNil(authorization)
 }
 
+func (s *TestSuite) TestKeeperMaxGas() {
+	app, addrs := s.app, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	now := s.ctx.BlockHeader().Time
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	msgs := types.NewMsgExecAuthorized(granteeAddr, []sdk.ServiceMsg{
+		{
+			MethodName: banktypes.SendAuthorization{}.MethodName(),
+			Request: &banktypes.MsgSend{
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 2)),
+				FromAddress: granterAddr.String(),
+				ToAddress:   recipientAddr.String(),
+			},
+		},
+	})
+	s.Require().NoError(msgs.UnpackInterfaces(app.AppCodec()))
+	executeMsgs, err := msgs.GetServiceMsgs()
+	s.Require().NoError(err)
+
+	dispatchWithTxGas := func(maxGas uint64, txGasMeter sdk.GasMeter) (uint64, error) {
+		ctx, _ := s.ctx.CacheContext()
+		err := app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(spendLimit), now.Add(time.Hour), maxGas)
+		s.Require().NoError(err)
+
+		ctx = ctx.WithGasMeter(txGasMeter)
+		_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, executeMsgs)
+		return ctx.GasMeter().GasConsumed(), err
+	}
+	dispatch := func(maxGas uint64) (uint64, error) {
+		return dispatchWithTxGas(maxGas, sdk.NewGasMeter(1000000))
+	}
+
+	s.T().Log("verify dispatch executes without max gas")
+	gasUsed, err := dispatch(0)
+	s.Require().NoError(err)
+
+	s.T().Log("verify dispatch executes within the max gas of the grant and charges its gas")
+	gasUsedWithMaxGas, err := dispatch(gasUsed)
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(gasUsedWithMaxGas, gasUsed)
+
+	s.T().Log("verify dispatch fails when the message exceeds the max gas of the grant")
+	gasUsedWithMaxGas, err = dispatch(10)
+	s.Require().True(sdkerrors.ErrOutOfGas.Is(err))
+	s.Require().Less(gasUsedWithMaxGas, gasUsed)
+
+	s.T().Log("verify the tx runs out of gas when the max gas of the grant exceeds the gas left in the tx")
+	txGasMeter := sdk.NewGasMeter(gasUsed / 2)
+	func() {
+		defer func() {
+			_, ok := recover().(sdk.ErrorOutOfGas)
+			s.Require().True(ok)
+		}()
+
+		_, _ = dispatchWithTxGas(10*gasUsed, txGasMeter)
+		s.Fail("dispatch should run out of gas")
+	}()
+	s.Require().Equal(txGasMeter.Limit(), txGasMeter.GasConsumed())
+}
+
+func (s *TestSuite) TestKeeperGenericAuthorization() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	withdrawAddr := addrs[2]
+	now := ctx.BlockHeader().Time
+	methodName := "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
+
+	msgs := types.NewMsgExecAuthorized(granteeAddr, []sdk.ServiceMsg{
+		{
+			MethodName: methodName,
+			Request:    distrtypes.NewMsgSetWithdrawAddress(granterAddr, withdrawAddr),
+		},
+	})
+	s.Require().NoError(msgs.UnpackInterfaces(app.AppCodec()))
+	executeMsgs, err := msgs.GetServiceMsgs()
+	s.Require().NoError(err)
+
+	s.T().Log("verify dispatch fails without a generic authorization")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, executeMsgs)
+	s.Require().True(sdkerrors.ErrUnauthorized.Is(err))
+
+	s.T().Log("verify dispatch executes any message of the method of a generic authorization")
+	err = app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, types.NewGenericAuthorization(methodName), now.Add(time.Hour), 0)
+	s.Require().NoError(err)
+
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, executeMsgs)
+	s.Require().NoError(err)
+	s.Require().Equal(withdrawAddr, app.DistrKeeper.GetDelegatorWithdrawAddr(ctx, granterAddr))
+
+	s.T().Log("verify the generic authorization is kept")
+	authorization, expiration := app.AuthzKeeper.GetOrRevokeAuthorization(ctx, granteeAddr, granterAddr, methodName)
+	s.Require().Equal(types.NewGenericAuthorization(methodName), authorization)
+	s.Require().Equal(now.Add(time.Hour), expiration)
+}
+
+func (s *TestSuite) TestDequeueAndDeleteExpiredGrants() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	now := ctx.BlockHeader().Time
+	sendMethod := banktypes.SendAuthorization{}.MethodName()
+	genericMethod := "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	s.Require().NoError(app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 10))), now.Add(time.Hour), 0))
+	s.Require().NoError(app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, types.NewGenericAuthorization(genericMethod), now.Add(2*time.Hour), 0))
+	s.Require().True(store.Has(types.GetGrantQueueKey(now.Add(time.Hour), granteeAddr, granterAddr, sendMethod)))
+
+	s.T().Log("verify granting again moves the grant in the grant queue")
+	s.Require().NoError(app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 10))), now.Add(3*time.Hour), 0))
+	s.Require().False(store.Has(types.GetGrantQueueKey(now.Add(time.Hour), granteeAddr, granterAddr, sendMethod)))
+	s.Require().True(store.Has(types.GetGrantQueueKey(now.Add(3*time.Hour), granteeAddr, granterAddr, sendMethod)))
+
+	s.T().Log("verify grants are not deleted until they expire")
+	app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(now.Add(2 * time.Hour)))
+	s.Require().Len(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr), 2)
+
+	s.T().Log("verify expired grants are deleted")
+	pruneCtx := ctx.WithBlockTime(now.Add(2*time.Hour + time.Second)).WithEventManager(sdk.NewEventManager())
+	app.AuthzKeeper.DequeueAndDeleteExpiredGrants(pruneCtx)
+	authorizations := app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr)
+	s.Require().Len(authorizations, 1)
+	s.Require().Equal(sendMethod, authorizations[0].MethodName())
+	s.Require().False(store.Has(types.GetGrantQueueKey(now.Add(2*time.Hour), granteeAddr, granterAddr, genericMethod)))
+
+	events := pruneCtx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(types.EventRevokeAuthorization, events[0].Type)
+
+	s.T().Log("verify revoking deletes the grant from the grant queue")
+	s.Require().NoError(app.AuthzKeeper.Revoke(ctx, granteeAddr, granterAddr, sendMethod))
+	iter := sdk.KVStorePrefixIterator(store, types.GrantQueuePrefix)
+	defer iter.Close()
+	s.Require().False(iter.Valid())
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
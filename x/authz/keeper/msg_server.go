@@ -24,11 +24,11 @@ func (k Keeper) GrantAuthorization(goCtx context.Context, msg *types.MsgGrantAut
 
 	authorization := msg.GetGrantAuthorization()
 	// If the granted service Msg doesn't exist, we throw an error.
-	if k.router.Handler(authorization.MethodName()) == nil {
+	if !k.dispatcher.HasRoute(authorization.MethodName()) {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s doesn't exist.", authorization.MethodName())
 	}
 
-	err = k.Grant(ctx, grantee, granter, authorization, msg.Expiration)
+	err = k.Grant(ctx, grantee, granter, authorization, msg.Expiration, msg.MaxGas)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,40 @@
+package v042
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// MigrateStore performs in-place store migrations from version 1 to version 2
+// of the authz store. The migration includes:
+//
+// - Add the grants to the grant queue, indexing them by expiration time.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) error {
+	store := ctx.KVStore(storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GrantKey)
+	defer iter.Close()
+
+	var queueKeys, grantStoreKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		if err := cdc.UnmarshalBinaryBare(iter.Value(), &grant); err != nil {
+			return err
+		}
+
+		grantStoreKey := iter.Key()
+		granter, grantee := types.ExtractAddressesFromGrantKey(grantStoreKey)
+		msgType := types.ExtractMsgTypeFromGrantKey(grantStoreKey)
+		queueKeys = append(queueKeys, types.GetGrantQueueKey(grant.Expiration, grantee, granter, msgType))
+		grantStoreKeys = append(grantStoreKeys, grantStoreKey)
+	}
+
+	// The grant queue is written once the grants are iterated over, not to
+	// write to the store while iterating over it.
+	for i, queueKey := range queueKeys {
+		store.Set(queueKey, grantStoreKeys[i])
+	}
+
+	return nil
+}
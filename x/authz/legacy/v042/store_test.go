@@ -0,0 +1,40 @@
+package v042_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v042authz "github.com/cosmos/cosmos-sdk/x/authz/legacy/v042"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGrantQueueMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	authzKey := sdk.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(authzKey)
+
+	granter := sdk.AccAddress("_______granter______")
+	grantee := sdk.AccAddress("_______grantee______")
+	expiration := time.Now().UTC()
+	authorization := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 10)))
+
+	// Grants were stored without a grant queue.
+	grant, err := types.NewAuthorizationGrant(authorization, expiration, 0)
+	require.NoError(t, err)
+	grantStoreKey := types.GetAuthorizationStoreKey(grantee, granter, authorization.MethodName())
+	store.Set(grantStoreKey, encCfg.Marshaler.MustMarshalBinaryBare(&grant))
+
+	// Run migration.
+	err = v042authz.MigrateStore(ctx, authzKey, encCfg.Marshaler)
+	require.NoError(t, err)
+
+	// Grants are indexed by expiration time in the grant queue.
+	require.Equal(t, grantStoreKey, store.Get(types.GetGrantQueueKey(expiration, grantee, granter, authorization.MethodName())))
+}
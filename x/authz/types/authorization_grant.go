@@ -10,10 +10,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz/exported"
 )
 
-// NewAuthorizationGrant returns new AuthrizationGrant
-func NewAuthorizationGrant(authorization exported.Authorization, expiration time.Time) (AuthorizationGrant, error) {
+// NewAuthorizationGrant returns new AuthrizationGrant. A maxGas of 0 does not
+// limit the gas of the messages executed under the grant.
+func NewAuthorizationGrant(authorization exported.Authorization, expiration time.Time, maxGas uint64) (AuthorizationGrant, error) {
 	auth := AuthorizationGrant{
 		Expiration: expiration,
+		MaxGas:     maxGas,
 	}
 	msg, ok := authorization.(proto.Message)
 	if !ok {
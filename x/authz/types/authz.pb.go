@@ -83,6 +83,9 @@ func (m *GenericAuthorization) GetMessageName() string {
 type AuthorizationGrant struct {
 	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	Expiration    time.Time  `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// max_gas is the maximum gas the execution of a message under the grant may
+	// consume, or 0 for no limit.
+	MaxGas uint64 `protobuf:"varint,3,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
 }
 
 func (m *AuthorizationGrant) Reset()         { *m = AuthorizationGrant{} }
@@ -132,6 +135,13 @@ func (m *AuthorizationGrant) GetExpiration() time.Time {
 	return time.Time{}
 }
 
+func (m *AuthorizationGrant) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
 	proto.RegisterType((*AuthorizationGrant)(nil), "cosmos.authz.v1beta1.AuthorizationGrant")
@@ -140,28 +150,29 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4e, 0xfa, 0x40,
-	0x10, 0xc6, 0xbb, 0xff, 0xc3, 0x3f, 0xba, 0x84, 0x18, 0x9a, 0x1e, 0x84, 0x43, 0x4b, 0x38, 0x19,
-	0x13, 0x5a, 0xd1, 0x9b, 0x37, 0x1a, 0x12, 0x4e, 0x78, 0x20, 0x9e, 0xf4, 0x40, 0xb6, 0x30, 0xb6,
-	0x8d, 0x6e, 0xa7, 0xe9, 0x6e, 0x0d, 0xf0, 0x14, 0xbc, 0x86, 0x77, 0x1f, 0x82, 0x78, 0x22, 0x9e,
-	0x3c, 0xa1, 0x29, 0x2f, 0x62, 0xe8, 0x2e, 0x09, 0xe0, 0x69, 0x67, 0xbe, 0xfd, 0xbe, 0xdf, 0x4e,
-	0x76, 0x68, 0x73, 0x8c, 0x82, 0xa3, 0xf0, 0x58, 0x2e, 0xa3, 0xb9, 0xf7, 0xda, 0x09, 0x40, 0xb2,
-	0x8e, 0xea, 0xdc, 0x34, 0x43, 0x89, 0xa6, 0xa5, 0x1c, 0xae, 0xd2, 0xb4, 0xa3, 0x51, 0x57, 0xea,
-	0xa8, 0xf4, 0x78, 0xda, 0x52, 0x36, 0x0d, 0x27, 0x44, 0x0c, 0x5f, 0xc0, 0x2b, 0xbb, 0x20, 0x7f,
-	0xf2, 0x64, 0xcc, 0x41, 0x48, 0xc6, 0x53, 0x6d, 0xb0, 0x42, 0x0c, 0x51, 0x05, 0xb7, 0x95, 0x56,
-	0xeb, 0xc7, 0x31, 0x96, 0xcc, 0xd4, 0x55, 0xeb, 0x91, 0x5a, 0x7d, 0x48, 0x20, 0x8b, 0xc7, 0xdd,
-	0x5c, 0x46, 0x98, 0xc5, 0x73, 0x26, 0x63, 0x4c, 0xcc, 0x2b, 0x5a, 0xe1, 0x20, 0x23, 0x9c, 0x8c,
-	0x12, 0xc6, 0xe1, 0x9c, 0x34, 0xc9, 0xc5, 0xa9, 0x7f, 0x56, 0xac, 0x9d, 0xca, 0x00, 0x84, 0x60,
-	0x21, 0xdc, 0x31, 0x0e, 0x43, 0xaa, 0x3c, 0xdb, 0xfa, 0xb6, 0xf6, 0xf9, 0xde, 0xae, 0x1e, 0x40,
-	0x5a, 0x6f, 0x84, 0x9a, 0x07, 0x4a, 0x3f, 0x63, 0x89, 0x34, 0x07, 0xb4, 0xca, 0xf6, 0xd5, 0x92,
-	0x5e, 0xb9, 0xb6, 0x5c, 0x35, 0xa6, 0xbb, 0x1b, 0xd3, 0xed, 0x26, 0x33, 0xbf, 0xf6, 0x71, 0x8c,
-	0x1d, 0x1e, 0xa6, 0xcd, 0x1e, 0xa5, 0x30, 0x4d, 0xe3, 0x4c, 0xb1, 0xfe, 0x95, 0xac, 0xc6, 0x1f,
-	0xd6, 0xfd, 0xee, 0xa7, 0xfc, 0x93, 0xe5, 0xda, 0x31, 0x16, 0xdf, 0x0e, 0x19, 0xee, 0xe5, 0xfc,
-	0xde, 0xb2, 0xb0, 0xc9, 0xaa, 0xb0, 0xc9, 0x4f, 0x61, 0x93, 0xc5, 0xc6, 0x36, 0x56, 0x1b, 0xdb,
-	0xf8, 0xda, 0xd8, 0xc6, 0xc3, 0x65, 0x18, 0xcb, 0x28, 0x0f, 0xdc, 0x31, 0x72, 0xbd, 0x0d, 0x7d,
-	0xb4, 0xc5, 0xe4, 0xd9, 0x9b, 0xea, 0xfd, 0xca, 0x59, 0x0a, 0x22, 0xf8, 0x5f, 0xbe, 0x77, 0xf3,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x62, 0x49, 0x9d, 0xc0, 0xfc, 0x01, 0x00, 0x00,
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x18, 0x86, 0x7b, 0x6a, 0x50, 0x8f, 0x10, 0x43, 0xd3, 0x44, 0x60, 0x68, 0x09, 0x13, 0x31, 0xa1,
+	0x15, 0xdd, 0xdc, 0x68, 0x48, 0x98, 0x70, 0x68, 0x9c, 0x74, 0x20, 0x5f, 0xe1, 0x6c, 0x1b, 0xbd,
+	0x5e, 0xd3, 0xbb, 0x9a, 0xc2, 0xaf, 0xe0, 0xc7, 0xf8, 0x0b, 0x9c, 0x88, 0x13, 0x71, 0x72, 0x42,
+	0x53, 0xfe, 0x88, 0xa1, 0x57, 0x12, 0xc0, 0xa9, 0xdf, 0xf7, 0xf6, 0x7d, 0x9f, 0xbc, 0xb9, 0x0f,
+	0x37, 0xc7, 0x8c, 0x53, 0xc6, 0x2d, 0x48, 0x84, 0x3f, 0xb3, 0xde, 0xba, 0x2e, 0x11, 0xd0, 0x95,
+	0x9b, 0x19, 0xc5, 0x4c, 0x30, 0x55, 0x93, 0x0e, 0x53, 0x6a, 0x85, 0xa3, 0x51, 0x97, 0xea, 0x28,
+	0xf7, 0x58, 0x85, 0x25, 0x5f, 0x1a, 0x86, 0xc7, 0x98, 0xf7, 0x4a, 0xac, 0x7c, 0x73, 0x93, 0x67,
+	0x4b, 0x04, 0x94, 0x70, 0x01, 0x34, 0x2a, 0x0c, 0x9a, 0xc7, 0x3c, 0x26, 0x83, 0x9b, 0xa9, 0x50,
+	0xeb, 0x87, 0x31, 0x08, 0xa7, 0xf2, 0x57, 0xeb, 0x09, 0x6b, 0x03, 0x12, 0x92, 0x38, 0x18, 0xf7,
+	0x12, 0xe1, 0xb3, 0x38, 0x98, 0x81, 0x08, 0x58, 0xa8, 0x5e, 0xe3, 0x32, 0x25, 0xc2, 0x67, 0x93,
+	0x51, 0x08, 0x94, 0xd4, 0x50, 0x13, 0xb5, 0xcf, 0xed, 0x8b, 0x6c, 0x65, 0x94, 0x87, 0x84, 0x73,
+	0xf0, 0xc8, 0x3d, 0x50, 0xe2, 0x60, 0xe9, 0xd9, 0xcc, 0x77, 0xd5, 0xaf, 0xf7, 0x4e, 0x65, 0x0f,
+	0xd2, 0xfa, 0x40, 0x58, 0xdd, 0x53, 0x06, 0x31, 0x84, 0x42, 0x1d, 0xe2, 0x0a, 0xec, 0xaa, 0x39,
+	0xbd, 0x7c, 0xa3, 0x99, 0xb2, 0xa6, 0xb9, 0xad, 0x69, 0xf6, 0xc2, 0xa9, 0x5d, 0xfd, 0x3c, 0xc4,
+	0x3a, 0xfb, 0x69, 0xb5, 0x8f, 0x31, 0x49, 0xa3, 0x20, 0x96, 0xac, 0xa3, 0x9c, 0xd5, 0xf8, 0xc7,
+	0x7a, 0xd8, 0xbe, 0x94, 0x7d, 0xb6, 0x58, 0x19, 0xca, 0xfc, 0xc7, 0x40, 0xce, 0x4e, 0x4e, 0xbd,
+	0xc4, 0xa7, 0x14, 0xd2, 0x91, 0x07, 0xbc, 0x76, 0xdc, 0x44, 0xed, 0x13, 0xa7, 0x44, 0x21, 0x1d,
+	0x00, 0xb7, 0xfb, 0x8b, 0x4c, 0x47, 0xcb, 0x4c, 0x47, 0xbf, 0x99, 0x8e, 0xe6, 0x6b, 0x5d, 0x59,
+	0xae, 0x75, 0xe5, 0x7b, 0xad, 0x2b, 0x8f, 0x57, 0x5e, 0x20, 0xfc, 0xc4, 0x35, 0xc7, 0x8c, 0x16,
+	0x67, 0x2a, 0x3e, 0x1d, 0x3e, 0x79, 0xb1, 0xd2, 0xe2, 0xf0, 0x62, 0x1a, 0x11, 0xee, 0x96, 0xf2,
+	0x22, 0xb7, 0x7f, 0x03, 0x00, 0x23, 0xe7, 0xd5, 0x02, 0x15, 0x02, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -214,6 +225,11 @@ func (m *AuthorizationGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x18
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
 	if err1 != nil {
 		return 0, err1
@@ -273,6 +289,9 @@ func (m *AuthorizationGrant) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
 	n += 1 + l + sovAuthz(uint64(l))
+	if m.MaxGas != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxGas))
+	}
 	return n
 }
 
@@ -462,6 +481,25 @@ func (m *AuthorizationGrant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
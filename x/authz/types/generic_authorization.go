@@ -23,7 +23,8 @@ func (cap GenericAuthorization) MethodName() string {
 	return cap.MessageName
 }
 
-// Accept implements Authorization.Accept.
+// Accept implements Authorization.Accept. It accepts any message of the method
+// and is never updated, so the grant is not written back to the store.
 func (cap GenericAuthorization) Accept(msg sdk.ServiceMsg, block tmproto.Header) (updated exported.Authorization, delete bool, err error) {
-	return &cap, false, nil
+	return nil, false, nil
 }
@@ -80,6 +80,7 @@ type GrantAuthorization struct {
 	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	Expiration    time.Time  `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	MaxGas        uint64     `protobuf:"varint,5,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
 }
 
 func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
@@ -143,6 +144,13 @@ func (m *GrantAuthorization) GetExpiration() time.Time {
 	return time.Time{}
 }
 
+func (m *GrantAuthorization) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.authz.v1beta1.GenesisState")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
@@ -153,29 +161,30 @@ func init() {
 }
 
 var fileDescriptor_4c2fbb971da7c892 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xbb, 0x6e, 0xc2, 0x30,
-	0x14, 0x8d, 0x0b, 0xea, 0xc3, 0x94, 0xa1, 0x11, 0x43, 0xca, 0x10, 0x10, 0x53, 0x54, 0x09, 0x5b,
-	0xd0, 0x2f, 0x20, 0x42, 0x62, 0xea, 0x42, 0x99, 0xba, 0x54, 0x0e, 0xb8, 0x26, 0x6a, 0x13, 0x47,
-	0xb1, 0xa9, 0x80, 0xaf, 0xe0, 0x63, 0xfa, 0x11, 0xa8, 0x13, 0x63, 0x97, 0x3e, 0x04, 0x3f, 0x52,
-	0xc5, 0x76, 0x54, 0x08, 0x9d, 0x72, 0x6f, 0xce, 0xb9, 0xe7, 0x1c, 0x5f, 0x1b, 0xb6, 0xc6, 0x5c,
-	0x44, 0x5c, 0x60, 0x32, 0x93, 0xd3, 0x25, 0x7e, 0xed, 0x04, 0x54, 0x92, 0x0e, 0x66, 0x34, 0xa6,
-	0x22, 0x14, 0x28, 0x49, 0xb9, 0xe4, 0x76, 0x4d, 0x73, 0x90, 0xe2, 0x20, 0xc3, 0xa9, 0x37, 0x18,
-	0xe7, 0xec, 0x85, 0x62, 0xc5, 0x09, 0x66, 0x4f, 0x58, 0x86, 0x11, 0x15, 0x92, 0x44, 0x89, 0x1e,
-	0xab, 0x5f, 0x17, 0x09, 0x24, 0x5e, 0x18, 0xa8, 0xc6, 0x38, 0xe3, 0xaa, 0xc4, 0x59, 0x95, 0x0f,
-	0x68, 0x9f, 0x47, 0x0d, 0x18, 0x53, 0xd5, 0xb4, 0x26, 0xf0, 0x72, 0xa0, 0x33, 0xdd, 0x4b, 0x22,
-	0xa9, 0x3d, 0x82, 0xd5, 0x2c, 0x0d, 0x4f, 0xc3, 0x25, 0x91, 0x21, 0x8f, 0x1d, 0xd0, 0x2c, 0x79,
-	0x95, 0xae, 0x87, 0xfe, 0x8b, 0x8a, 0x06, 0x29, 0x89, 0x65, 0x6f, 0x9f, 0xef, 0x97, 0xd7, 0x5f,
-	0x0d, 0x6b, 0x78, 0x28, 0xd2, 0xfa, 0x04, 0xd0, 0x3e, 0xe6, 0xda, 0x0e, 0x3c, 0x63, 0xd9, 0x5f,
-	0x9a, 0x3a, 0xa0, 0x09, 0xbc, 0x8b, 0x61, 0xde, 0xfe, 0x21, 0xd4, 0x39, 0xd9, 0x47, 0xa8, 0x7d,
-	0x57, 0x0c, 0x58, 0x6a, 0x02, 0xaf, 0xd2, 0xad, 0x21, 0xbd, 0x14, 0x94, 0x2f, 0x05, 0xf5, 0xe2,
-	0x85, 0x7f, 0xf5, 0xfe, 0xd6, 0xae, 0x1e, 0x78, 0x16, 0x92, 0xd9, 0x7d, 0x08, 0xe9, 0x3c, 0x09,
-	0x53, 0xad, 0x55, 0x56, 0x5a, 0xf5, 0x23, 0xad, 0x51, 0x7e, 0x03, 0xfe, 0x79, 0x76, 0xbc, 0xd5,
-	0x77, 0x03, 0x0c, 0xf7, 0xe6, 0xfc, 0xfe, 0x7a, 0xeb, 0x82, 0xcd, 0xd6, 0x05, 0x3f, 0x5b, 0x17,
-	0xac, 0x76, 0xae, 0xb5, 0xd9, 0xb9, 0xd6, 0xc7, 0xce, 0xb5, 0x1e, 0x6e, 0x58, 0x28, 0xa7, 0xb3,
-	0x00, 0x8d, 0x79, 0x64, 0x16, 0x6f, 0x3e, 0x6d, 0x31, 0x79, 0xc6, 0x73, 0xf3, 0x3c, 0xe4, 0x22,
-	0xa1, 0x22, 0x38, 0x55, 0x7e, 0xb7, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94, 0xbe, 0x5b, 0xfa,
-	0x3b, 0x02, 0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xbd, 0x6e, 0xf2, 0x30,
+	0x14, 0x8d, 0x3f, 0xf8, 0xa0, 0x35, 0x65, 0x68, 0x84, 0xd4, 0x94, 0x21, 0x44, 0x4c, 0x51, 0x25,
+	0x6c, 0x41, 0x9f, 0x80, 0x08, 0x89, 0xa9, 0x4b, 0xca, 0xd4, 0x05, 0x39, 0xe0, 0x9a, 0xa8, 0x4d,
+	0x1c, 0xc5, 0xa6, 0x0a, 0x3c, 0x05, 0x0f, 0xd3, 0x87, 0x40, 0x9d, 0x18, 0x3b, 0xb5, 0x15, 0xbc,
+	0x43, 0xe7, 0x2a, 0x71, 0xa2, 0xf2, 0xd3, 0xc9, 0xf7, 0xfa, 0x9c, 0x7b, 0xee, 0xf1, 0xbd, 0x86,
+	0xed, 0x09, 0x17, 0x01, 0x17, 0x98, 0xcc, 0xe5, 0x6c, 0x89, 0x5f, 0xba, 0x1e, 0x95, 0xa4, 0x8b,
+	0x19, 0x0d, 0xa9, 0xf0, 0x05, 0x8a, 0x62, 0x2e, 0xb9, 0xde, 0x50, 0x1c, 0x94, 0x71, 0x50, 0xce,
+	0x69, 0xb6, 0x18, 0xe7, 0xec, 0x99, 0xe2, 0x8c, 0xe3, 0xcd, 0x1f, 0xb1, 0xf4, 0x03, 0x2a, 0x24,
+	0x09, 0x22, 0x55, 0xd6, 0xbc, 0x3e, 0x26, 0x90, 0x70, 0x91, 0x43, 0x0d, 0xc6, 0x19, 0xcf, 0x42,
+	0x9c, 0x46, 0x45, 0x81, 0xea, 0x33, 0x56, 0x40, 0xde, 0x34, 0x4b, 0xda, 0x53, 0x78, 0x31, 0x54,
+	0x9e, 0xee, 0x25, 0x91, 0x54, 0x1f, 0xc1, 0x7a, 0xea, 0x86, 0xc7, 0xfe, 0x92, 0x48, 0x9f, 0x87,
+	0x06, 0xb0, 0x4a, 0x76, 0xad, 0x67, 0xa3, 0xbf, 0xac, 0xa2, 0x61, 0x4c, 0x42, 0xd9, 0xdf, 0xe7,
+	0x3b, 0xe5, 0xf5, 0x47, 0x4b, 0x73, 0x0f, 0x45, 0xda, 0xdf, 0x00, 0xea, 0xa7, 0x5c, 0xdd, 0x80,
+	0x55, 0x96, 0xde, 0xd2, 0xd8, 0x00, 0x16, 0xb0, 0xcf, 0xdd, 0x22, 0xfd, 0x45, 0xa8, 0xf1, 0x6f,
+	0x1f, 0xa1, 0xfa, 0xdd, 0xb1, 0xc1, 0x92, 0x05, 0xec, 0x5a, 0xaf, 0x81, 0xd4, 0x50, 0x50, 0x31,
+	0x14, 0xd4, 0x0f, 0x17, 0xce, 0xe5, 0xdb, 0x6b, 0xa7, 0x7e, 0xd0, 0xf3, 0xc8, 0x99, 0x3e, 0x80,
+	0x90, 0x26, 0x91, 0x1f, 0x2b, 0xad, 0x72, 0xa6, 0xd5, 0x3c, 0xd1, 0x1a, 0x15, 0x1b, 0x70, 0xce,
+	0xd2, 0xe7, 0xad, 0x3e, 0x5b, 0xc0, 0xdd, 0xab, 0xd3, 0xaf, 0x60, 0x35, 0x20, 0xc9, 0x98, 0x11,
+	0x61, 0xfc, 0xb7, 0x80, 0x5d, 0x76, 0x2b, 0x01, 0x49, 0x86, 0x44, 0x38, 0x83, 0xf5, 0xd6, 0x04,
+	0x9b, 0xad, 0x09, 0xbe, 0xb6, 0x26, 0x58, 0xed, 0x4c, 0x6d, 0xb3, 0x33, 0xb5, 0xf7, 0x9d, 0xa9,
+	0x3d, 0xdc, 0x30, 0x5f, 0xce, 0xe6, 0x1e, 0x9a, 0xf0, 0x20, 0xdf, 0x48, 0x7e, 0x74, 0xc4, 0xf4,
+	0x09, 0x27, 0xf9, 0xbf, 0x91, 0x8b, 0x88, 0x0a, 0xaf, 0x92, 0x19, 0xb9, 0xfd, 0x19, 0x00, 0x52,
+	0x62, 0x1e, 0xb5, 0x54, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +244,11 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x28
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
 	if err1 != nil {
 		return 0, err1
@@ -318,6 +332,9 @@ func (m *GrantAuthorization) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxGas))
+	}
 	return n
 }
 
@@ -573,6 +590,25 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -23,10 +27,13 @@ const (
 // Items are stored with the following key: values
 //
 // - 0x01<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: Grant
+//
+// - 0x02<expiration_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: grant store key
 
 var (
 	// Keys for store prefixes
-	GrantKey = []byte{0x01} // prefix for each key
+	GrantKey         = []byte{0x01} // prefix for each key
+	GrantQueuePrefix = []byte{0x02} // prefix for the grants by expiration time
 )
 
 // GetAuthorizationStoreKey - return authorization store key
@@ -39,6 +46,19 @@ func GetAuthorizationStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, ms
 	)
 }
 
+// GrantQueueTimePrefix returns the prefix of the grant queue keys of the grants
+// expiring at the given time.
+func GrantQueueTimePrefix(expiration time.Time) []byte {
+	return append(GrantQueuePrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetGrantQueueKey returns the grant queue key of the grant of the provided msg
+// type expiring at the given time. Its value is the store key of the grant.
+func GetGrantQueueKey(expiration time.Time, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	grantStoreKey := GetAuthorizationStoreKey(grantee, granter, msgType)
+	return append(GrantQueueTimePrefix(expiration), grantStoreKey[len(GrantKey):]...)
+}
+
 // ExtractAddressesFromGrantKey - split granter & grantee address from the authorization key
 func ExtractAddressesFromGrantKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress) {
 	// key if of format:
@@ -50,3 +70,27 @@ func ExtractAddressesFromGrantKey(key []byte) (granterAddr, granteeAddr sdk.AccA
 
 	return granterAddr, granteeAddr
 }
+
+// ExtractMsgTypeFromGrantKey - split the msg type from the authorization key
+func ExtractMsgTypeFromGrantKey(key []byte) string {
+	granterAddr, granteeAddr := ExtractAddressesFromGrantKey(key)
+	return string(key[3+len(granterAddr)+len(granteeAddr):])
+}
+
+// DecodeStoreKey decodes the key of an entry of the authz store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	switch {
+	case bytes.HasPrefix(key, GrantKey):
+		return sdk.NewStoreKeyParser("Grants", key[1:], &AuthorizationGrant{}).
+			AccAddress("granter").AccAddress("grantee").String("msg_type").
+			Decode()
+
+	case bytes.HasPrefix(key, GrantQueuePrefix):
+		return sdk.NewStoreKeyParser("GrantQueue", key[1:], nil).
+			Time("expiration").AccAddress("granter").AccAddress("grantee").String("msg_type").
+			Decode()
+
+	default:
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -18,4 +20,13 @@ func TestGrantkey(t *testing.T) {
 	granter1, grantee1 := ExtractAddressesFromGrantKey(GetAuthorizationStoreKey(grantee, granter, msgType))
 	require.Equal(t, granter, granter1)
 	require.Equal(t, grantee, grantee1)
+	require.Equal(t, msgType, ExtractMsgTypeFromGrantKey(GetAuthorizationStoreKey(grantee, granter, msgType)))
+}
+
+func TestGrantQueueKey(t *testing.T) {
+	now := time.Now().UTC()
+	key := GetGrantQueueKey(now, grantee, granter, msgType)
+	require.True(t, bytes.HasPrefix(key, GrantQueueTimePrefix(now)))
+	require.Less(t, bytes.Compare(key, GrantQueueTimePrefix(now.Add(time.Nanosecond))), 0)
+	require.Greater(t, bytes.Compare(key, GetGrantQueueKey(now.Add(-time.Nanosecond), grantee, granter, msgType)), 0)
 }
@@ -20,13 +20,15 @@ var (
 	_ types.UnpackInterfacesMessage = &MsgExecAuthorizedRequest{}
 )
 
-// NewMsgGrantAuthorization creates a new MsgGrantAuthorization
+// NewMsgGrantAuthorization creates a new MsgGrantAuthorization. A maxGas of 0
+// does not limit the gas of the messages executed under the grant.
 //nolint:interfacer
-func NewMsgGrantAuthorization(granter sdk.AccAddress, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time) (*MsgGrantAuthorizationRequest, error) {
+func NewMsgGrantAuthorization(granter sdk.AccAddress, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time, maxGas uint64) (*MsgGrantAuthorizationRequest, error) {
 	m := &MsgGrantAuthorizationRequest{
 		Granter:    granter.String(),
 		Grantee:    grantee.String(),
 		Expiration: expiration,
+		MaxGas:     maxGas,
 	}
 	err := m.SetAuthorization(authorization)
 	if err != nil {
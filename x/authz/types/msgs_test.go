@@ -88,7 +88,7 @@ func TestMsgGrantAuthorization(t *testing.T) {
 	}
 	for i, tc := range tests {
 		msg, err := types.NewMsgGrantAuthorization(
-			tc.granter, tc.grantee, tc.authorization, tc.expiration,
+			tc.granter, tc.grantee, tc.authorization, tc.expiration, 0,
 		)
 		if !tc.expectErr {
 			require.NoError(t, err)
@@ -42,6 +42,9 @@ type MsgGrantAuthorizationRequest struct {
 	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	Expiration    time.Time  `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// max_gas is the maximum gas the execution of a message under the grant may
+	// consume, or 0 for no limit.
+	MaxGas uint64 `protobuf:"varint,5,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
 }
 
 func (m *MsgGrantAuthorizationRequest) Reset()         { *m = MsgGrantAuthorizationRequest{} }
@@ -105,6 +108,13 @@ func (m *MsgGrantAuthorizationRequest) GetExpiration() time.Time {
 	return time.Time{}
 }
 
+func (m *MsgGrantAuthorizationRequest) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
 // MsgExecAuthorizedResponse defines the Msg/MsgExecAuthorizedResponse response type.
 type MsgExecAuthorizedResponse struct {
 	Result *types1.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
@@ -353,40 +363,41 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
+	// 537 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x93, 0xaa, 0xd0, 0x8d, 0x8a, 0xc4, 0x92, 0x83, 0x63, 0x51, 0xdb, 0x32, 0x97, 0x08,
-	0xa9, 0x6b, 0x35, 0xe5, 0xc0, 0xb5, 0x51, 0x11, 0xa7, 0x70, 0xb0, 0xe0, 0xc2, 0x81, 0x6a, 0x9d,
-	0x0c, 0x1b, 0xab, 0xb5, 0xd7, 0xf5, 0xae, 0xab, 0xa4, 0x12, 0x12, 0x9f, 0xd0, 0x8f, 0xe1, 0x23,
-	0x2a, 0x4e, 0x3d, 0x72, 0x02, 0x94, 0x1c, 0xf8, 0x07, 0x4e, 0x28, 0xbb, 0x1b, 0x48, 0xda, 0x18,
-	0x11, 0x89, 0x93, 0x3d, 0x33, 0x6f, 0x66, 0x9e, 0xdf, 0x5b, 0x2f, 0xda, 0x1b, 0x70, 0x91, 0x72,
-	0x11, 0xd2, 0x52, 0x8e, 0x2e, 0xc3, 0x8b, 0x83, 0x18, 0x24, 0x3d, 0x08, 0xe5, 0x98, 0xe4, 0x05,
-	0x97, 0x1c, 0xb7, 0x74, 0x99, 0xa8, 0x32, 0x31, 0x65, 0xa7, 0xad, 0xb3, 0x27, 0x0a, 0x13, 0x1a,
-	0x88, 0x0a, 0x9c, 0x16, 0xe3, 0x8c, 0xeb, 0xfc, 0xfc, 0xcd, 0x64, 0x3d, 0xc6, 0x39, 0x3b, 0x83,
-	0x50, 0x45, 0x71, 0xf9, 0x3e, 0x94, 0x49, 0x0a, 0x42, 0xd2, 0x34, 0x37, 0x80, 0xf6, 0x6d, 0x00,
-	0xcd, 0x26, 0xa6, 0xf4, 0xc4, 0x30, 0x8c, 0xa9, 0x80, 0x90, 0xc6, 0x83, 0xe4, 0x37, 0xcb, 0x79,
-	0xa0, 0x41, 0xc1, 0x0f, 0x0b, 0x3d, 0xee, 0x0b, 0xf6, 0xb2, 0xa0, 0x99, 0x3c, 0x2a, 0xe5, 0x88,
-	0x17, 0xc9, 0x25, 0x95, 0x09, 0xcf, 0x22, 0x38, 0x2f, 0x41, 0x48, 0x6c, 0xa3, 0x7b, 0x6c, 0x5e,
-	0x84, 0xc2, 0xb6, 0x7c, 0xab, 0xb3, 0x13, 0x2d, 0xc2, 0x3f, 0x15, 0xb0, 0xeb, 0xcb, 0x15, 0xc0,
-	0x7d, 0xb4, 0x4b, 0x97, 0x67, 0xd9, 0x0d, 0xdf, 0xea, 0x34, 0xbb, 0x2d, 0xa2, 0xc9, 0x92, 0x05,
-	0x59, 0x72, 0x94, 0x4d, 0x7a, 0x0f, 0x3f, 0x7f, 0xda, 0xdf, 0x5d, 0x5d, 0xbd, 0xda, 0x8d, 0x8f,
-	0x11, 0x82, 0x71, 0x9e, 0x14, 0x7a, 0xd6, 0x96, 0x9a, 0xe5, 0xdc, 0x99, 0xf5, 0x7a, 0xa1, 0x4c,
-	0xef, 0xfe, 0xf5, 0x57, 0xaf, 0x76, 0xf5, 0xcd, 0xb3, 0xa2, 0xa5, 0xbe, 0xe0, 0x0d, 0x6a, 0xf7,
-	0x05, 0x7b, 0x31, 0x86, 0xc1, 0x62, 0x19, 0x0c, 0x23, 0x10, 0x39, 0xcf, 0x04, 0xe0, 0xe7, 0x68,
-	0xbb, 0x00, 0x51, 0x9e, 0x49, 0xf5, 0x91, 0xcd, 0xae, 0x4f, 0x8c, 0x39, 0x73, 0xf1, 0x88, 0xd2,
-	0xcb, 0x88, 0x47, 0x22, 0x85, 0x8b, 0x0c, 0x3e, 0x78, 0x87, 0xec, 0x35, 0x63, 0x6f, 0x69, 0x07,
-	0xab, 0xda, 0x01, 0xee, 0xa0, 0xad, 0x54, 0x30, 0x61, 0xd7, 0xfd, 0x46, 0x95, 0x30, 0x91, 0x42,
-	0x04, 0x1e, 0xda, 0xab, 0xf0, 0x47, 0x53, 0x0f, 0xa4, 0x02, 0x44, 0x70, 0xc1, 0x4f, 0xe1, 0xbf,
-	0x39, 0xe8, 0xa1, 0x66, 0x0a, 0x72, 0xc4, 0x87, 0x27, 0x19, 0x4d, 0x41, 0xf9, 0xb7, 0x13, 0x21,
-	0x9d, 0x7a, 0x45, 0x53, 0x08, 0x7c, 0xe4, 0x56, 0x6d, 0xd5, 0xbc, 0xba, 0x3f, 0xeb, 0xa8, 0xd1,
-	0x17, 0x0c, 0x7f, 0x40, 0xf8, 0x2e, 0x7b, 0xdc, 0x25, 0xeb, 0x7e, 0x10, 0xf2, 0xb7, 0xa3, 0xe8,
-	0x1c, 0x6e, 0xd4, 0x63, 0x9c, 0x3d, 0x47, 0x0f, 0x56, 0xcd, 0xc1, 0xa4, 0x72, 0xcc, 0x5a, 0x17,
-	0x9d, 0xf0, 0x9f, 0xf1, 0x66, 0xe5, 0x47, 0x0b, 0x3d, 0x5a, 0xa3, 0x0c, 0xae, 0xe6, 0x5f, 0xed,
-	0x9e, 0xf3, 0x6c, 0xb3, 0x26, 0x4d, 0xa1, 0x77, 0x7c, 0x3d, 0x75, 0xad, 0x9b, 0xa9, 0x6b, 0x7d,
-	0x9f, 0xba, 0xd6, 0xd5, 0xcc, 0xad, 0xdd, 0xcc, 0xdc, 0xda, 0x97, 0x99, 0x5b, 0x7b, 0xfb, 0x94,
-	0x25, 0x72, 0x54, 0xc6, 0x64, 0xc0, 0x53, 0x73, 0x01, 0x99, 0xc7, 0xbe, 0x18, 0x9e, 0x86, 0x63,
-	0x73, 0x9f, 0xc9, 0x49, 0x0e, 0x22, 0xde, 0x56, 0xe7, 0xf1, 0xf0, 0x57, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xff, 0x9d, 0x19, 0xe1, 0xec, 0x04, 0x00, 0x00,
+	0x10, 0x8d, 0x93, 0x90, 0xd2, 0x8d, 0x8a, 0xc4, 0x12, 0x09, 0xc7, 0xa2, 0x8e, 0x65, 0x2e, 0x11,
+	0x52, 0xd7, 0x6a, 0xca, 0x81, 0x6b, 0xa3, 0xa2, 0x9e, 0xc2, 0xc1, 0x82, 0x0b, 0x07, 0xa2, 0x75,
+	0x32, 0x6c, 0xac, 0xd6, 0x5e, 0xd7, 0xbb, 0xae, 0x9c, 0x4a, 0x48, 0xfd, 0x84, 0x7e, 0x0c, 0x1f,
+	0x51, 0x71, 0xea, 0x91, 0x13, 0xa0, 0xe4, 0x2f, 0x38, 0xa1, 0xac, 0x37, 0x90, 0xb4, 0x31, 0xa2,
+	0x12, 0xa7, 0x64, 0xe6, 0xbd, 0x99, 0x7d, 0x79, 0x6f, 0xb3, 0x68, 0x77, 0xc4, 0x45, 0xc4, 0x85,
+	0x47, 0x33, 0x39, 0xb9, 0xf0, 0xce, 0xf7, 0x03, 0x90, 0x74, 0xdf, 0x93, 0x39, 0x49, 0x52, 0x2e,
+	0x39, 0x6e, 0x15, 0x30, 0x51, 0x30, 0xd1, 0xb0, 0xd5, 0x2e, 0xba, 0x43, 0xc5, 0xf1, 0x34, 0x45,
+	0x15, 0x56, 0x8b, 0x71, 0xc6, 0x8b, 0xfe, 0xe2, 0x9b, 0xee, 0x76, 0x18, 0xe7, 0xec, 0x14, 0x3c,
+	0x55, 0x05, 0xd9, 0x47, 0x4f, 0x86, 0x11, 0x08, 0x49, 0xa3, 0x44, 0x13, 0xda, 0xb7, 0x09, 0x34,
+	0x9e, 0x6a, 0xe8, 0xb9, 0x56, 0x18, 0x50, 0x01, 0x1e, 0x0d, 0x46, 0xe1, 0x6f, 0x95, 0x8b, 0xa2,
+	0x20, 0xb9, 0x97, 0x55, 0xf4, 0x6c, 0x20, 0xd8, 0x71, 0x4a, 0x63, 0x79, 0x98, 0xc9, 0x09, 0x4f,
+	0xc3, 0x0b, 0x2a, 0x43, 0x1e, 0xfb, 0x70, 0x96, 0x81, 0x90, 0xd8, 0x44, 0x5b, 0x6c, 0x01, 0x42,
+	0x6a, 0x1a, 0x8e, 0xd1, 0xdd, 0xf6, 0x97, 0xe5, 0x1f, 0x04, 0xcc, 0xea, 0x2a, 0x02, 0x78, 0x80,
+	0x76, 0xe8, 0xea, 0x2e, 0xb3, 0xe6, 0x18, 0xdd, 0x66, 0xaf, 0x45, 0x0a, 0xb1, 0x64, 0x29, 0x96,
+	0x1c, 0xc6, 0xd3, 0xfe, 0xe3, 0x2f, 0x9f, 0xf7, 0x76, 0xd6, 0x8f, 0x5e, 0x9f, 0xc6, 0x47, 0x08,
+	0x41, 0x9e, 0x84, 0x69, 0xb1, 0xab, 0xae, 0x76, 0x59, 0x77, 0x76, 0xbd, 0x5d, 0x3a, 0xd3, 0x7f,
+	0x78, 0xfd, 0xad, 0x53, 0xb9, 0xfa, 0xde, 0x31, 0xfc, 0x95, 0x39, 0xfc, 0x14, 0x6d, 0x45, 0x34,
+	0x1f, 0x32, 0x2a, 0xcc, 0x07, 0x8e, 0xd1, 0xad, 0xfb, 0x8d, 0x88, 0xe6, 0xc7, 0x54, 0xb8, 0xef,
+	0x50, 0x7b, 0x20, 0xd8, 0xeb, 0x1c, 0x46, 0x4b, 0x15, 0x30, 0xf6, 0x41, 0x24, 0x3c, 0x16, 0x80,
+	0x5f, 0xa1, 0x46, 0x0a, 0x22, 0x3b, 0x95, 0xea, 0xd7, 0x37, 0x7b, 0x0e, 0xd1, 0xa9, 0x2d, 0x5c,
+	0x25, 0xca, 0x48, 0xed, 0x2a, 0xf1, 0x15, 0xcf, 0xd7, 0x7c, 0xf7, 0x03, 0x32, 0x37, 0xac, 0xbd,
+	0x65, 0x2a, 0xac, 0x9b, 0x0a, 0xb8, 0x8b, 0xea, 0x91, 0x60, 0xc2, 0xac, 0x3a, 0xb5, 0x32, 0xc7,
+	0x7c, 0xc5, 0x70, 0x3b, 0x68, 0xb7, 0x24, 0xb8, 0x42, 0xba, 0x2b, 0x15, 0xc1, 0x87, 0x73, 0x7e,
+	0x02, 0xff, 0x2d, 0xda, 0x0e, 0x6a, 0x46, 0x20, 0x27, 0x7c, 0x3c, 0x8c, 0x69, 0x04, 0x2a, 0xd8,
+	0x6d, 0x1f, 0x15, 0xad, 0x37, 0x34, 0x02, 0xd7, 0x41, 0x76, 0xd9, 0xa9, 0x85, 0xae, 0xde, 0xcf,
+	0x2a, 0xaa, 0x0d, 0x04, 0xc3, 0x9f, 0x10, 0xbe, 0xab, 0x1e, 0xf7, 0xc8, 0xa6, 0x7f, 0x0e, 0xf9,
+	0xdb, 0x1d, 0xb5, 0x0e, 0xee, 0x35, 0xa3, 0x93, 0x3d, 0x43, 0x8f, 0xd6, 0xc3, 0xc1, 0xa4, 0x74,
+	0xcd, 0xc6, 0x14, 0x2d, 0xef, 0x9f, 0xf9, 0xfa, 0xc8, 0x4b, 0x03, 0x3d, 0xd9, 0xe0, 0x0c, 0x2e,
+	0xd7, 0x5f, 0x9e, 0x9e, 0xf5, 0xf2, 0x7e, 0x43, 0x85, 0x84, 0xfe, 0xd1, 0xf5, 0xcc, 0x36, 0x6e,
+	0x66, 0xb6, 0xf1, 0x63, 0x66, 0x1b, 0x57, 0x73, 0xbb, 0x72, 0x33, 0xb7, 0x2b, 0x5f, 0xe7, 0x76,
+	0xe5, 0xfd, 0x0b, 0x16, 0xca, 0x49, 0x16, 0x90, 0x11, 0x8f, 0xf4, 0xcb, 0xa4, 0x3f, 0xf6, 0xc4,
+	0xf8, 0xc4, 0xcb, 0xf5, 0x43, 0x27, 0xa7, 0x09, 0x88, 0xa0, 0xa1, 0xee, 0xe3, 0xc1, 0xaf, 0x01,
+	0x00, 0x8d, 0xcf, 0x84, 0x88, 0x05, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -575,6 +586,11 @@ func (m *MsgGrantAuthorizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x28
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
 	if err1 != nil {
 		return 0, err1
@@ -812,6 +828,9 @@ func (m *MsgGrantAuthorizationRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
 	n += 1 + l + sovTx(uint64(l))
+	if m.MaxGas != 0 {
+		n += 1 + sovTx(uint64(m.MaxGas))
+	}
 	return n
 }
 
@@ -1054,6 +1073,25 @@ func (m *MsgGrantAuthorizationRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
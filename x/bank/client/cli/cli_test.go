@@ -230,6 +230,20 @@ func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdReconcileSupply() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdReconcileSupply(), []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var res types.QueryReconcileSupplyResponse
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Empty(res.Mismatches)
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	val := s.network.Validators[0]
 
@@ -388,6 +402,75 @@ func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	}}, tx.GetMsgs())
 }
 
+func (s *IntegrationTestSuite) TestNewSendTxCmdDenomConversion() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		amount   string
+		args     []string
+		expected sdk.Coins
+		expErr   bool
+	}{
+		{
+			"display denomination",
+			"1.5atom,10stake",
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uatom", 1500000)),
+			false,
+		},
+		{
+			"alias",
+			"1ATOM,500microatom",
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000500)),
+			false,
+		},
+		{
+			"fractional base amount",
+			"0.0000001atom",
+			nil,
+			nil,
+			true,
+		},
+		{
+			"offline",
+			"2atom",
+			[]string{fmt.Sprintf("--%s=true", flags.FlagOffline)},
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 2)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+			args := append([]string{
+				val.Address.String(),
+				val.Address.String(),
+				tc.amount,
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			}, tc.args...)
+
+			bz, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewSendTxCmd(), args)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			tx, err := s.cfg.TxConfig.TxJSONDecoder()(bz.Bytes())
+			s.Require().NoError(err)
+			s.Require().Equal([]sdk.Msg{sdk.ServiceMsg{
+				MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+				Request:    types.NewMsgSend(val.Address, val.Address, tc.expected),
+			}}, tx.GetMsgs())
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmd() {
 	val := s.network.Validators[0]
 
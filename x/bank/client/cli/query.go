@@ -33,6 +33,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdReconcileSupply(),
 	)
 
 	return cmd
@@ -206,3 +207,47 @@ To query for the total supply of a specific coin denomination use:
 
 	return cmd
 }
+
+// GetCmdReconcileSupply returns a command reconciling the total supply with the
+// balances of all the accounts.
+func GetCmdReconcileSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile-supply",
+		Short: "Reconcile the total supply with the balances of all accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Compare, per denomination, the tracked total supply of the chain with the sum of the
+balances of all accounts, module accounts included, and report the denominations whose supply and
+balances differ, along with the balances escrowed by module accounts.
+
+The balances are summed by the queried node, which iterates over all of them and may take a while
+on chains with many accounts.
+
+Example:
+  $ %s query %s reconcile-supply
+  $ %s query %s reconcile-supply --height=[height]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReconcileSupply(cmd.Context(), &types.QueryReconcileSupplyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
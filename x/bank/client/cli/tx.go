@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -8,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -32,6 +36,13 @@ func NewSendTxCmd() *cobra.Command {
 		Use: "send [from_key_or_address] [to_address] [amount]",
 		Short: `Send funds from one account to another. Note, the'--from' flag is
 ignored as it is implied from [from_key_or_address].`,
+		Long: `Send funds from one account to another. Note, the'--from' flag is
+ignored as it is implied from [from_key_or_address].
+
+The amount may be given in any denomination unit of the denom metadata
+registered in the bank module, e.g. 1.5atom, and is converted to the base
+denomination of the asset, e.g. 1500000uatom. The conversion is skipped in
+offline mode.`,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.Flags().Set(flags.FlagFrom, args[0])
@@ -44,7 +55,7 @@ ignored as it is implied from [from_key_or_address].`,
 				return err
 			}
 
-			coins, err := sdk.ParseCoinsNormalized(args[2])
+			coins, err := parseCoins(cmd.Context(), clientCtx, args[2])
 			if err != nil {
 				return err
 			}
@@ -65,3 +76,38 @@ ignored as it is implied from [from_key_or_address].`,
 
 	return cmd
 }
+
+// parseCoins parses the given coins, converting the amounts given in a
+// denomination unit of the bank denom metadata to the base denomination of
+// their asset. Denom metadata cannot be queried offline, in which case the
+// coins are parsed as is.
+func parseCoins(ctx context.Context, clientCtx client.Context, coinsStr string) (sdk.Coins, error) {
+	if clientCtx.Offline {
+		return sdk.ParseCoinsNormalized(coinsStr)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryDenomsMetadataRequest{Pagination: &query.PageRequest{}}
+
+	var metadatas []types.Metadata
+	for {
+		res, err := queryClient.DenomsMetadata(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query denom metadata, use --%s to skip the conversion of the amounts: %w", flags.FlagOffline, err)
+		}
+
+		metadatas = append(metadatas, res.Metadatas...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+
+	converter, err := types.NewDenomConverter(metadatas)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ParseCoinsNormalized(coinsStr)
+}
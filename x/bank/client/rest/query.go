@@ -1,13 +1,20 @@
 package rest
 
 import (
-	"fmt"
+	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -15,104 +22,98 @@ import (
 // QueryBalancesRequestHandlerFn returns a REST handler that queries for all
 // account balances or a specific balance by denomination.
 func QueryBalancesRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 
-		vars := mux.Vars(r)
-		bech32addr := vars["address"]
+		queryClient := types.NewQueryClient(clientCtx)
 
-		addr, err := sdk.AccAddressFromBech32(bech32addr)
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
+		denom := r.FormValue("denom")
+		if denom != "" {
+			res, err := queryClient.Balance(r.Context(), types.NewQueryBalanceRequest(addr, denom), opts...)
+			if err != nil {
+				return nil, err
+			}
 
-		ctx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+			return res.Balance, nil
 		}
 
-		var (
-			params interface{}
-			route  string
-		)
+		return queryAllBalances(r.Context(), clientCtx, addr, opts...)
+	})
+}
 
-		denom := r.FormValue("denom")
-		if denom == "" {
-			params = types.NewQueryAllBalancesRequest(addr, nil)
-			route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllBalances)
-		} else {
-			params = types.NewQueryBalanceRequest(addr, denom)
-			route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBalance)
+// queryAllBalances returns all the balances of the given account, querying all
+// the pages of balances at the height of the first one.
+func queryAllBalances(ctx context.Context, clientCtx client.Context, addr sdk.AccAddress, opts ...grpc.CallOption) (sdk.Coins, error) {
+	var (
+		header   metadata.MD
+		balances sdk.Coins
+		key      []byte
+	)
+
+	opts = append(opts, grpc.Header(&header))
+
+	for {
+		res, err := types.NewQueryClient(clientCtx).AllBalances(ctx, &types.QueryAllBalancesRequest{
+			Address:    addr.String(),
+			Pagination: &query.PageRequest{Key: key},
+		}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		bz, err := ctx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		balances = append(balances, res.Balances...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return balances, nil
 		}
 
-		res, height, err := ctx.QueryWithData(route, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 && clientCtx.Height == 0 {
+			height, err := strconv.ParseInt(heights[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			clientCtx = clientCtx.WithHeight(height)
 		}
 
-		ctx = ctx.WithHeight(height)
-		rest.PostProcessResponse(w, ctx, res)
+		key = res.Pagination.NextKey
 	}
 }
 
 // HTTP request handler to query the total supply of coins
 func totalSupplyHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
 		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
-		params := types.NewQueryTotalSupplyParams(page, limit)
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-
-		if rest.CheckBadRequestError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).TotalSupply(r.Context(), &types.QueryTotalSupplyRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTotalSupply), bz)
-
-		if rest.CheckInternalServerError(w, err) {
-			return
+		start, end := client.Paginate(len(res.Supply), page, limit, 100)
+		if start < 0 || end < 0 {
+			return sdk.Coins{}, nil
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Supply[start:end], nil
+	})
 }
 
 // HTTP request handler to query the supply of a single denom
 func supplyOfHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
 		denom := mux.Vars(r)["denom"]
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
 
-		params := types.NewQuerySupplyOfParams(denom)
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySupplyOf), bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).SupplyOf(r.Context(), &types.QuerySupplyOfRequest{Denom: denom}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Amount, nil
+	})
 }
@@ -4,6 +4,7 @@ package rest_test
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -142,6 +143,13 @@ func (s *IntegrationTestSuite) TestQueryBalancesRequestHandlerFn() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryBalancesRequestHandlerFnInvalidAddress() {
+	res, err := http.Get(fmt.Sprintf("%s/bank/balances/invalid", s.network.Validators[0].APIAddress))
+	s.Require().NoError(err)
+	s.Require().NoError(res.Body.Close())
+	s.Require().Equal(http.StatusBadRequest, res.StatusCode)
+}
+
 func (s *IntegrationTestSuite) TestTotalSupplyHandlerFn() {
 	val := s.network.Validators[0]
 	baseURL := val.APIAddress
@@ -161,3 +161,16 @@ func (k BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetada
 		Metadata: metadata,
 	}, nil
 }
+
+// ReconcileSupply implements Query/ReconcileSupply gRPC method.
+func (k BaseKeeper) ReconcileSupply(c context.Context, req *types.QueryReconcileSupplyRequest) (*types.QueryReconcileSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryReconcileSupplyResponse{
+		Mismatches: k.GetSupplyMismatches(ctx),
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -120,6 +121,31 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 	suite.Require().Equal(test1Supply, res.Amount)
 }
 
+func (suite *IntegrationTestSuite) TestQueryReconcileSupply() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	supply := sdk.NewCoins(sdk.NewInt64Coin("test", 400000000))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, supply))
+
+	res, err := queryClient.ReconcileSupply(gocontext.Background(), &types.QueryReconcileSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Mismatches)
+
+	// corrupt the balance of the mint module account
+	moduleAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	balance := sdk.NewInt64Coin("test", 400000010)
+	balancesStore := prefix.NewStore(ctx.KVStore(app.GetKey(types.StoreKey)), types.CreateAccountBalancesPrefix(moduleAddr))
+	balancesStore.Set([]byte(balance.Denom), app.AppCodec().MustMarshalBinaryBare(&balance))
+
+	res, err = queryClient.ReconcileSupply(gocontext.Background(), &types.QueryReconcileSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SupplyMismatch{{
+		Denom:          "test",
+		Supply:         sdk.NewInt(400000000),
+		Balances:       sdk.NewInt(400000010),
+		ModuleBalances: sdk.NewInt(400000010),
+	}}, res.Mismatches)
+}
+
 func (suite *IntegrationTestSuite) TestQueryParams() {
 	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/objcache"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -13,6 +14,10 @@ import (
 
 var _ Keeper = (*BaseKeeper)(nil)
 
+// denomMetadataCacheSize is the number of decoded denom metadata kept in the
+// denom metadata cache of the keeper.
+const denomMetadataCacheSize = 1000
+
 // Keeper defines a module interface that facilitates the transfer of coins
 // between accounts.
 type Keeper interface {
@@ -24,6 +29,7 @@ type Keeper interface {
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	GetTotalSupply(ctx sdk.Context) sdk.Coins
 	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
+	GetSupplyMismatches(ctx sdk.Context) []types.SupplyMismatch
 
 	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
@@ -51,6 +57,8 @@ type BaseKeeper struct {
 	cdc        codec.BinaryMarshaler
 	storeKey   sdk.StoreKey
 	paramSpace paramtypes.Subspace
+
+	denomMetadataCache *objcache.Cache
 }
 
 func (k BaseKeeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
@@ -79,6 +87,18 @@ func NewBaseKeeper(
 		cdc:            cdc,
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
+		denomMetadataCache: objcache.NewCache(
+			storeKey, denomMetadataCacheSize,
+			func(obj interface{}) ([]byte, error) {
+				metadata := obj.(types.Metadata)
+				return cdc.MarshalBinaryBare(&metadata)
+			},
+			func(bz []byte) (interface{}, error) {
+				var metadata types.Metadata
+				err := cdc.UnmarshalBinaryBare(bz, &metadata)
+				return metadata, err
+			},
+		),
 	}
 }
 
@@ -118,9 +138,11 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 		return sdkerrors.Wrap(err, "failed to track delegation")
 	}
 	// emit coin spent event
-	ctx.EventManager().EmitEvent(
-		types.NewCoinSpentEvent(delegatorAddr, amt),
-	)
+	if emitsEvents(ctx, sdk.EventVerbosityFull) {
+		ctx.EventManager().EmitEvent(
+			types.NewCoinSpentEvent(delegatorAddr, amt),
+		)
+	}
 
 	err := k.addCoins(ctx, moduleAccAddr, amt)
 	if err != nil {
@@ -211,20 +233,19 @@ func (k BaseKeeper) setSupply(ctx sdk.Context, supply sdk.Coins) {
 	}
 }
 
-// GetDenomMetaData retrieves the denomination metadata
+// GetDenomMetaData retrieves the denomination metadata. The metadata is read
+// through the denom metadata cache of the keeper and must not be modified.
 func (k BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool) {
-	store := ctx.KVStore(k.storeKey)
-	store = prefix.NewStore(store, types.DenomMetadataKey(denom))
+	obj, err := k.denomMetadataCache.Get(ctx, denomMetadataStoreKey(denom))
+	if err != nil {
+		panic(err)
+	}
 
-	bz := store.Get([]byte(denom))
-	if bz == nil {
+	if obj == nil {
 		return types.Metadata{}, false
 	}
 
-	var metadata types.Metadata
-	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
-
-	return metadata, true
+	return obj.(types.Metadata), true
 }
 
 // GetAllDenomMetaData retrieves all denominations metadata
@@ -260,11 +281,15 @@ func (k BaseKeeper) IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metad
 
 // SetDenomMetaData sets the denominations metadata
 func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata) {
-	store := ctx.KVStore(k.storeKey)
-	denomMetaDataStore := prefix.NewStore(store, types.DenomMetadataKey(denomMetaData.Base))
+	if err := k.denomMetadataCache.Set(ctx, denomMetadataStoreKey(denomMetaData.Base), denomMetaData); err != nil {
+		panic(err)
+	}
+}
 
-	m := k.cdc.MustMarshalBinaryBare(&denomMetaData)
-	denomMetaDataStore.Set([]byte(denomMetaData.Base), m)
+// denomMetadataStoreKey returns the key of the metadata of the denom in the
+// bank store.
+func denomMetadataStoreKey(denom string) []byte {
+	return append(types.DenomMetadataKey(denom), denom...)
 }
 
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
@@ -379,9 +404,11 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	logger.Info("minted coins from module account", "amount", amt.String(), "from", moduleName)
 
 	// emit mint event
-	ctx.EventManager().EmitEvent(
-		types.NewCoinMintEvent(acc.GetAddress(), amt),
-	)
+	if emitsEvents(ctx, sdk.EventVerbosityStandard) {
+		ctx.EventManager().EmitEvent(
+			types.NewCoinMintEvent(acc.GetAddress(), amt),
+		)
+	}
 
 	return nil
 }
@@ -413,9 +440,11 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	logger.Info("burned tokens from module account", "amount", amt.String(), "from", moduleName)
 
 	// emit burn event
-	ctx.EventManager().EmitEvent(
-		types.NewCoinBurnEvent(acc.GetAddress(), amt),
-	)
+	if emitsEvents(ctx, sdk.EventVerbosityStandard) {
+		ctx.EventManager().EmitEvent(
+			types.NewCoinBurnEvent(acc.GetAddress(), amt),
+		)
+	}
 
 	return nil
 }
@@ -450,6 +479,35 @@ func (k BaseKeeper) trackUndelegation(ctx sdk.Context, addr sdk.AccAddress, amt
 	return nil
 }
 
+// GetSupplyMismatches compares the tracked total supply with the sum of the
+// balances of all accounts and returns the mismatching denominations, along
+// with the balances escrowed by module accounts in these denominations.
+func (k BaseKeeper) GetSupplyMismatches(ctx sdk.Context) []types.SupplyMismatch {
+	reconciliation := types.NewSupplyReconciliation(k.GetTotalSupply(ctx))
+	k.IterateAllBalances(ctx, func(_ sdk.AccAddress, balance sdk.Coin) bool {
+		reconciliation.AddBalance(balance)
+		return false
+	})
+
+	mismatches := reconciliation.Mismatches()
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	// the module escrows are only computed to drill down into the mismatches
+	k.ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		if _, ok := acc.(authtypes.ModuleAccountI); ok {
+			for _, balance := range k.GetAllBalances(ctx, acc.GetAddress()) {
+				reconciliation.AddModuleBalance(balance)
+			}
+		}
+
+		return false
+	})
+
+	return reconciliation.Mismatches()
+}
+
 func (k BaseViewKeeper) IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool) {
 	store := ctx.KVStore(k.storeKey)
 	supplyStore := prefix.NewStore(store, types.SupplyKey)
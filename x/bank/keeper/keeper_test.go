@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -97,6 +98,36 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	suite.Require().Equal(totalSupply, total)
 }
 
+func (suite *IntegrationTestSuite) TestGetSupplyMismatches() {
+	app, ctx := suite.app, suite.ctx
+
+	initTokens := sdk.TokensFromConsensusPower(100)
+	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, totalSupply))
+
+	suite.Require().Empty(app.BankKeeper.GetSupplyMismatches(ctx))
+	_, broken := keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// corrupt the balance of the mint module account
+	moduleAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	balance := sdk.NewCoin(sdk.DefaultBondDenom, initTokens.AddRaw(10))
+	balancesStore := prefix.NewStore(ctx.KVStore(app.GetKey(types.StoreKey)), types.CreateAccountBalancesPrefix(moduleAddr))
+	balancesStore.Set([]byte(balance.Denom), app.AppCodec().MustMarshalBinaryBare(&balance))
+
+	suite.Require().Equal([]types.SupplyMismatch{{
+		Denom:          sdk.DefaultBondDenom,
+		Supply:         initTokens,
+		Balances:       initTokens.AddRaw(10),
+		ModuleBalances: initTokens.AddRaw(10),
+	}}, app.BankKeeper.GetSupplyMismatches(ctx))
+
+	msg, broken := keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "amount of mismatching denominations found 1")
+	suite.Require().Contains(msg, "difference -10")
+}
+
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
@@ -507,6 +538,40 @@ func (suite *IntegrationTestSuite) TestHasBalance() {
 	suite.Require().True(app.BankKeeper.HasBalance(ctx, addr, newFooCoin(1)))
 }
 
+func (suite *IntegrationTestSuite) TestSendEventsVerbosity() {
+	app, ctx := suite.app, suite.ctx
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50))
+
+	suite.Require().NoError(simapp.FundAccount(app, ctx, addr, coins.Add(coins...).Add(coins...)))
+
+	eventTypes := func(verbosity sdk.EventVerbosity) []string {
+		sdk.SetEventVerbosity(sdk.EventVerbosityConfig{
+			Default: sdk.EventVerbosityFull,
+			Modules: map[string]sdk.EventVerbosity{types.ModuleName: verbosity},
+		})
+		defer sdk.SetEventVerbosity(sdk.EventVerbosityConfig{})
+
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr, addr2, coins))
+
+		var eventTypes []string
+		for _, event := range ctx.EventManager().Events() {
+			eventTypes = append(eventTypes, event.Type)
+		}
+
+		return eventTypes
+	}
+
+	suite.Require().Equal(
+		[]string{types.EventTypeTransfer, sdk.EventTypeMessage, types.EventTypeCoinSpent, types.EventTypeCoinReceived},
+		eventTypes(sdk.EventVerbosityFull),
+	)
+	suite.Require().Equal([]string{types.EventTypeTransfer, sdk.EventTypeMessage}, eventTypes(sdk.EventVerbosityStandard))
+	suite.Require().Equal([]string{sdk.EventTypeMessage}, eventTypes(sdk.EventVerbosityMinimal))
+}
+
 func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1_______________"))
@@ -977,6 +1042,33 @@ func (suite *IntegrationTestSuite) TestSetDenomMetaData() {
 	suite.Require().Equal(metadata[1].GetDenomUnits()[1].GetAliases(), actualMetadata.GetDenomUnits()[1].GetAliases())
 }
 
+func (suite *IntegrationTestSuite) TestDenomMetaDataCache() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(1)
+
+	metadata := suite.getTestMetadata()
+	app.BankKeeper.SetDenomMetaData(ctx, metadata[0])
+
+	// reads served from the cache at the next height return the same
+	// metadata and consume the same gas as store reads
+	ctx = ctx.WithBlockHeight(2)
+	var gasUsed []sdk.Gas
+	for i := 0; i < 2; i++ {
+		gasBefore := ctx.GasMeter().GasConsumed()
+		actualMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, metadata[0].Base)
+		suite.Require().True(found)
+		suite.Require().Equal(metadata[0], actualMetadata)
+		gasUsed = append(gasUsed, ctx.GasMeter().GasConsumed()-gasBefore)
+	}
+	suite.Require().Equal(gasUsed[0], gasUsed[1])
+
+	// updates are visible right away
+	metadata[0].Description = "updated"
+	app.BankKeeper.SetDenomMetaData(ctx, metadata[0])
+	actualMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, metadata[0].Base)
+	suite.Require().True(found)
+	suite.Require().Equal("updated", actualMetadata.Description)
+}
+
 func (suite *IntegrationTestSuite) TestIterateAllDenomMetaData() {
 	app, ctx := suite.app, suite.ctx
 
@@ -106,13 +106,15 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 			return err
 		}
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
-			),
-		)
+		if emitsEvents(ctx, sdk.EventVerbosityStandard) {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeTransfer,
+					sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
+				),
+			)
+		}
 
 		// Create account if recipient does not exist.
 		//
@@ -131,18 +133,23 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeTransfer,
-			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
-			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
-		),
+	if emitsEvents(ctx, sdk.EventVerbosityStandard) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransfer,
+				sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+				sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
 		),
-	})
+	)
 
 	err := k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
@@ -169,7 +176,7 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
-// A coin_spent event is emitted after.
+// A coin_spent event is emitted after, if the event verbosity is full.
 func (k BaseSendKeeper) subUnlockedCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
 	if !amt.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
@@ -196,14 +203,17 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx sdk.Context, addr sdk.AccAddress, a
 	}
 
 	// emit coin spent event
-	ctx.EventManager().EmitEvent(
-		types.NewCoinSpentEvent(addr, amt),
-	)
+	if emitsEvents(ctx, sdk.EventVerbosityFull) {
+		ctx.EventManager().EmitEvent(
+			types.NewCoinSpentEvent(addr, amt),
+		)
+	}
+
 	return nil
 }
 
 // addCoins increase the addr balance by the given amt. Fails if the provided amt is invalid.
-// It emits a coin received event.
+// It emits a coin received event, if the event verbosity is full.
 func (k BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
 	if !amt.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
@@ -220,9 +230,11 @@ func (k BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 	}
 
 	// emit coin received event
-	ctx.EventManager().EmitEvent(
-		types.NewCoinReceivedEvent(addr, amt),
-	)
+	if emitsEvents(ctx, sdk.EventVerbosityFull) {
+		ctx.EventManager().EmitEvent(
+			types.NewCoinReceivedEvent(addr, amt),
+		)
+	}
 
 	return nil
 }
@@ -294,3 +306,11 @@ func (k BaseSendKeeper) SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// emitsEvents returns whether the bank events of the given verbosity are emitted
+// with the event verbosity configured on the node. Balance changes are full
+// events, transfers, mints and burns standard ones, and the message events
+// holding the sender of transfers are always emitted.
+func emitsEvents(ctx sdk.Context, verbosity sdk.EventVerbosity) bool {
+	return ctx.EventManager().Verbosity(types.ModuleName).Includes(verbosity)
+}
@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding bank type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.SupplyKey):
+			var supplyA, supplyB sdk.Coin
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &supplyA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &supplyB)
+
+			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+		case bytes.Equal(kvA.Key[:1], types.DenomMetadataPrefix):
+			var metadataA, metadataB types.Metadata
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &metadataA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &metadataB)
+
+			return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+		case bytes.Equal(kvA.Key[:1], types.BalancesPrefix):
+			var balanceA, balanceB sdk.Coin
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &balanceA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &balanceB)
+
+			addr := types.AddressFromBalancesStore(kvA.Key[1:])
+
+			return fmt.Sprintf("%s: %v\n%s: %v", addr, balanceA, addr, balanceB)
+		default:
+			panic(fmt.Sprintf("invalid bank key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	supply := sdk.NewInt64Coin("stake", 1000)
+	balance := sdk.NewInt64Coin("stake", 10)
+	metadata := types.Metadata{Base: "stake", Display: "stake"}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append(types.SupplyKey, []byte("stake")...), Value: cdc.MustMarshalBinaryBare(&supply)},
+			{Key: types.DenomMetadataKey("stake"), Value: cdc.MustMarshalBinaryBare(&metadata)},
+			{Key: append(types.CreateAccountBalancesPrefix(addr), []byte("stake")...), Value: cdc.MustMarshalBinaryBare(&balance)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Supply", fmt.Sprintf("%v\n%v", supply, supply)},
+		{"DenomMetadata", fmt.Sprintf("%v\n%v", metadata, metadata)},
+		{"Balance", fmt.Sprintf("%s: %v\n%s: %v", addr, balance, addr, balance)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
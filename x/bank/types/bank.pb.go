@@ -413,6 +413,58 @@ func (m *Metadata) GetSymbol() string {
 	return ""
 }
 
+// SupplyMismatch reports a denomination whose tracked total supply differs
+// from the sum of the balances of all accounts, module accounts included.
+type SupplyMismatch struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// supply is the tracked total supply of the denomination.
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	// balances is the sum of the balances of all accounts in the denomination.
+	Balances github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=balances,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balances"`
+	// module_balances is the sum of the balances of module accounts in the
+	// denomination, included in balances.
+	ModuleBalances github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=module_balances,json=moduleBalances,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"module_balances" yaml:"module_balances"`
+}
+
+func (m *SupplyMismatch) Reset()      { *m = SupplyMismatch{} }
+func (*SupplyMismatch) ProtoMessage() {}
+func (*SupplyMismatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *SupplyMismatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyMismatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyMismatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyMismatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyMismatch.Merge(m, src)
+}
+func (m *SupplyMismatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyMismatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyMismatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyMismatch proto.InternalMessageInfo
+
+func (m *SupplyMismatch) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -421,49 +473,55 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*SupplyMismatch)(nil), "cosmos.bank.v1beta1.SupplyMismatch")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0x35, 0x8d, 0x49, 0x2f, 0xb0, 0x1c, 0x15, 0x72, 0x2b, 0x61, 0x1b, 0x4b, 0x48, 0x29,
-	0xa2, 0x0e, 0x05, 0xb1, 0x64, 0x41, 0x4a, 0x41, 0xa8, 0x03, 0x02, 0xb9, 0x42, 0x48, 0x30, 0x44,
-	0xe7, 0xdc, 0x35, 0x58, 0xb5, 0xef, 0xac, 0xdc, 0x19, 0xd5, 0xff, 0x01, 0x13, 0x30, 0x32, 0x76,
-	0x66, 0x62, 0xe0, 0x7f, 0xa0, 0x63, 0x05, 0x0b, 0x53, 0x40, 0xc9, 0xc2, 0xdc, 0xbf, 0x00, 0xdd,
-	0x9d, 0xf3, 0xa3, 0x52, 0x40, 0x0c, 0x0c, 0x4c, 0x79, 0xdf, 0x7b, 0xdf, 0xfb, 0xde, 0xd3, 0x77,
-	0xcf, 0x81, 0x6e, 0x9f, 0x8b, 0x8c, 0x8b, 0x76, 0x8c, 0xd9, 0x61, 0xfb, 0xd5, 0x4e, 0x4c, 0x25,
-	0xde, 0xd1, 0x20, 0xcc, 0x87, 0x5c, 0x72, 0x74, 0xd9, 0xd4, 0x43, 0x9d, 0xaa, 0xea, 0x9b, 0xeb,
-	0x03, 0x3e, 0xe0, 0xba, 0xde, 0x56, 0x91, 0xa1, 0x6e, 0x6e, 0x18, 0x6a, 0xcf, 0x14, 0xaa, 0x3e,
-	0x53, 0x9a, 0x4f, 0x11, 0x74, 0x36, 0xa5, 0xcf, 0x13, 0x66, 0xea, 0xc1, 0x57, 0x00, 0xed, 0x27,
-	0x78, 0x88, 0x33, 0x81, 0x0e, 0xe0, 0x45, 0x41, 0x19, 0xe9, 0x51, 0x86, 0xe3, 0x94, 0x12, 0x07,
-	0xf8, 0xb5, 0x56, 0xf3, 0xb6, 0x1f, 0x2e, 0xd9, 0x23, 0xdc, 0xa7, 0x8c, 0x3c, 0x30, 0xbc, 0xee,
-	0xb5, 0xb3, 0x91, 0x77, 0xb5, 0xc4, 0x59, 0xda, 0x09, 0x16, 0xfb, 0x6f, 0xf2, 0x2c, 0x91, 0x34,
-	0xcb, 0x65, 0x19, 0x44, 0x4d, 0x31, 0xe7, 0xa3, 0x17, 0x70, 0x9d, 0xd0, 0x03, 0x5c, 0xa4, 0xb2,
-	0x77, 0x6e, 0xde, 0x8a, 0x0f, 0x5a, 0x8d, 0xee, 0xd6, 0xd9, 0xc8, 0xbb, 0x6e, 0xd4, 0x96, 0xb1,
-	0x16, 0x55, 0x51, 0x45, 0x58, 0x58, 0xa6, 0xb3, 0xfa, 0xfe, 0xd8, 0xb3, 0x82, 0x87, 0xb0, 0xb9,
-	0x90, 0x44, 0xeb, 0xb0, 0x4e, 0x28, 0xe3, 0x99, 0x03, 0x7c, 0xd0, 0x5a, 0x8b, 0x0c, 0x40, 0x0e,
-	0xbc, 0x70, 0x6e, 0x74, 0x34, 0x85, 0x9d, 0x86, 0x12, 0xf9, 0x79, 0xec, 0x81, 0xe0, 0x0d, 0x80,
-	0xf5, 0x3d, 0x96, 0x17, 0x52, 0xb1, 0x31, 0x21, 0x43, 0x2a, 0x44, 0xa5, 0x32, 0x85, 0x08, 0xc3,
-	0xba, 0x32, 0x54, 0x38, 0x2b, 0xda, 0xb0, 0x8d, 0xb9, 0x61, 0x82, 0xce, 0x0c, 0xdb, 0xe5, 0x09,
-	0xeb, 0xde, 0x3a, 0x19, 0x79, 0xd6, 0x87, 0xef, 0x5e, 0x6b, 0x90, 0xc8, 0x97, 0x45, 0x1c, 0xf6,
-	0x79, 0x56, 0xbd, 0x56, 0xf5, 0xb3, 0x2d, 0xc8, 0x61, 0x5b, 0x96, 0x39, 0x15, 0xba, 0x41, 0x44,
-	0x46, 0xb9, 0xd3, 0x78, 0x6d, 0x16, 0xb2, 0x82, 0xb7, 0x00, 0xda, 0x8f, 0x0b, 0xf9, 0x1f, 0x6d,
-	0xf4, 0x11, 0x40, 0x7b, 0xbf, 0xc8, 0xf3, 0xb4, 0x54, 0x73, 0x25, 0x97, 0x38, 0xad, 0x4e, 0xe7,
-	0xdf, 0xce, 0xd5, 0xca, 0x9d, 0xdd, 0x6a, 0x2e, 0xf8, 0xf2, 0x69, 0xfb, 0xee, 0x8d, 0x3f, 0x76,
-	0x1f, 0x99, 0x4f, 0x8b, 0x1e, 0xe5, 0x7c, 0x28, 0x29, 0x09, 0xcd, 0x92, 0x7b, 0x0e, 0x08, 0x9e,
-	0xc1, 0xb5, 0xfb, 0xea, 0x04, 0x9e, 0xb2, 0x44, 0xfe, 0xe6, 0x38, 0x36, 0x61, 0x43, 0x35, 0x32,
-	0xca, 0xa4, 0xbe, 0x8e, 0x4b, 0xd1, 0x0c, 0x6b, 0xe3, 0xd3, 0x04, 0x0b, 0x2a, 0x9c, 0x9a, 0x5f,
-	0xd3, 0xc6, 0x1b, 0x18, 0x7c, 0x06, 0xb0, 0xf1, 0x88, 0x4a, 0x4c, 0xb0, 0xc4, 0xc8, 0x87, 0x4d,
-	0x42, 0x45, 0x7f, 0x98, 0xe4, 0x32, 0xe1, 0xac, 0x92, 0x5f, 0x4c, 0xa1, 0x7b, 0x8a, 0xc1, 0x78,
-	0xd6, 0x2b, 0x58, 0x22, 0xa7, 0xaf, 0xe5, 0x2e, 0xfd, 0xe0, 0x66, 0xfb, 0x46, 0x90, 0x4c, 0x43,
-	0x81, 0x10, 0x5c, 0x55, 0xde, 0x3a, 0x35, 0xad, 0xad, 0x63, 0xb5, 0x1d, 0x49, 0x44, 0x9e, 0xe2,
-	0xd2, 0x59, 0x35, 0x67, 0x51, 0x41, 0xc5, 0x66, 0x38, 0xa3, 0x4e, 0xdd, 0xb0, 0x55, 0x8c, 0xae,
-	0x40, 0x5b, 0x94, 0x59, 0xcc, 0x53, 0xc7, 0xd6, 0xd9, 0x0a, 0x75, 0x77, 0x4f, 0xc6, 0x2e, 0x38,
-	0x1d, 0xbb, 0xe0, 0xc7, 0xd8, 0x05, 0xef, 0x26, 0xae, 0x75, 0x3a, 0x71, 0xad, 0x6f, 0x13, 0xd7,
-	0x7a, 0xbe, 0xf5, 0x37, 0xa6, 0xeb, 0x97, 0x8b, 0x6d, 0xfd, 0x1f, 0x73, 0xe7, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xe7, 0x1c, 0xc1, 0x93, 0xeb, 0x04, 0x00, 0x00,
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x34, 0xcd, 0x9a, 0x4e, 0xb4, 0xc2, 0x58, 0xca, 0xb6, 0xe0, 0x6e, 0x5c, 0x50, 0x52,
+	0xb1, 0x1b, 0xab, 0x78, 0xc9, 0x45, 0xd8, 0xfa, 0xab, 0x42, 0x51, 0xb6, 0x88, 0xa0, 0x87, 0x30,
+	0x9b, 0x99, 0xb6, 0x4b, 0x77, 0x67, 0xd6, 0xcc, 0xac, 0x34, 0xff, 0x81, 0x27, 0xf5, 0xe8, 0xb1,
+	0x67, 0x4f, 0x82, 0xfe, 0x0f, 0xf6, 0x58, 0xf4, 0x22, 0x1e, 0xa2, 0xb4, 0x17, 0xcf, 0xfd, 0x0b,
+	0x64, 0x66, 0x36, 0x69, 0x2a, 0xad, 0x54, 0x41, 0xf0, 0xb4, 0xf3, 0xde, 0xfb, 0xde, 0xf7, 0x3d,
+	0xbe, 0x79, 0x3b, 0xd0, 0xe9, 0x70, 0x91, 0x72, 0xd1, 0x8c, 0x30, 0xdb, 0x68, 0x3e, 0x5f, 0x88,
+	0xa8, 0xc4, 0x0b, 0x3a, 0xf0, 0xb3, 0x2e, 0x97, 0x1c, 0x9d, 0x33, 0x75, 0x5f, 0xa7, 0x8a, 0xfa,
+	0xec, 0xd4, 0x1a, 0x5f, 0xe3, 0xba, 0xde, 0x54, 0x27, 0x03, 0x9d, 0x9d, 0x31, 0xd0, 0xb6, 0x29,
+	0x14, 0x7d, 0xa6, 0x74, 0xa0, 0x22, 0xe8, 0x50, 0xa5, 0xc3, 0x63, 0x66, 0xea, 0xde, 0x67, 0x00,
+	0xad, 0x87, 0xb8, 0x8b, 0x53, 0x81, 0x56, 0xe1, 0x69, 0x41, 0x19, 0x69, 0x53, 0x86, 0xa3, 0x84,
+	0x12, 0x1b, 0xd4, 0xcb, 0x8d, 0xda, 0xb5, 0xba, 0x7f, 0xc4, 0x1c, 0xfe, 0x0a, 0x65, 0xe4, 0xb6,
+	0xc1, 0x05, 0x17, 0xf6, 0xfb, 0xee, 0xf9, 0x1e, 0x4e, 0x93, 0x96, 0x37, 0xda, 0x7f, 0x85, 0xa7,
+	0xb1, 0xa4, 0x69, 0x26, 0x7b, 0x5e, 0x58, 0x13, 0x07, 0x78, 0xf4, 0x14, 0x4e, 0x11, 0xba, 0x8a,
+	0xf3, 0x44, 0xb6, 0x0f, 0xe9, 0x8d, 0xd5, 0x41, 0xa3, 0x1a, 0xcc, 0xed, 0xf7, 0xdd, 0x8b, 0x86,
+	0xed, 0x28, 0xd4, 0x28, 0x2b, 0x2a, 0x00, 0x23, 0xc3, 0xb4, 0xc6, 0xdf, 0x6c, 0xb9, 0x25, 0xef,
+	0x2e, 0xac, 0x8d, 0x24, 0xd1, 0x14, 0xac, 0x10, 0xca, 0x78, 0x6a, 0x83, 0x3a, 0x68, 0x4c, 0x84,
+	0x26, 0x40, 0x36, 0x3c, 0x75, 0x48, 0x3a, 0x1c, 0x84, 0xad, 0xaa, 0x22, 0xf9, 0xb1, 0xe5, 0x02,
+	0xef, 0x25, 0x80, 0x95, 0x25, 0x96, 0xe5, 0x52, 0xa1, 0x31, 0x21, 0x5d, 0x2a, 0x44, 0xc1, 0x32,
+	0x08, 0x11, 0x86, 0x15, 0x65, 0xa8, 0xb0, 0xc7, 0xb4, 0x61, 0x33, 0x07, 0x86, 0x09, 0x3a, 0x34,
+	0x6c, 0x91, 0xc7, 0x2c, 0xb8, 0xba, 0xdd, 0x77, 0x4b, 0x6f, 0xbf, 0xb9, 0x8d, 0xb5, 0x58, 0xae,
+	0xe7, 0x91, 0xdf, 0xe1, 0x69, 0x71, 0x5b, 0xc5, 0x67, 0x5e, 0x90, 0x8d, 0xa6, 0xec, 0x65, 0x54,
+	0xe8, 0x06, 0x11, 0x1a, 0xe6, 0x56, 0xf5, 0x85, 0x19, 0xa8, 0xe4, 0xbd, 0x02, 0xd0, 0x7a, 0x90,
+	0xcb, 0xff, 0x68, 0xa2, 0x77, 0x00, 0x5a, 0x2b, 0x79, 0x96, 0x25, 0x3d, 0xa5, 0x2b, 0xb9, 0xc4,
+	0x89, 0x0d, 0xfe, 0x81, 0xae, 0x66, 0x6e, 0x2d, 0x16, 0xba, 0xe0, 0xd3, 0x87, 0xf9, 0x1b, 0x97,
+	0x7f, 0xdb, 0xbd, 0x69, 0x7e, 0x2d, 0xba, 0x99, 0xf1, 0xae, 0xa4, 0xc4, 0x37, 0x43, 0x2e, 0xd9,
+	0xc0, 0x7b, 0x0c, 0x27, 0x6e, 0xa9, 0x15, 0x78, 0xc4, 0x62, 0x79, 0xcc, 0x72, 0xcc, 0xc2, 0xaa,
+	0x6a, 0x64, 0x94, 0x49, 0xbd, 0x1d, 0x67, 0xc2, 0x61, 0xac, 0x8d, 0x4f, 0x62, 0x2c, 0xa8, 0xb0,
+	0xcb, 0xf5, 0xb2, 0x36, 0xde, 0x84, 0xde, 0x47, 0x00, 0xab, 0xcb, 0x54, 0x62, 0x82, 0x25, 0x46,
+	0x75, 0x58, 0x23, 0x54, 0x74, 0xba, 0x71, 0x26, 0x63, 0xce, 0x0a, 0xfa, 0xd1, 0x14, 0xba, 0xa9,
+	0x10, 0x8c, 0xa7, 0xed, 0x9c, 0xc5, 0x72, 0x70, 0x5b, 0xce, 0x91, 0x3f, 0xdc, 0x70, 0xde, 0x10,
+	0x92, 0xc1, 0x51, 0x20, 0x04, 0xc7, 0x95, 0xb7, 0x76, 0x59, 0x73, 0xeb, 0xb3, 0x9a, 0x8e, 0xc4,
+	0x22, 0x4b, 0x70, 0xcf, 0x1e, 0x37, 0x6b, 0x51, 0x84, 0x0a, 0xcd, 0x70, 0x4a, 0xed, 0x8a, 0x41,
+	0xab, 0x33, 0x9a, 0x86, 0x96, 0xe8, 0xa5, 0x11, 0x4f, 0x6c, 0x4b, 0x67, 0x8b, 0xc8, 0x7b, 0x3f,
+	0x06, 0x27, 0x8d, 0x61, 0xcb, 0xb1, 0x48, 0xb1, 0xec, 0xac, 0x1f, 0x63, 0xd4, 0x1d, 0x68, 0x09,
+	0x8d, 0xd3, 0x36, 0x4d, 0x04, 0xbe, 0xba, 0xd9, 0xaf, 0x7d, 0xf7, 0xd2, 0x09, 0x6e, 0x76, 0x89,
+	0xc9, 0xb0, 0xe8, 0x46, 0xf7, 0x61, 0x35, 0xc2, 0x09, 0x66, 0x1d, 0xed, 0xea, 0xdf, 0x30, 0x0d,
+	0xfb, 0xd1, 0x33, 0x78, 0x36, 0xe5, 0x24, 0x4f, 0x68, 0x7b, 0x48, 0xa9, 0xad, 0x08, 0xee, 0xfd,
+	0x19, 0xe5, 0x7e, 0xdf, 0x9d, 0x36, 0x4f, 0xd1, 0x2f, 0x74, 0x5e, 0x38, 0x69, 0x32, 0x41, 0x91,
+	0x30, 0xef, 0x4e, 0xb0, 0xb8, 0xbd, 0xeb, 0x80, 0x9d, 0x5d, 0x07, 0x7c, 0xdf, 0x75, 0xc0, 0xeb,
+	0x3d, 0xa7, 0xb4, 0xb3, 0xe7, 0x94, 0xbe, 0xec, 0x39, 0xa5, 0x27, 0x73, 0x27, 0x59, 0x55, 0x2d,
+	0x1c, 0x59, 0xfa, 0x65, 0xbe, 0xfe, 0x73, 0x00, 0x39, 0x8d, 0xf5, 0x3d, 0x21, 0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -850,6 +908,66 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplyMismatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyMismatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyMismatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ModuleBalances.Size()
+		i -= size
+		if _, err := m.ModuleBalances.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Balances.Size()
+		i -= size
+		if _, err := m.Balances.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -1005,6 +1123,25 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *SupplyMismatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = m.Balances.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = m.ModuleBalances.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1910,6 +2047,190 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SupplyMismatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyMismatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyMismatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balances.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalances", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleBalances.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// maxExponentDiff is the largest difference of exponents between two
+// denomination units that can be converted: 10^76 is the largest power of ten
+// an sdk.Dec holds, with 18 fractional digits, so that larger differences
+// overflow any non-zero amount, or truncate any amount to zero.
+const maxExponentDiff = 76 + sdk.Precision
+
+// denomUnitRef references a denomination unit of an asset.
+type denomUnitRef struct {
+	base     string
+	exponent uint32
+}
+
+// DenomConverter converts coins between the denomination units of the assets
+// described by bank denom metadata, e.g. from 1atom to 1000000uatom for an
+// asset whose base denomination is uatom and whose atom denomination unit has
+// exponent 6. Denomination units are referenced by their denomination or any
+// of their aliases.
+type DenomConverter struct {
+	units map[string]denomUnitRef
+}
+
+// NewDenomConverter returns a DenomConverter of the assets described by the
+// given denom metadata. An error is returned if a denomination unit, or alias,
+// is used by two assets.
+func NewDenomConverter(metadatas []Metadata) (DenomConverter, error) {
+	c := DenomConverter{units: make(map[string]denomUnitRef)}
+
+	for _, m := range metadatas {
+		for _, du := range m.DenomUnits {
+			ref := denomUnitRef{base: m.Base, exponent: du.Exponent}
+
+			for _, denom := range append([]string{du.Denom}, du.Aliases...) {
+				if other, ok := c.units[denom]; ok && other != ref {
+					return DenomConverter{}, fmt.Errorf(
+						"denomination unit %s is used by both %s and %s", denom, other.base, m.Base,
+					)
+				}
+
+				c.units[denom] = ref
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// ConvertDecCoin converts a decimal coin to the given denomination unit of the
+// same asset. Amounts are truncated to the precision of sdk.Dec.
+func (c DenomConverter) ConvertDecCoin(coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	src, ok := c.units[coin.Denom]
+	if !ok {
+		return sdk.DecCoin{}, sdkerrors.Wrapf(ErrDenomMetadataNotFound, "denomination unit %s", coin.Denom)
+	}
+
+	dst, ok := c.units[denom]
+	if !ok {
+		return sdk.DecCoin{}, sdkerrors.Wrapf(ErrDenomMetadataNotFound, "denomination unit %s", denom)
+	}
+
+	if src.base != dst.base {
+		return sdk.DecCoin{}, fmt.Errorf(
+			"cannot convert %s to %s: they are units of different assets (%s and %s)", coin.Denom, denom, src.base, dst.base,
+		)
+	}
+
+	diff := int64(src.exponent) - int64(dst.exponent)
+	if diff > maxExponentDiff || diff < -maxExponentDiff {
+		return sdk.DecCoin{}, fmt.Errorf("cannot convert %s to %s: exponent difference %d is too large", coin.Denom, denom, diff)
+	}
+
+	amount, err := scaleDec(coin.Amount, diff)
+	if err != nil {
+		return sdk.DecCoin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot convert %s to %s: %s", coin, denom, err)
+	}
+
+	return sdk.NewDecCoinFromDec(denom, amount), nil
+}
+
+// scaleDec returns amount * 10^exp, truncated to the precision of sdk.Dec. An
+// error is returned if the result overflows sdk.Dec.
+func scaleDec(amount sdk.Dec, exp int64) (res sdk.Dec, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("amount %s out of bounds: %v", amount, r)
+		}
+	}()
+
+	abs := exp
+	if abs < 0 {
+		abs = -abs
+	}
+
+	// 10^abs overflows sdk.Int for the largest exponents, so that the factor is
+	// built from a big.Int; sdk.Dec only checks the bounds of results
+	factor := sdk.NewDecFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(abs), nil))
+
+	switch {
+	case exp > 0:
+		return amount.Mul(factor), nil
+
+	case exp < 0:
+		return amount.QuoTruncate(factor), nil
+	}
+
+	return amount, nil
+}
+
+// ConvertCoin converts a coin to the given denomination unit of the same asset.
+// An error is returned if the converted amount is not an integer, e.g. when
+// converting 1uatom to atom.
+func (c DenomConverter) ConvertCoin(coin sdk.Coin, denom string) (sdk.Coin, error) {
+	decCoin, err := c.ConvertDecCoin(sdk.NewDecCoinFromCoin(coin), denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return truncateExact(decCoin)
+}
+
+// NormalizeDecCoin converts a decimal coin to the base denomination of its
+// asset. Coins of denominations without metadata are returned as is.
+func (c DenomConverter) NormalizeDecCoin(coin sdk.DecCoin) (sdk.DecCoin, error) {
+	ref, ok := c.units[coin.Denom]
+	if !ok {
+		return coin, nil
+	}
+
+	return c.ConvertDecCoin(coin, ref.base)
+}
+
+// NormalizeCoin converts a coin to the base denomination of its asset. Coins
+// of denominations without metadata are returned as is.
+func (c DenomConverter) NormalizeCoin(coin sdk.Coin) (sdk.Coin, error) {
+	decCoin, err := c.NormalizeDecCoin(sdk.NewDecCoinFromCoin(coin))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return truncateExact(decCoin)
+}
+
+// ParseCoinsNormalized parses a list of decimal coins separated by commas,
+// e.g. "1.5atom,10stake", and converts them to the base denomination of their
+// assets. An error is returned if an amount is not an integer once converted.
+func (c DenomConverter) ParseCoinsNormalized(coinsStr string) (sdk.Coins, error) {
+	decCoins, err := sdk.ParseDecCoins(coinsStr)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins()
+	for _, decCoin := range decCoins {
+		normalized, err := c.NormalizeDecCoin(decCoin)
+		if err != nil {
+			return nil, err
+		}
+
+		coin, err := truncateExact(normalized)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid amount %s", decCoin)
+		}
+
+		coins = coins.Add(coin)
+	}
+
+	return coins, nil
+}
+
+// truncateExact returns the coin of the given decimal coin, which must have an
+// integer amount.
+func truncateExact(decCoin sdk.DecCoin) (sdk.Coin, error) {
+	coin, change := decCoin.TruncateDecimal()
+	if !change.IsZero() {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidCoins, "%s is not an integer amount of %s", decCoin.Amount, decCoin.Denom,
+		)
+	}
+
+	return coin, nil
+}
@@ -0,0 +1,164 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func testDenomConverter(t *testing.T) types.DenomConverter {
+	converter, err := types.NewDenomConverter([]types.Metadata{
+		{
+			Base:    "uatom",
+			Display: "atom",
+			DenomUnits: []*types.DenomUnit{
+				{"uatom", uint32(0), []string{"microatom"}},
+				{"matom", uint32(3), []string{"milliatom"}},
+				{"atom", uint32(6), nil},
+			},
+		},
+		{
+			Base:    "aevmos",
+			Display: "evmos",
+			DenomUnits: []*types.DenomUnit{
+				{"aevmos", uint32(0), nil},
+				{"evmos", uint32(18), nil},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	return converter
+}
+
+func TestNewDenomConverter(t *testing.T) {
+	_, err := types.NewDenomConverter([]types.Metadata{
+		{Base: "uatom", DenomUnits: []*types.DenomUnit{{"uatom", uint32(0), nil}, {"atom", uint32(6), nil}}},
+		{Base: "uosmo", DenomUnits: []*types.DenomUnit{{"uosmo", uint32(0), []string{"atom"}}}},
+	})
+	require.Error(t, err)
+}
+
+func TestDenomConverterConvert(t *testing.T) {
+	converter := testDenomConverter(t)
+
+	coin, err := converter.ConvertCoin(sdk.NewInt64Coin("atom", 2), "uatom")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 2000000), coin)
+
+	_, err = converter.ConvertCoin(sdk.NewInt64Coin("milliatom", 1500), "atom")
+	require.Error(t, err)
+
+	coin, err = converter.ConvertCoin(sdk.NewInt64Coin("microatom", 3000), "matom")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("matom", 3), coin)
+
+	decCoin, err := converter.ConvertDecCoin(sdk.NewInt64DecCoin("uatom", 1), "atom")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 6)), decCoin)
+
+	decCoin, err = converter.ConvertDecCoin(sdk.NewDecCoinFromDec("evmos", sdk.NewDecWithPrec(15, 1)), "aevmos")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("aevmos", sdk.NewDecFromInt(sdk.NewIntWithDecimal(15, 17))), decCoin)
+
+	_, err = converter.ConvertCoin(sdk.NewInt64Coin("atom", 1), "evmos")
+	require.Error(t, err)
+
+	_, err = converter.ConvertCoin(sdk.NewInt64Coin("stake", 1), "atom")
+	require.ErrorIs(t, err, types.ErrDenomMetadataNotFound)
+}
+
+func TestDenomConverterNormalize(t *testing.T) {
+	converter := testDenomConverter(t)
+
+	coin, err := converter.NormalizeCoin(sdk.NewInt64Coin("matom", 5))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 5000), coin)
+
+	coin, err = converter.NormalizeCoin(sdk.NewInt64Coin("stake", 5))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 5), coin)
+
+	decCoin, err := converter.NormalizeDecCoin(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(25, 1)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64DecCoin("uatom", 2500000), decCoin)
+}
+
+func TestDenomConverterParseCoinsNormalized(t *testing.T) {
+	converter := testDenomConverter(t)
+
+	testCases := []struct {
+		input    string
+		expected sdk.Coins
+		expErr   bool
+	}{
+		{"", sdk.NewCoins(), false},
+		{"10atom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 10000000)), false},
+		{"1.5atom,500microatom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500500)), false},
+		{"0.000001atom,10stake", sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uatom", 1)), false},
+		{"0.0000001atom", nil, true},
+		{"1.5stake", nil, true},
+		{"atom", nil, true},
+	}
+
+	for _, tc := range testCases {
+		coins, err := converter.ParseCoinsNormalized(tc.input)
+		if tc.expErr {
+			require.Error(t, err, tc.input)
+			continue
+		}
+
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.expected, coins, tc.input)
+	}
+}
+
+func TestDenomConverterOverflow(t *testing.T) {
+	converter, err := types.NewDenomConverter([]types.Metadata{
+		{
+			Base: "atto",
+			DenomUnits: []*types.DenomUnit{
+				{"atto", uint32(0), nil},
+				{"unit", uint32(18), nil},
+				{"huge", uint32(94), nil},
+				{"toohuge", uint32(95), nil},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// decimals close to the largest sdk.Dec overflow once multiplied
+	maxDec := sdk.MustNewDecFromStr("66000000000000000000000000000000000000000000000000000000000000000000000000000")
+	_, err = converter.ConvertDecCoin(sdk.NewDecCoinFromDec("unit", maxDec), "atto")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	_, err = converter.ConvertCoin(sdk.NewCoin("unit", sdk.NewIntWithDecimal(1, 60)), "atto")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	coin, err := converter.ConvertCoin(sdk.NewCoin("unit", sdk.NewIntWithDecimal(1, 58)), "atto")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("atto", sdk.NewIntWithDecimal(1, 76)), coin)
+
+	// the largest exponent difference converts the smallest decimal amounts to
+	// the largest ones, and back
+	decCoin, err := converter.ConvertDecCoin(sdk.NewDecCoinFromDec("huge", sdk.SmallestDec()), "atto")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("atto", sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 76))), decCoin)
+
+	decCoin, err = converter.ConvertDecCoin(decCoin, "huge")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("huge", sdk.SmallestDec()), decCoin)
+
+	_, err = converter.ConvertDecCoin(sdk.NewDecCoinFromDec("huge", sdk.OneDec()), "atto")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	_, err = converter.ConvertDecCoin(sdk.NewDecCoinFromDec("toohuge", sdk.SmallestDec()), "atto")
+	require.Error(t, err)
+
+	_, err = converter.ConvertDecCoin(sdk.NewInt64DecCoin("atto", 1), "toohuge")
+	require.Error(t, err)
+}
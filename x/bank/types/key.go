@@ -1,6 +1,9 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -48,3 +51,24 @@ func AddressFromBalancesStore(key []byte) sdk.AccAddress {
 func CreateAccountBalancesPrefix(addr []byte) []byte {
 	return append(BalancesPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// DecodeStoreKey decodes the key of an entry of the bank store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	switch {
+	case bytes.HasPrefix(key, SupplyKey):
+		return sdk.NewStoreKeyParser("Supply", key[1:], &sdk.Coin{}).String("denom").Decode()
+
+	case bytes.HasPrefix(key, DenomMetadataPrefix):
+		// the metadata of a denom is stored under the denom twice
+		return sdk.NewStoreKeyParser("DenomMetadata", key[1:], &Metadata{}).
+			Field("denom", (len(key)-1)/2, func(bz []byte) string { return string(bz) }).
+			String("base").
+			Decode()
+
+	case bytes.HasPrefix(key, BalancesPrefix):
+		return sdk.NewStoreKeyParser("Balances", key[1:], &sdk.Coin{}).AccAddress("address").String("denom").Decode()
+
+	default:
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+}
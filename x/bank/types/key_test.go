@@ -27,3 +27,34 @@ func TestAddressFromBalancesStore(t *testing.T) {
 	res := types.AddressFromBalancesStore(key)
 	require.Equal(t, res, addr)
 }
+
+func TestDecodeStoreKey(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+
+	testCases := []struct {
+		name     string
+		key      []byte
+		expected string
+		expErr   bool
+	}{
+		{"supply", append(types.SupplyKey, []byte("stake")...), "Supply(denom=stake)", false},
+		{"denom metadata", append(types.DenomMetadataKey("stake"), []byte("stake")...), "DenomMetadata(denom=stake, base=stake)", false},
+		{"balances", append(types.CreateAccountBalancesPrefix(addr), []byte("stake")...), "Balances(address=" + addr.String() + ", denom=stake)", false},
+		{"balances without address", types.BalancesPrefix, "", true},
+		{"unknown", []byte{0xff}, "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			decoded, err := types.DecodeStoreKey(tc.key)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, decoded.String())
+		})
+	}
+}
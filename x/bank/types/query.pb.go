@@ -670,6 +670,92 @@ func (m *QueryDenomMetadataResponse) GetMetadata() Metadata {
 	return Metadata{}
 }
 
+// QueryReconcileSupplyRequest is the request type for the Query/ReconcileSupply
+// RPC method.
+type QueryReconcileSupplyRequest struct {
+}
+
+func (m *QueryReconcileSupplyRequest) Reset()         { *m = QueryReconcileSupplyRequest{} }
+func (m *QueryReconcileSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReconcileSupplyRequest) ProtoMessage()    {}
+func (*QueryReconcileSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{14}
+}
+func (m *QueryReconcileSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReconcileSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReconcileSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReconcileSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReconcileSupplyRequest.Merge(m, src)
+}
+func (m *QueryReconcileSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReconcileSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReconcileSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReconcileSupplyRequest proto.InternalMessageInfo
+
+// QueryReconcileSupplyResponse is the response type for the
+// Query/ReconcileSupply RPC method.
+type QueryReconcileSupplyResponse struct {
+	// mismatches are the denominations, sorted, whose total supply differs from
+	// the sum of the balances of all accounts.
+	Mismatches []SupplyMismatch `protobuf:"bytes,1,rep,name=mismatches,proto3" json:"mismatches"`
+}
+
+func (m *QueryReconcileSupplyResponse) Reset()         { *m = QueryReconcileSupplyResponse{} }
+func (m *QueryReconcileSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReconcileSupplyResponse) ProtoMessage()    {}
+func (*QueryReconcileSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{15}
+}
+func (m *QueryReconcileSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReconcileSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReconcileSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReconcileSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReconcileSupplyResponse.Merge(m, src)
+}
+func (m *QueryReconcileSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReconcileSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReconcileSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReconcileSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryReconcileSupplyResponse) GetMismatches() []SupplyMismatch {
+	if m != nil {
+		return m.Mismatches
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -685,64 +771,71 @@ func init() {
 	proto.RegisterType((*QueryDenomsMetadataResponse)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataResponse")
 	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataRequest")
 	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataResponse")
+	proto.RegisterType((*QueryReconcileSupplyRequest)(nil), "cosmos.bank.v1beta1.QueryReconcileSupplyRequest")
+	proto.RegisterType((*QueryReconcileSupplyResponse)(nil), "cosmos.bank.v1beta1.QueryReconcileSupplyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 824 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6b, 0x13, 0x5b,
-	0x14, 0xce, 0xed, 0x7b, 0x4d, 0xd3, 0x13, 0xde, 0x5b, 0xdc, 0xe6, 0xf1, 0xd2, 0xe9, 0x6b, 0xf2,
-	0x98, 0x6a, 0x9b, 0xd6, 0x74, 0xa6, 0x69, 0x85, 0xa2, 0x1b, 0x69, 0x2a, 0xba, 0x10, 0x69, 0x8c,
-	0xae, 0x04, 0x91, 0x9b, 0x64, 0x1c, 0x43, 0x93, 0xb9, 0xd3, 0xdc, 0x89, 0x58, 0x4a, 0x51, 0x04,
-	0xc1, 0x95, 0x0a, 0x2e, 0x5c, 0xb8, 0xa9, 0x1b, 0x41, 0x97, 0xfe, 0x15, 0x5d, 0xb8, 0x28, 0xb8,
-	0x71, 0xa5, 0xd2, 0xba, 0xf0, 0xcf, 0x90, 0xdc, 0x1f, 0xd3, 0x49, 0x32, 0x4d, 0x06, 0xd1, 0x55,
-	0x66, 0xee, 0x3d, 0xe7, 0x3b, 0xdf, 0x77, 0xee, 0xb9, 0xdf, 0x04, 0xb2, 0x55, 0xca, 0x9a, 0x94,
-	0x99, 0x15, 0xe2, 0x6c, 0x9a, 0xf7, 0x0a, 0x15, 0xcb, 0x23, 0x05, 0x73, 0xab, 0x6d, 0xb5, 0xb6,
-	0x0d, 0xb7, 0x45, 0x3d, 0x8a, 0x27, 0x44, 0x80, 0xd1, 0x09, 0x30, 0x64, 0x80, 0xb6, 0xe0, 0x67,
-	0x31, 0x4b, 0x44, 0xfb, 0xb9, 0x2e, 0xb1, 0xeb, 0x0e, 0xf1, 0xea, 0xd4, 0x11, 0x00, 0x5a, 0xca,
-	0xa6, 0x36, 0xe5, 0x8f, 0x66, 0xe7, 0x49, 0xae, 0xfe, 0x67, 0x53, 0x6a, 0x37, 0x2c, 0x93, 0xb8,
-	0x75, 0x93, 0x38, 0x0e, 0xf5, 0x78, 0x0a, 0x93, 0xbb, 0x99, 0x20, 0xbe, 0x42, 0xae, 0xd2, 0xba,
-	0xd3, 0xb7, 0x1f, 0x60, 0xcd, 0x19, 0xf2, 0x7d, 0x7d, 0x03, 0x26, 0xae, 0x75, 0x58, 0x15, 0x49,
-	0x83, 0x38, 0x55, 0xab, 0x6c, 0x6d, 0xb5, 0x2d, 0xe6, 0xe1, 0x34, 0x8c, 0x91, 0x5a, 0xad, 0x65,
-	0x31, 0x96, 0x46, 0xff, 0xa3, 0xdc, 0x78, 0x59, 0xbd, 0xe2, 0x14, 0x8c, 0xd6, 0x2c, 0x87, 0x36,
-	0xd3, 0x23, 0x7c, 0x5d, 0xbc, 0x9c, 0x4f, 0x3c, 0xd9, 0xcb, 0xc6, 0xbe, 0xef, 0x65, 0x63, 0xfa,
-	0x15, 0x48, 0x75, 0x03, 0x32, 0x97, 0x3a, 0xcc, 0xc2, 0x2b, 0x30, 0x56, 0x11, 0x4b, 0x1c, 0x31,
-	0xb9, 0x3c, 0x69, 0xf8, 0xfd, 0x62, 0x96, 0xea, 0x97, 0xb1, 0x4e, 0xeb, 0x4e, 0x59, 0x45, 0xea,
-	0x8f, 0x11, 0xfc, 0xcb, 0xd1, 0xd6, 0x1a, 0x0d, 0x09, 0xc8, 0x86, 0x53, 0xbc, 0x04, 0x70, 0xdc,
-	0x5b, 0xce, 0x33, 0xb9, 0x3c, 0xdb, 0x55, 0x4d, 0x1c, 0x9b, 0xaa, 0x59, 0x22, 0xb6, 0x12, 0x5e,
-	0x0e, 0x64, 0x06, 0x44, 0x7d, 0x40, 0x90, 0xee, 0xe7, 0x21, 0x95, 0xd9, 0x90, 0x90, 0x7c, 0x3b,
-	0x4c, 0xfe, 0x18, 0x28, 0xad, 0xb8, 0xb4, 0xff, 0x39, 0x1b, 0x7b, 0xf7, 0x25, 0x9b, 0xb3, 0xeb,
-	0xde, 0xdd, 0x76, 0xc5, 0xa8, 0xd2, 0xa6, 0x29, 0x8f, 0x48, 0xfc, 0x2c, 0xb2, 0xda, 0xa6, 0xe9,
-	0x6d, 0xbb, 0x16, 0xe3, 0x09, 0xac, 0xec, 0x83, 0xe3, 0xcb, 0x21, 0xba, 0xe6, 0x86, 0xea, 0x12,
-	0x2c, 0x83, 0xc2, 0xf4, 0x49, 0xd9, 0xd5, 0x1b, 0xd4, 0x23, 0x8d, 0xeb, 0x6d, 0xd7, 0x6d, 0x6c,
-	0x4b, 0xfd, 0xfa, 0x03, 0x29, 0xb4, 0x6b, 0x4b, 0x0a, 0xad, 0x42, 0x9c, 0xf1, 0x95, 0xdf, 0x21,
-	0x53, 0x42, 0xeb, 0x79, 0x39, 0x3f, 0xa2, 0xf6, 0xc6, 0x1d, 0x75, 0xdc, 0xfe, 0xdc, 0xa1, 0xc0,
-	0xdc, 0xe9, 0x25, 0xf8, 0xa7, 0x27, 0x5a, 0x72, 0x5d, 0x85, 0x38, 0x69, 0xd2, 0xb6, 0xe3, 0x0d,
-	0x9d, 0xb6, 0xe2, 0x9f, 0x1d, 0xae, 0x65, 0x19, 0xae, 0xa7, 0x00, 0x73, 0xc4, 0x12, 0x69, 0x91,
-	0xa6, 0x1a, 0x36, 0xbd, 0x24, 0xaf, 0x89, 0x5a, 0x95, 0x55, 0xce, 0x41, 0xdc, 0xe5, 0x2b, 0xb2,
-	0xca, 0x94, 0x11, 0xe2, 0x01, 0x86, 0x48, 0x52, 0x75, 0x44, 0x82, 0x5e, 0x03, 0x8d, 0x23, 0x5e,
-	0xec, 0xe8, 0x60, 0x57, 0x2d, 0x8f, 0xd4, 0x88, 0x47, 0x94, 0xda, 0xee, 0x11, 0x46, 0x3f, 0x3b,
-	0xc2, 0xfa, 0x5b, 0x04, 0x53, 0xa1, 0x65, 0xa4, 0x80, 0x35, 0x18, 0x6f, 0xca, 0x35, 0x35, 0xbc,
-	0xd3, 0xa1, 0x1a, 0x54, 0xa6, 0x54, 0x71, 0x9c, 0xf5, 0xeb, 0xa6, 0xb2, 0x00, 0x93, 0xc7, 0x54,
-	0x7b, 0x1b, 0x12, 0x7e, 0xfc, 0xb7, 0x82, 0x4d, 0xec, 0x13, 0x77, 0x01, 0x12, 0x8a, 0xa6, 0x6c,
-	0x61, 0x24, 0x6d, 0x7e, 0xd2, 0xf2, 0xfb, 0x04, 0x8c, 0x72, 0x7c, 0xfc, 0x12, 0xc1, 0x98, 0xbc,
-	0xf8, 0x38, 0x17, 0x0a, 0x12, 0xe2, 0xa2, 0xda, 0x7c, 0x84, 0x48, 0xc1, 0x55, 0x5f, 0x7d, 0xf4,
-	0xf1, 0xdb, 0x8b, 0x91, 0x02, 0x36, 0xcd, 0x70, 0xc3, 0x16, 0x16, 0x60, 0xee, 0x48, 0x8f, 0xdb,
-	0x35, 0x77, 0x78, 0x07, 0x76, 0xf1, 0x2b, 0x04, 0xc9, 0x80, 0x2b, 0xe1, 0xfc, 0xc9, 0x35, 0xfb,
-	0x4d, 0x54, 0x5b, 0x8c, 0x18, 0x2d, 0x59, 0x9a, 0x9c, 0xe5, 0x3c, 0x9e, 0x8b, 0xc8, 0x12, 0x3f,
-	0x43, 0x90, 0x0c, 0x58, 0xc9, 0x20, 0x76, 0xfd, 0x66, 0x34, 0x88, 0x5d, 0x88, 0x3f, 0xe9, 0x33,
-	0x9c, 0xdd, 0x34, 0x9e, 0x0a, 0x65, 0x27, 0xfc, 0x05, 0x3f, 0x45, 0x90, 0x50, 0x6e, 0x81, 0x07,
-	0x1c, 0x50, 0x8f, 0xff, 0x68, 0x0b, 0x51, 0x42, 0x25, 0x91, 0x33, 0x9c, 0xc8, 0x69, 0x3c, 0x33,
-	0x80, 0x88, 0x7f, 0x80, 0x0f, 0x11, 0xc4, 0x85, 0x43, 0xe0, 0xb9, 0x93, 0x6b, 0x74, 0xd9, 0x91,
-	0x96, 0x1b, 0x1e, 0x18, 0xa9, 0x27, 0xc2, 0x8b, 0xf0, 0x1b, 0x04, 0x7f, 0x75, 0x5d, 0x21, 0x6c,
-	0x9c, 0x5c, 0x20, 0xec, 0x7a, 0x6a, 0x66, 0xe4, 0x78, 0xc9, 0xeb, 0x2c, 0xe7, 0x65, 0xe0, 0x7c,
-	0x28, 0x2f, 0xde, 0x1a, 0x76, 0x5b, 0x5d, 0x44, 0xbf, 0x57, 0xaf, 0x11, 0xfc, 0xdd, 0xed, 0x64,
-	0x78, 0x58, 0xe5, 0x5e, 0x6b, 0xd5, 0x96, 0xa2, 0x27, 0x48, 0xae, 0x79, 0xce, 0x75, 0x16, 0x9f,
-	0x8a, 0xc2, 0xb5, 0xb8, 0xbe, 0x7f, 0x98, 0x41, 0x07, 0x87, 0x19, 0xf4, 0xf5, 0x30, 0x83, 0x9e,
-	0x1f, 0x65, 0x62, 0x07, 0x47, 0x99, 0xd8, 0xa7, 0xa3, 0x4c, 0xec, 0xe6, 0xfc, 0xc0, 0x8f, 0xe1,
-	0x7d, 0x01, 0xcb, 0xbf, 0x89, 0x95, 0x38, 0xff, 0x77, 0xb6, 0xf2, 0x23, 0x00, 0x00, 0xff, 0xff,
-	0xb4, 0xd4, 0xb0, 0xfc, 0x75, 0x0a, 0x00, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x85, 0x3a, 0xc9, 0x8b, 0x00, 0x69, 0x12, 0x84, 0xb3, 0x69, 0x6c, 0xb4, 0xa1,
+	0x8d, 0x53, 0xec, 0xdd, 0x3a, 0x45, 0xaa, 0xe0, 0x82, 0xea, 0x22, 0x10, 0x42, 0x55, 0x8d, 0xe1,
+	0x84, 0x84, 0xaa, 0xf1, 0x7a, 0xd9, 0xae, 0xea, 0xdd, 0xd9, 0x7a, 0xd6, 0x88, 0xa8, 0xaa, 0x40,
+	0x48, 0x48, 0x9c, 0x00, 0x89, 0x03, 0x07, 0x2e, 0xe5, 0x00, 0x12, 0xfc, 0x25, 0x3d, 0x70, 0x88,
+	0xc4, 0x05, 0x71, 0x00, 0x94, 0x70, 0xe0, 0xcf, 0x40, 0x9e, 0x79, 0xb3, 0xd9, 0xb5, 0x27, 0xf6,
+	0x82, 0xe8, 0x29, 0xf6, 0xec, 0xfb, 0xf1, 0xf9, 0xbe, 0x79, 0xfe, 0x66, 0xa1, 0xe1, 0x71, 0x11,
+	0x71, 0xe1, 0x0e, 0x58, 0x7c, 0xd7, 0xfd, 0xb0, 0x33, 0xf0, 0x53, 0xd6, 0x71, 0xef, 0x4d, 0xfc,
+	0xf1, 0xa1, 0x93, 0x8c, 0x79, 0xca, 0xe9, 0x86, 0x0a, 0x70, 0xa6, 0x01, 0x0e, 0x06, 0x58, 0x97,
+	0xb3, 0x2c, 0xe1, 0xab, 0xe8, 0x2c, 0x37, 0x61, 0x41, 0x18, 0xb3, 0x34, 0xe4, 0xb1, 0x2a, 0x60,
+	0x6d, 0x06, 0x3c, 0xe0, 0xf2, 0xa3, 0x3b, 0xfd, 0x84, 0xa7, 0x17, 0x02, 0xce, 0x83, 0x91, 0xef,
+	0xb2, 0x24, 0x74, 0x59, 0x1c, 0xf3, 0x54, 0xa6, 0x08, 0x7c, 0x5a, 0xcf, 0xd7, 0xd7, 0x95, 0x3d,
+	0x1e, 0xc6, 0x73, 0xcf, 0x73, 0xd4, 0xd3, 0x2f, 0xea, 0xb9, 0x7d, 0x0b, 0x36, 0xde, 0x9e, 0x52,
+	0x75, 0xd9, 0x88, 0xc5, 0x9e, 0xdf, 0xf7, 0xef, 0x4d, 0x7c, 0x91, 0xd2, 0x1a, 0xac, 0xb0, 0xe1,
+	0x70, 0xec, 0x0b, 0x51, 0x23, 0xcf, 0x93, 0xe6, 0x5a, 0x5f, 0x7f, 0xa5, 0x9b, 0x70, 0x7e, 0xe8,
+	0xc7, 0x3c, 0xaa, 0x9d, 0x93, 0xe7, 0xea, 0xcb, 0x2b, 0xab, 0x9f, 0x3f, 0x6c, 0x54, 0xfe, 0x7e,
+	0xd8, 0xa8, 0xd8, 0x6f, 0xc1, 0x66, 0xb1, 0xa0, 0x48, 0x78, 0x2c, 0x7c, 0x7a, 0x15, 0x56, 0x06,
+	0xea, 0x48, 0x56, 0x5c, 0x3f, 0xd8, 0x72, 0xb2, 0x79, 0x09, 0x5f, 0xcf, 0xcb, 0xb9, 0xc1, 0xc3,
+	0xb8, 0xaf, 0x23, 0xed, 0xcf, 0x08, 0x3c, 0x27, 0xab, 0x5d, 0x1f, 0x8d, 0xb0, 0xa0, 0x58, 0x8e,
+	0xf8, 0x3a, 0xc0, 0xe9, 0x6c, 0x25, 0xe7, 0xfa, 0xc1, 0xa5, 0x42, 0x37, 0x75, 0x6d, 0xba, 0x67,
+	0x8f, 0x05, 0x5a, 0x78, 0x3f, 0x97, 0x99, 0x13, 0xf5, 0x33, 0x81, 0xda, 0x3c, 0x07, 0x2a, 0x0b,
+	0x60, 0x15, 0x79, 0xa7, 0x24, 0x4f, 0x2c, 0x94, 0xd6, 0xbd, 0xf2, 0xe8, 0xf7, 0x46, 0xe5, 0xa7,
+	0x3f, 0x1a, 0xcd, 0x20, 0x4c, 0xef, 0x4c, 0x06, 0x8e, 0xc7, 0x23, 0x17, 0xaf, 0x48, 0xfd, 0x69,
+	0x8b, 0xe1, 0x5d, 0x37, 0x3d, 0x4c, 0x7c, 0x21, 0x13, 0x44, 0x3f, 0x2b, 0x4e, 0xdf, 0x30, 0xe8,
+	0xda, 0x5b, 0xaa, 0x4b, 0x51, 0xe6, 0x85, 0xd9, 0x5b, 0x38, 0xd5, 0x77, 0x79, 0xca, 0x46, 0xef,
+	0x4c, 0x92, 0x64, 0x74, 0x88, 0xfa, 0xed, 0x8f, 0xa1, 0x36, 0xff, 0x08, 0x85, 0x7a, 0x50, 0x15,
+	0xf2, 0xe4, 0x71, 0xc8, 0xc4, 0xd2, 0x76, 0x0b, 0xf7, 0x47, 0xf5, 0xbe, 0xf5, 0x81, 0xbe, 0xee,
+	0x6c, 0xef, 0x48, 0x6e, 0xef, 0xec, 0x1e, 0x3c, 0x3b, 0x13, 0x8d, 0xac, 0xd7, 0xa0, 0xca, 0x22,
+	0x3e, 0x89, 0xd3, 0xa5, 0xdb, 0xd6, 0x7d, 0x72, 0xca, 0xda, 0xc7, 0x70, 0x7b, 0x13, 0xa8, 0xac,
+	0xd8, 0x63, 0x63, 0x16, 0xe9, 0x65, 0xb3, 0x7b, 0xb0, 0x51, 0x38, 0xc5, 0x2e, 0x2f, 0x43, 0x35,
+	0x91, 0x27, 0xd8, 0x65, 0xdb, 0x31, 0x78, 0x80, 0xa3, 0x92, 0x74, 0x1f, 0x95, 0x60, 0x0f, 0xc1,
+	0x92, 0x15, 0x5f, 0x9b, 0xea, 0x10, 0x37, 0xfd, 0x94, 0x0d, 0x59, 0xca, 0xb4, 0xda, 0xe2, 0x0a,
+	0x93, 0xff, 0xba, 0xc2, 0xf6, 0x8f, 0x04, 0xb6, 0x8d, 0x6d, 0x50, 0xc0, 0x75, 0x58, 0x8b, 0xf0,
+	0x4c, 0x2f, 0xef, 0x8e, 0x51, 0x83, 0xce, 0x44, 0x15, 0xa7, 0x59, 0xff, 0xdf, 0x56, 0x76, 0x60,
+	0xeb, 0x14, 0x75, 0x76, 0x20, 0xe6, 0xeb, 0x7f, 0x1f, 0x2c, 0x53, 0x0a, 0x8a, 0x7b, 0x15, 0x56,
+	0x35, 0x26, 0x8e, 0xb0, 0x94, 0xb6, 0x2c, 0xc9, 0xde, 0xc1, 0xe1, 0xf5, 0x7d, 0x8f, 0xc7, 0x5e,
+	0x38, 0xf2, 0x8b, 0xbf, 0x95, 0x10, 0x2e, 0x98, 0x1f, 0x63, 0xff, 0x37, 0x01, 0xa2, 0x50, 0x44,
+	0x2c, 0xf5, 0xee, 0x64, 0xd6, 0xb0, 0x6b, 0x24, 0x50, 0x89, 0x37, 0x31, 0x18, 0x39, 0x72, 0xc9,
+	0x07, 0xbf, 0xad, 0xc1, 0x79, 0xd9, 0x8b, 0x7e, 0x43, 0x60, 0x05, 0x2d, 0x88, 0x36, 0x8d, 0xc5,
+	0x0c, 0x7e, 0x6e, 0xed, 0x97, 0x88, 0x54, 0xd4, 0xf6, 0xb5, 0x4f, 0x7f, 0xf9, 0xeb, 0xeb, 0x73,
+	0x1d, 0xea, 0xba, 0xe6, 0x7f, 0x1d, 0x32, 0x5a, 0xb8, 0xf7, 0xd1, 0x6d, 0x1f, 0xb8, 0xf7, 0xe5,
+	0x5d, 0x3c, 0xa0, 0xdf, 0x12, 0x58, 0xcf, 0xf9, 0x23, 0x6d, 0x9d, 0xdd, 0x73, 0xde, 0xce, 0xad,
+	0x76, 0xc9, 0x68, 0xa4, 0x74, 0x25, 0xe5, 0x3e, 0xdd, 0x2b, 0x49, 0x49, 0xbf, 0x24, 0xb0, 0x9e,
+	0x33, 0xb5, 0x45, 0x74, 0xf3, 0xb6, 0x68, 0xb5, 0x4b, 0x46, 0x23, 0xdd, 0xae, 0xa4, 0xdb, 0xa1,
+	0xdb, 0x46, 0x3a, 0xe5, 0x74, 0xf4, 0x0b, 0x02, 0xab, 0xda, 0xb7, 0xe8, 0x82, 0x0b, 0x9a, 0x71,
+	0x42, 0xeb, 0x72, 0x99, 0x50, 0x04, 0x79, 0x51, 0x82, 0x5c, 0xa4, 0xbb, 0x0b, 0x40, 0xb2, 0x0b,
+	0xfc, 0x84, 0x40, 0x55, 0x79, 0x15, 0xdd, 0x3b, 0xbb, 0x47, 0xc1, 0x18, 0xad, 0xe6, 0xf2, 0xc0,
+	0x52, 0x33, 0x51, 0xae, 0x48, 0x7f, 0x20, 0xf0, 0x54, 0xe1, 0xc7, 0x4c, 0x9d, 0xb3, 0x1b, 0x98,
+	0x8c, 0xc2, 0x72, 0x4b, 0xc7, 0x23, 0xd7, 0x4b, 0x92, 0xcb, 0xa1, 0x2d, 0x23, 0x97, 0x1c, 0x8d,
+	0xb8, 0xad, 0x2d, 0x21, 0x9b, 0xd5, 0x77, 0x04, 0x9e, 0x2e, 0x7a, 0x2a, 0x5d, 0xd6, 0x79, 0xd6,
+	0xe4, 0xad, 0x2b, 0xe5, 0x13, 0x90, 0xb5, 0x25, 0x59, 0x2f, 0xd1, 0x17, 0xca, 0xb0, 0xd2, 0xef,
+	0x09, 0x3c, 0x33, 0xe3, 0x4d, 0x74, 0x41, 0x4f, 0xb3, 0xcb, 0x59, 0x9d, 0x7f, 0x91, 0x81, 0x98,
+	0x6d, 0x89, 0xb9, 0x47, 0x2f, 0x1a, 0x31, 0xc7, 0x3a, 0xeb, 0xb6, 0xda, 0xbf, 0xee, 0x8d, 0x47,
+	0xc7, 0x75, 0x72, 0x74, 0x5c, 0x27, 0x7f, 0x1e, 0xd7, 0xc9, 0x57, 0x27, 0xf5, 0xca, 0xd1, 0x49,
+	0xbd, 0xf2, 0xeb, 0x49, 0xbd, 0xf2, 0xde, 0xfe, 0xc2, 0xd7, 0x87, 0x8f, 0x54, 0x5d, 0xf9, 0x16,
+	0x31, 0xa8, 0xca, 0xf7, 0xd9, 0xab, 0xff, 0x0c, 0x00, 0x21, 0x6c, 0x1e, 0x18, 0xa7, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -771,6 +864,9 @@ type QueryClient interface {
 	DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error)
 	// DenomsMetadata queries the client metadata for all registered coin denominations.
 	DenomsMetadata(ctx context.Context, in *QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataResponse, error)
+	// ReconcileSupply compares the total supply with the sum of the balances of
+	// all accounts and returns the mismatching denominations.
+	ReconcileSupply(ctx context.Context, in *QueryReconcileSupplyRequest, opts ...grpc.CallOption) (*QueryReconcileSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -844,6 +940,15 @@ func (c *queryClient) DenomsMetadata(ctx context.Context, in *QueryDenomsMetadat
 	return out, nil
 }
 
+func (c *queryClient) ReconcileSupply(ctx context.Context, in *QueryReconcileSupplyRequest, opts ...grpc.CallOption) (*QueryReconcileSupplyResponse, error) {
+	out := new(QueryReconcileSupplyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/ReconcileSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -860,6 +965,9 @@ type QueryServer interface {
 	DenomMetadata(context.Context, *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error)
 	// DenomsMetadata queries the client metadata for all registered coin denominations.
 	DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error)
+	// ReconcileSupply compares the total supply with the sum of the balances of
+	// all accounts and returns the mismatching denominations.
+	ReconcileSupply(context.Context, *QueryReconcileSupplyRequest) (*QueryReconcileSupplyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -887,6 +995,9 @@ func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDe
 func (*UnimplementedQueryServer) DenomsMetadata(ctx context.Context, req *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadata not implemented")
 }
+func (*UnimplementedQueryServer) ReconcileSupply(ctx context.Context, req *QueryReconcileSupplyRequest) (*QueryReconcileSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileSupply not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1018,6 +1129,24 @@ func _Query_DenomsMetadata_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ReconcileSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReconcileSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReconcileSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/ReconcileSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReconcileSupply(ctx, req.(*QueryReconcileSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1050,6 +1179,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsMetadata",
 			Handler:    _Query_DenomsMetadata_Handler,
 		},
+		{
+			MethodName: "ReconcileSupply",
+			Handler:    _Query_ReconcileSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -1544,6 +1677,66 @@ func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryReconcileSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReconcileSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReconcileSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReconcileSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReconcileSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReconcileSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mismatches) > 0 {
+		for iNdEx := len(m.Mismatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mismatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1745,6 +1938,30 @@ func (m *QueryDenomMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryReconcileSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReconcileSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mismatches) > 0 {
+		for _, e := range m.Mismatches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2992,6 +3209,140 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryReconcileSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReconcileSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReconcileSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReconcileSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReconcileSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReconcileSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mismatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mismatches = append(m.Mismatches, SupplyMismatch{})
+			if err := m.Mismatches[len(m.Mismatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Difference returns the tracked supply minus the sum of the balances.
+func (m SupplyMismatch) Difference() sdk.Int {
+	return m.Supply.Sub(m.Balances)
+}
+
+// String implements the Stringer interface.
+func (m SupplyMismatch) String() string {
+	return fmt.Sprintf(
+		"%s: supply %s, sum of balances %s (module accounts %s), difference %s",
+		m.Denom, m.Supply, m.Balances, m.ModuleBalances, m.Difference(),
+	)
+}
+
+// SupplyReconciliation reconciles the tracked total supply with the balances
+// of all accounts, per denomination.
+type SupplyReconciliation struct {
+	supply         map[string]sdk.Int
+	balances       map[string]sdk.Int
+	moduleBalances map[string]sdk.Int
+}
+
+// NewSupplyReconciliation returns a SupplyReconciliation of the given total
+// supply.
+func NewSupplyReconciliation(supply sdk.Coins) SupplyReconciliation {
+	r := SupplyReconciliation{
+		supply:         make(map[string]sdk.Int),
+		balances:       make(map[string]sdk.Int),
+		moduleBalances: make(map[string]sdk.Int),
+	}
+
+	for _, coin := range supply {
+		addAmount(r.supply, coin)
+	}
+
+	return r
+}
+
+// AddBalance adds the balance of an account, module accounts included.
+func (r SupplyReconciliation) AddBalance(balance sdk.Coin) {
+	addAmount(r.balances, balance)
+}
+
+// AddModuleBalance records the balance of a module account, which must also
+// be added through AddBalance. Module balances are only reported.
+func (r SupplyReconciliation) AddModuleBalance(balance sdk.Coin) {
+	addAmount(r.moduleBalances, balance)
+}
+
+// Mismatches returns the denominations, sorted, whose supply differs from the
+// sum of the balances.
+func (r SupplyReconciliation) Mismatches() []SupplyMismatch {
+	denoms := make(map[string]struct{}, len(r.supply))
+	for denom := range r.supply {
+		denoms[denom] = struct{}{}
+	}
+
+	for denom := range r.balances {
+		denoms[denom] = struct{}{}
+	}
+
+	var mismatches []SupplyMismatch
+	for denom := range denoms {
+		m := SupplyMismatch{
+			Denom:          denom,
+			Supply:         getAmount(r.supply, denom),
+			Balances:       getAmount(r.balances, denom),
+			ModuleBalances: getAmount(r.moduleBalances, denom),
+		}
+
+		if !m.Supply.Equal(m.Balances) {
+			mismatches = append(mismatches, m)
+		}
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool {
+		return mismatches[i].Denom < mismatches[j].Denom
+	})
+
+	return mismatches
+}
+
+func addAmount(amounts map[string]sdk.Int, coin sdk.Coin) {
+	amounts[coin.Denom] = getAmount(amounts, coin.Denom).Add(coin.Amount)
+}
+
+func getAmount(amounts map[string]sdk.Int, denom string) sdk.Int {
+	if amount, ok := amounts[denom]; ok {
+		return amount
+	}
+
+	return sdk.ZeroInt()
+}
@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSupplyReconciliation(t *testing.T) {
+	reconciliation := types.NewSupplyReconciliation(sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 50)))
+	reconciliation.AddBalance(sdk.NewInt64Coin("atom", 60))
+	reconciliation.AddBalance(sdk.NewInt64Coin("atom", 40))
+	reconciliation.AddBalance(sdk.NewInt64Coin("stake", 30))
+	reconciliation.AddModuleBalance(sdk.NewInt64Coin("stake", 30))
+	reconciliation.AddBalance(sdk.NewInt64Coin("foo", 5))
+
+	mismatches := reconciliation.Mismatches()
+	require.Equal(t, []types.SupplyMismatch{
+		{Denom: "foo", Supply: sdk.ZeroInt(), Balances: sdk.NewInt(5), ModuleBalances: sdk.ZeroInt()},
+		{Denom: "stake", Supply: sdk.NewInt(50), Balances: sdk.NewInt(30), ModuleBalances: sdk.NewInt(30)},
+	}, mismatches)
+	require.Equal(t, sdk.NewInt(-5), mismatches[0].Difference())
+	require.Equal(t, "stake: supply 50, sum of balances 30 (module accounts 30), difference 20", mismatches[1].String())
+
+	require.Empty(t, types.NewSupplyReconciliation(sdk.NewCoins()).Mismatches())
+}
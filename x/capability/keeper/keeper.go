@@ -89,15 +89,28 @@ func (k *Keeper) ScopeToModule(moduleName string) ScopedKeeper {
 	}
 }
 
-// InitializeAndSeal loads all capabilities from the persistent KVStore into the
-// in-memory store and seals the keeper to prevent further modules from creating
-// a scoped keeper. InitializeAndSeal must be called once after the application
-// state is loaded.
-func (k *Keeper) InitializeAndSeal(ctx sdk.Context) {
+// Seal seals the keeper to prevent further modules from creating a scoped
+// keeper. Seal must be called once all the scoped keepers of the application
+// have been created.
+func (k *Keeper) Seal() {
 	if k.sealed {
-		panic("cannot initialize and seal an already sealed capability keeper")
+		panic("cannot seal an already sealed capability keeper")
 	}
 
+	k.sealed = true
+}
+
+// IsSealed returns whether the keeper is sealed.
+func (k Keeper) IsSealed() bool {
+	return k.sealed
+}
+
+// InitMemStore loads all capabilities from the persistent KVStore into the
+// in-memory store, unless it is already initialized. It must be called in the
+// first BeginBlock after the application starts, or after its state is restored
+// from a snapshot, so that in-memory capabilities are regenerated before
+// modules use them. It is a no-op in the following blocks.
+func (k Keeper) InitMemStore(ctx sdk.Context) {
 	memStore := ctx.KVStore(k.memKey)
 	memStoreType := memStore.GetStoreType()
 
@@ -105,7 +118,14 @@ func (k *Keeper) InitializeAndSeal(ctx sdk.Context) {
 		panic(fmt.Sprintf("invalid memory store type; got %s, expected: %s", memStoreType, sdk.StoreTypeMemory))
 	}
 
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+	// initialization is local to the node, it must not consume gas
+	noGasCtx := ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()).WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	if k.IsInitialized(noGasCtx) {
+		return
+	}
+
+	prefixStore := prefix.NewStore(noGasCtx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
 	iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
 
 	// initialize the in-memory store for all persisted capabilities
@@ -117,10 +137,17 @@ func (k *Keeper) InitializeAndSeal(ctx sdk.Context) {
 		var capOwners types.CapabilityOwners
 
 		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &capOwners)
-		k.InitializeCapability(ctx, index, capOwners)
+		k.InitializeCapability(noGasCtx, index, capOwners)
 	}
 
-	k.sealed = true
+	// flag the in-memory store as initialized so it is not initialized again
+	noGasCtx.KVStore(k.memKey).Set(types.KeyMemInitialized, []byte{1})
+}
+
+// IsInitialized returns whether the in-memory store was initialized by
+// InitMemStore.
+func (k Keeper) IsInitialized(ctx sdk.Context) bool {
+	return ctx.KVStore(k.memKey).Has(types.KeyMemInitialized)
 }
 
 // InitializeIndex sets the index to one (or greater) in InitChain according
@@ -173,12 +200,17 @@ func (k Keeper) GetOwners(ctx sdk.Context, index uint64) (types.CapabilityOwners
 
 // InitializeCapability takes in an index and an owners array. It creates the capability in memory
 // and sets the fwd and reverse keys for each owner in the memstore.
-// It is used during initialization from genesis.
+// It is used during initialization from genesis and from the persistent store.
+// The in-memory capability of the index is reused if it exists, so that the
+// capabilities held by modules remain valid.
 func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types.CapabilityOwners) {
 
 	memStore := ctx.KVStore(k.memKey)
 
-	cap := types.NewCapability(index)
+	cap, ok := k.capMap[index]
+	if !ok {
+		cap = types.NewCapability(index)
+	}
 	for _, owner := range owners.Owners {
 		// Set the forward mapping between the module and capability tuple and the
 		// capability name in the memKVStore
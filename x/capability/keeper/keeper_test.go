@@ -36,7 +36,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.keeper = keeper
 }
 
-func (suite *KeeperTestSuite) TestInitializeAndSeal() {
+func (suite *KeeperTestSuite) TestSeal() {
 	sk := suite.keeper.ScopeToModule(banktypes.ModuleName)
 	suite.Require().Panics(func() {
 		suite.keeper.ScopeToModule("  ")
@@ -55,9 +55,11 @@ func (suite *KeeperTestSuite) TestInitializeAndSeal() {
 		caps[i] = cap
 	}
 
+	suite.Require().False(suite.keeper.IsSealed())
 	suite.Require().NotPanics(func() {
-		suite.keeper.InitializeAndSeal(suite.ctx)
+		suite.keeper.Seal()
 	})
+	suite.Require().True(suite.keeper.IsSealed())
 
 	for i, cap := range caps {
 		got, ok := sk.GetCapability(suite.ctx, fmt.Sprintf("transfer-%d", i))
@@ -67,7 +69,7 @@ func (suite *KeeperTestSuite) TestInitializeAndSeal() {
 	}
 
 	suite.Require().Panics(func() {
-		suite.keeper.InitializeAndSeal(suite.ctx)
+		suite.keeper.Seal()
 	})
 
 	suite.Require().Panics(func() {
@@ -75,6 +77,64 @@ func (suite *KeeperTestSuite) TestInitializeAndSeal() {
 	})
 }
 
+func (suite *KeeperTestSuite) TestInitMemStore() {
+	sk := suite.keeper.ScopeToModule(banktypes.ModuleName)
+
+	caps := make([]*types.Capability, 5)
+	for i := range caps {
+		cap, err := sk.NewCapability(suite.ctx, fmt.Sprintf("transfer-%d", i))
+		suite.Require().NoError(err)
+
+		caps[i] = cap
+	}
+
+	// simulate a restart: the in-memory store and capabilities are lost
+	memKey := suite.app.GetMemKey(types.MemStoreKey)
+	memStore := suite.ctx.KVStore(memKey)
+	iterator := memStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		memStore.Delete(key)
+	}
+
+	restarted := keeper.NewKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), memKey)
+	sk = restarted.ScopeToModule(banktypes.ModuleName)
+	restarted.Seal()
+
+	suite.Require().False(restarted.IsInitialized(suite.ctx))
+	_, ok := sk.GetCapability(suite.ctx, "transfer-0")
+	suite.Require().False(ok)
+
+	// initialization must not consume gas
+	ctx := suite.ctx.WithBlockGasMeter(sdk.NewGasMeter(50)).WithGasMeter(sdk.NewGasMeter(50))
+	suite.Require().NotPanics(func() {
+		restarted.InitMemStore(ctx)
+	})
+	suite.Require().True(restarted.IsInitialized(suite.ctx))
+	suite.Require().Equal(uint64(0), ctx.GasMeter().GasConsumed())
+
+	got := make([]*types.Capability, len(caps))
+	for i, cap := range caps {
+		var ok bool
+		got[i], ok = sk.GetCapability(suite.ctx, fmt.Sprintf("transfer-%d", i))
+		suite.Require().True(ok)
+		suite.Require().Equal(cap.GetIndex(), got[i].GetIndex())
+		suite.Require().True(sk.AuthenticateCapability(suite.ctx, got[i], fmt.Sprintf("transfer-%d", i)))
+	}
+
+	// the in-memory store is only initialized once
+	restarted.InitMemStore(suite.ctx)
+	for i := range caps {
+		cap, ok := sk.GetCapability(suite.ctx, fmt.Sprintf("transfer-%d", i))
+		suite.Require().True(ok)
+		suite.Require().True(cap == got[i], "expected memory addresses to be equal")
+	}
+}
+
 func (suite *KeeperTestSuite) TestNewCapability() {
 	sk := suite.keeper.ScopeToModule(banktypes.ModuleName)
 
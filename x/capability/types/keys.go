@@ -25,6 +25,10 @@ var (
 	// KeyPrefixIndexCapability defines a key prefix that stores index to capability
 	// name mappings.
 	KeyPrefixIndexCapability = []byte("capability_index")
+
+	// KeyMemInitialized defines the key that flags whether the in-memory store
+	// has been initialized from the persistent store.
+	KeyMemInitialized = []byte("mem_initialized")
 )
 
 // RevCapabilityKey returns a reverse lookup key for a given module and capability
@@ -0,0 +1,29 @@
+package delay
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/delay/keeper"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+// EndBlocker executes the delayed executions whose execute time has come, up
+// to MaxExecutionsPerBlock of them. The others remain queued for the following
+// blocks.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	// collect the due executions first, as executing messages may write to the
+	// delay queue, e.g. to delay messages again
+	var due []types.DelayedExecution
+	k.IterateDelayQueue(ctx, ctx.BlockTime(), func(de types.DelayedExecution) bool {
+		due = append(due, de)
+		return len(due) >= types.MaxExecutionsPerBlock
+	})
+
+	for _, de := range due {
+		k.ExecuteDelayed(ctx, de)
+	}
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	delayQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the delay module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	delayQueryCmd.AddCommand(
+		GetCmdQueryDelayedExecution(),
+		GetCmdQueryDelayedExecutions(),
+	)
+
+	return delayQueryCmd
+}
+
+// GetCmdQueryDelayedExecution implements the query delayed-execution command.
+func GetCmdQueryDelayedExecution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delayed-execution [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query a delayed execution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the messages of a delayed execution and their execute time:
+Example:
+$ %s query %s delayed-execution 1
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id %s not a valid uint, please input a valid id", args[0])
+			}
+
+			res, err := queryClient.DelayedExecution(
+				context.Background(),
+				&types.QueryDelayedExecutionRequest{Id: id},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.DelayedExecution)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDelayedExecutions implements the query delayed-executions command.
+func GetCmdQueryDelayedExecutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delayed-executions",
+		Args:  cobra.NoArgs,
+		Short: "query all the pending delayed executions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the pending delayed executions:
+Example:
+$ %s query %s delayed-executions
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelayedExecutions(
+				context.Background(),
+				&types.QueryDelayedExecutionsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delayed-executions")
+	return cmd
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+// flag for delay transactions
+const (
+	FlagExecutionGas = "execution-gas"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	delayTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Delay transactions subcommands",
+		Long:                       "Submit messages for execution after a delay, and cancel them until then",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	delayTxCmd.AddCommand(
+		NewCmdDelay(),
+		NewCmdCancelDelayed(),
+	)
+
+	return delayTxCmd
+}
+
+// NewCmdDelay returns a CLI command handler for creating a MsgDelay transaction.
+func NewCmdDelay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit [msg_tx_json_file] [delay] --from [sender]",
+		Short: "submit the messages of a tx for execution after a delay",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`submit the messages of a tx, signed by the sender, for execution at the end
+of the first block past the delay. The gas the execution may consume is charged
+with the submission, and defaults to the gas limit of the tx:
+Example:
+ $ %s tx bank send <sender> <recipient> 1000stake --from <sender> --chain-id <chain-id> --generate-only > tx.json && %s tx %s submit tx.json 24h --from <sender>
+			`, version.AppName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline {
+				return errors.New("cannot broadcast tx during offline mode")
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			delay, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msgs := theTx.GetMsgs()
+			serviceMsgs := make([]sdk.ServiceMsg, len(msgs))
+			for i, msg := range msgs {
+				srvMsg, ok := msg.(sdk.ServiceMsg)
+				if !ok {
+					return fmt.Errorf("tx contains %T which is not a sdk.ServiceMsg", msg)
+				}
+				serviceMsgs[i] = srvMsg
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(FlagExecutionGas)
+			if err != nil {
+				return err
+			}
+
+			if gasLimit == 0 {
+				feeTx, ok := theTx.(sdk.FeeTx)
+				if !ok {
+					return fmt.Errorf("cannot read the gas limit of %T, please provide it with --%s", theTx, FlagExecutionGas)
+				}
+				gasLimit = feeTx.GetGas()
+			}
+
+			msg, err := types.NewMsgDelay(clientCtx.GetFromAddress(), serviceMsgs, delay, gasLimit)
+			if err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.Delay(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagExecutionGas, 0, "Gas limit of the execution of the messages (defaults to the gas limit of the tx)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelDelayed returns a CLI command handler for creating a
+// MsgCancelDelayed transaction.
+func NewCmdCancelDelayed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [id] --from [sender]",
+		Short: "cancel the execution of delayed messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`cancel the execution of delayed messages submitted by the sender:
+Example:
+ $ %s tx %s cancel 1 --from <sender>
+			`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id %s not a valid uint, please input a valid id", args[0])
+			}
+
+			msg := types.NewMsgCancelDelayed(clientCtx.GetFromAddress(), id)
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.CancelDelayed(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
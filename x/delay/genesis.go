@@ -0,0 +1,33 @@
+package delay
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/delay/keeper"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+// InitGenesis initializes the delay module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
+	k.SetNextID(ctx, data.NextId)
+
+	for _, de := range data.DelayedExecutions {
+		k.SetDelayedExecution(ctx, de)
+	}
+}
+
+// ExportGenesis returns the delay module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	nextID, err := k.GetNextID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var delayedExecutions []types.DelayedExecution
+	k.IterateDelayedExecutions(ctx, func(de types.DelayedExecution) bool {
+		delayedExecutions = append(delayedExecutions, de)
+		return false
+	})
+
+	return types.NewGenesisState(nextID, delayedExecutions)
+}
@@ -0,0 +1,65 @@
+package delay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/delay"
+	"github.com/cosmos/cosmos-sdk/x/delay/keeper"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	suite.keeper = app.DelayKeeper
+}
+
+var (
+	senderAddr    = sdk.AccAddress("_______sender_______")
+	recipientAddr = sdk.AccAddress("_____recipient______")
+)
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000)))
+
+	msg, err := types.NewMsgDelay(senderAddr, []sdk.ServiceMsg{
+		{
+			MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+			Request:    bank.NewMsgSend(senderAddr, recipientAddr, coins),
+		},
+	}, time.Hour, 200000)
+	suite.Require().NoError(err)
+
+	id, err := suite.keeper.Delay(suite.ctx, senderAddr, msg.Msgs, msg.Delay, msg.GasLimit)
+	suite.Require().NoError(err)
+	genesis := delay.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(id+1, genesis.NextId)
+	suite.Require().Len(genesis.DelayedExecutions, 1)
+
+	// Clear keeper
+	suite.Require().NoError(suite.keeper.Cancel(suite.ctx, senderAddr, id))
+
+	delay.InitGenesis(suite.ctx, suite.keeper, genesis)
+	newGenesis := delay.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(genesis, newGenesis)
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// DelayedExecution returns the delayed execution of the provided id.
+func (k Keeper) DelayedExecution(c context.Context, req *types.QueryDelayedExecutionRequest) (*types.QueryDelayedExecutionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	de, found := k.GetDelayedExecution(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "delayed execution %d doesn't exist", req.Id)
+	}
+
+	return &types.QueryDelayedExecutionResponse{DelayedExecution: &de}, nil
+}
+
+// DelayedExecutions returns all the pending delayed executions.
+func (k Keeper) DelayedExecutions(c context.Context, req *types.QueryDelayedExecutionsRequest) (*types.QueryDelayedExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var delayedExecutions []*types.DelayedExecution
+
+	store := ctx.KVStore(k.storeKey)
+	deStore := prefix.NewStore(store, types.DelayedExecutionKeyPrefix)
+
+	pageRes, err := query.Paginate(deStore, req.Pagination, func(key []byte, value []byte) error {
+		var de types.DelayedExecution
+		if err := k.cdc.UnmarshalBinaryBare(value, &de); err != nil {
+			return err
+		}
+
+		delayedExecutions = append(delayedExecutions, &de)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelayedExecutionsResponse{DelayedExecutions: delayedExecutions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+// Keeper manages the messages whose execution is delayed, and executes them
+// once their delay is over.
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryMarshaler
+	dispatcher baseapp.MsgDispatcher
+}
+
+// NewKeeper constructs a delay Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		dispatcher: baseapp.NewMsgDispatcher(router),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Delay schedules the execution of the messages, which must only be signed by
+// the sender, after the delay. The gas limit of the execution is consumed from
+// the gas meter of ctx, so that the sender pays for the execution upfront. It
+// returns the id of the delayed execution.
+func (k Keeper) Delay(ctx sdk.Context, sender sdk.AccAddress, msgs []*codectypes.Any, delay time.Duration, gasLimit uint64) (uint64, error) {
+	if delay <= 0 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidDelay, "delay must be positive: %s", delay)
+	}
+
+	id, err := k.GetNextID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	de := types.NewDelayedExecution(id, sender, msgs, ctx.BlockTime().Add(delay), gasLimit)
+	if err := de.Validate(); err != nil {
+		return 0, err
+	}
+
+	ctx.GasMeter().ConsumeGas(gasLimit, "delayed execution")
+
+	k.SetDelayedExecution(ctx, de)
+	k.SetNextID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelay,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeySender, de.Sender),
+			sdk.NewAttribute(types.AttributeKeyExecuteTime, de.ExecuteTime.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// Cancel cancels the delayed execution of the given id, which must have been
+// submitted by the sender.
+func (k Keeper) Cancel(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	de, found := k.GetDelayedExecution(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDelayedExecutionNotFound, "id %d", id)
+	}
+
+	if de.Sender != sender.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "delayed execution %d was not submitted by %s", id, sender)
+	}
+
+	k.removeDelayedExecution(ctx, de)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelDelayed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeySender, de.Sender),
+		),
+	)
+
+	return nil
+}
+
+// ExecuteDelayed executes the messages of the delayed execution and removes it.
+// The messages are executed with a gas meter limited to the gas limit of the
+// delayed execution, and their state changes are only written if all of them
+// succeed.
+func (k Keeper) ExecuteDelayed(ctx sdk.Context, de types.DelayedExecution) {
+	k.removeDelayedExecution(ctx, de)
+
+	result := types.AttributeValueSuccess
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", de.Id)),
+		sdk.NewAttribute(types.AttributeKeySender, de.Sender),
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(de.GasLimit))
+
+	err := k.dispatchMsgs(cacheCtx, de)
+	attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", cacheCtx.GasMeter().GasConsumedToLimit())))
+	if err == nil {
+		// The cached context is created with a new EventManager, re-emit its
+		// events into the original context's EventManager.
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+	} else {
+		result = types.AttributeValueFailure
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	k.Logger(ctx).Info("delayed messages executed", "id", de.Id, "sender", de.Sender, "result", result)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeExecuteDelayed, append(attrs, sdk.NewAttribute(types.AttributeKeyResult, result))...),
+	)
+}
+
+// dispatchMsgs executes the messages of the delayed execution, recovering from
+// the panics of their handlers, including running out of gas.
+func (k Keeper) dispatchMsgs(ctx sdk.Context, de types.DelayedExecution) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = sdkerrors.Wrapf(
+					sdkerrors.ErrOutOfGas, "out of gas in location: %v; gasLimit: %d, gasUsed: %d",
+					rType.Descriptor, de.GasLimit, ctx.GasMeter().GasConsumed(),
+				)
+
+			default:
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+			}
+		}
+	}()
+
+	msgs, err := de.GetServiceMsgs()
+	if err != nil {
+		return err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(de.Sender)
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		if _, err := k.dispatcher.Dispatch(ctx, sender, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetNextID returns the id of the next delayed execution.
+func (k Keeper) GetNextID(ctx sdk.Context) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextIDKey)
+	if bz == nil {
+		return 0, sdkerrors.Wrap(types.ErrInvalidGenesis, "next delayed execution id hasn't been set")
+	}
+
+	return types.GetIDFromBytes(bz), nil
+}
+
+// SetNextID sets the id of the next delayed execution.
+func (k Keeper) SetNextID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextIDKey, types.GetIDBytes(id))
+}
+
+// GetDelayedExecution returns the delayed execution of the given id.
+func (k Keeper) GetDelayedExecution(ctx sdk.Context, id uint64) (de types.DelayedExecution, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DelayedExecutionKey(id))
+	if bz == nil {
+		return de, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &de)
+	return de, true
+}
+
+// SetDelayedExecution stores the delayed execution and queues it for
+// execution at its execute time.
+func (k Keeper) SetDelayedExecution(ctx sdk.Context, de types.DelayedExecution) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DelayedExecutionKey(de.Id), k.cdc.MustMarshalBinaryBare(&de))
+	store.Set(types.DelayQueueKey(de.Id, de.ExecuteTime), types.GetIDBytes(de.Id))
+}
+
+func (k Keeper) removeDelayedExecution(ctx sdk.Context, de types.DelayedExecution) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DelayedExecutionKey(de.Id))
+	store.Delete(types.DelayQueueKey(de.Id, de.ExecuteTime))
+}
+
+// IterateDelayedExecutions iterates over the delayed executions by id and
+// performs a callback function.
+func (k Keeper) IterateDelayedExecutions(ctx sdk.Context, cb func(de types.DelayedExecution) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelayedExecutionKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var de types.DelayedExecution
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &de)
+
+		if cb(de) {
+			break
+		}
+	}
+}
+
+// IterateDelayQueue iterates over the delayed executions whose execute time is
+// not after executeTime, by execute time, and performs a callback function.
+func (k Keeper) IterateDelayQueue(ctx sdk.Context, executeTime time.Time, cb func(de types.DelayedExecution) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.DelayQueuePrefix, sdk.PrefixEndBytes(types.DelayQueueByTimeKey(executeTime)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		id, _ := types.SplitDelayQueueKey(iterator.Key())
+		de, found := k.GetDelayedExecution(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("delayed execution %d does not exist", id))
+		}
+
+		if cb(de) {
+			break
+		}
+	}
+}
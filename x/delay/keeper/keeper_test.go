@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/delay"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+// executionGas is the gas limit of the delayed executions of the tests.
+const executionGas = 200000
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.DelayKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+// sendMsgs returns the Anys of a bank send of amount from one address to
+// another.
+func (s *KeeperTestSuite) sendMsgs(from, to sdk.AccAddress, amount int64) []*codectypes.Any {
+	msg, err := types.NewMsgDelay(from, []sdk.ServiceMsg{
+		{
+			MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+			Request:    banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))),
+		},
+	}, time.Hour, executionGas)
+	s.Require().NoError(err)
+
+	return msg.Msgs
+}
+
+func (s *KeeperTestSuite) TestDelay() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	balance := app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom)
+
+	s.T().Log("verify messages are only delayed by a positive delay")
+	_, err := app.DelayKeeper.Delay(ctx, addrs[0], s.sendMsgs(addrs[0], addrs[1], 100), 0, executionGas)
+	s.Require().True(types.ErrInvalidDelay.Is(err))
+
+	s.T().Log("verify the execution of messages must have a gas limit")
+	_, err = app.DelayKeeper.Delay(ctx, addrs[0], s.sendMsgs(addrs[0], addrs[1], 100), time.Hour, 0)
+	s.Require().True(types.ErrInvalidGasLimit.Is(err))
+
+	s.T().Log("verify messages must be signed by the sender")
+	_, err = app.DelayKeeper.Delay(ctx, addrs[2], s.sendMsgs(addrs[0], addrs[1], 100), time.Hour, executionGas)
+	s.Require().True(sdkerrors.ErrUnauthorized.Is(err))
+
+	s.T().Log("verify messages are delayed, charging the gas of their execution")
+	gasMeter := sdk.NewGasMeter(10 * executionGas)
+	id, err := app.DelayKeeper.Delay(ctx.WithGasMeter(gasMeter), addrs[0], s.sendMsgs(addrs[0], addrs[1], 100), time.Hour, executionGas)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultStartingID, id)
+	s.Require().GreaterOrEqual(gasMeter.GasConsumed(), uint64(executionGas))
+
+	res, err := s.queryClient.DelayedExecution(ctx.Context(), &types.QueryDelayedExecutionRequest{Id: id})
+	s.Require().NoError(err)
+	s.Require().Equal(addrs[0].String(), res.DelayedExecution.Sender)
+	s.Require().Equal(ctx.BlockTime().Add(time.Hour), res.DelayedExecution.ExecuteTime)
+
+	s.T().Log("verify messages are not executed before their execute time")
+	delay.EndBlocker(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour-time.Second)), app.DelayKeeper)
+	_, found := app.DelayKeeper.GetDelayedExecution(ctx, id)
+	s.Require().True(found)
+	s.Require().Equal(balance, app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+
+	s.T().Log("verify messages are executed at their execute time")
+	execCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	delay.EndBlocker(execCtx, app.DelayKeeper)
+	_, found = app.DelayKeeper.GetDelayedExecution(ctx, id)
+	s.Require().False(found)
+	s.Require().Equal(balance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+
+	events := execCtx.EventManager().Events()
+	s.Require().Equal(banktypes.EventTypeTransfer, events[0].Type)
+	s.Require().Equal(types.EventTypeExecuteDelayed, events[len(events)-1].Type)
+
+	_, err = s.queryClient.DelayedExecution(ctx.Context(), &types.QueryDelayedExecutionRequest{Id: id})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestExecuteDelayedFailure() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	balance := app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom)
+
+	// the second send exceeds the balance of the sender once the first one is
+	// executed, so that none of them is
+	msgs := append(s.sendMsgs(addrs[0], addrs[1], 20000000), s.sendMsgs(addrs[0], addrs[1], 20000000)...)
+	id, err := app.DelayKeeper.Delay(ctx, addrs[0], msgs, time.Hour, executionGas)
+	s.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	delay.EndBlocker(ctx, app.DelayKeeper)
+
+	_, found := app.DelayKeeper.GetDelayedExecution(ctx, id)
+	s.Require().False(found)
+	s.Require().Equal(balance, app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(types.EventTypeExecuteDelayed, events[0].Type)
+	s.Require().Contains(events[0].Attributes, sdk.NewAttribute(types.AttributeKeyResult, types.AttributeValueFailure).ToKVPair())
+}
+
+func (s *KeeperTestSuite) TestExecuteDelayedOutOfGas() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	balance := app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom)
+
+	id, err := app.DelayKeeper.Delay(ctx, addrs[0], s.sendMsgs(addrs[0], addrs[1], 100), time.Hour, 1000)
+	s.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	delay.EndBlocker(ctx, app.DelayKeeper)
+
+	_, found := app.DelayKeeper.GetDelayedExecution(ctx, id)
+	s.Require().False(found)
+	s.Require().Equal(balance, app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Contains(events[0].Attributes, sdk.NewAttribute(types.AttributeKeyResult, types.AttributeValueFailure).ToKVPair())
+	s.Require().Contains(events[0].Attributes, sdk.NewAttribute(types.AttributeKeyGasUsed, "1000").ToKVPair())
+
+	var errAttr string
+	for _, attr := range events[0].Attributes {
+		if string(attr.Key) == types.AttributeKeyError {
+			errAttr = string(attr.Value)
+		}
+	}
+	s.Require().Contains(errAttr, sdkerrors.ErrOutOfGas.Error())
+}
+
+func (s *KeeperTestSuite) TestEndBlockerMaxExecutions() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	balance := app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom)
+
+	for i := 0; i <= types.MaxExecutionsPerBlock; i++ {
+		_, err := app.DelayKeeper.Delay(ctx, addrs[0], s.sendMsgs(addrs[0], addrs[1], 1), time.Hour, executionGas)
+		s.Require().NoError(err)
+	}
+
+	s.T().Log("verify at most MaxExecutionsPerBlock executions are executed per block")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	delay.EndBlocker(ctx, app.DelayKeeper)
+	s.Require().Equal(balance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, types.MaxExecutionsPerBlock)), app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+
+	res, err := s.queryClient.DelayedExecutions(ctx.Context(), &types.QueryDelayedExecutionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.DelayedExecutions, 1)
+	s.Require().Equal(types.DefaultStartingID+types.MaxExecutionsPerBlock, res.DelayedExecutions[0].Id)
+
+	s.T().Log("verify the remaining executions are executed in the following block")
+	delay.EndBlocker(ctx.WithBlockTime(ctx.BlockTime().Add(time.Second)), app.DelayKeeper)
+	s.Require().Equal(balance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, types.MaxExecutionsPerBlock+1)), app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+}
+
+func (s *KeeperTestSuite) TestCancel() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	id, err := app.DelayKeeper.Delay(ctx, addrs[0], s.sendMsgs(addrs[0], addrs[1], 100), time.Hour, executionGas)
+	s.Require().NoError(err)
+
+	s.T().Log("verify unknown delayed executions cannot be cancelled")
+	err = app.DelayKeeper.Cancel(ctx, addrs[0], id+1)
+	s.Require().True(types.ErrDelayedExecutionNotFound.Is(err))
+
+	s.T().Log("verify delayed executions can only be cancelled by their sender")
+	err = app.DelayKeeper.Cancel(ctx, addrs[1], id)
+	s.Require().True(sdkerrors.ErrUnauthorized.Is(err))
+
+	s.T().Log("verify cancelled delayed executions are not executed")
+	s.Require().NoError(app.DelayKeeper.Cancel(ctx, addrs[0], id))
+	_, found := app.DelayKeeper.GetDelayedExecution(ctx, id)
+	s.Require().False(found)
+
+	res, err := s.queryClient.DelayedExecutions(ctx.Context(), &types.QueryDelayedExecutionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.DelayedExecutions)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the delay MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Delay implements the MsgServer.Delay method.
+func (k msgServer) Delay(goCtx context.Context, msg *types.MsgDelay) (*types.MsgDelayResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.Delay(ctx, sender, msg.Msgs, msg.Delay, msg.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelayResponse{Id: id}, nil
+}
+
+// CancelDelayed implements the MsgServer.CancelDelayed method.
+func (k msgServer) CancelDelayed(goCtx context.Context, msg *types.MsgCancelDelayed) (*types.MsgCancelDelayedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Cancel(ctx, sender, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelDelayedResponse{}, nil
+}
@@ -0,0 +1,33 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+// NewDecodeStore returns a decoder function closure that umarshals the KVPair's
+// Value to the corresponding delay type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.DelayedExecutionKeyPrefix):
+			var deA, deB types.DelayedExecution
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &deA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &deB)
+			return fmt.Sprintf("%v\n%v", deA, deB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelayQueuePrefix),
+			bytes.Equal(kvA.Key[:1], types.NextIDKey):
+			idA := types.GetIDFromBytes(kvA.Value)
+			idB := types.GetIDFromBytes(kvB.Value)
+			return fmt.Sprintf("%d\n%d", idA, idB)
+
+		default:
+			panic(fmt.Sprintf("invalid delay key %X", kvA.Key))
+		}
+	}
+}
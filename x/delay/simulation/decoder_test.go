@@ -0,0 +1,56 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/delay/simulation"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	executeTime := time.Now().UTC()
+	de := types.NewDelayedExecution(1, sender, nil, executeTime, 200000)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.DelayedExecutionKey(1), Value: cdc.MustMarshalBinaryBare(&de)},
+			{Key: types.DelayQueueKey(1, executeTime), Value: types.GetIDBytes(1)},
+			{Key: types.NextIDKey, Value: types.GetIDBytes(2)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"DelayedExecution", fmt.Sprintf("%v\n%v", de, de)},
+		{"DelayQueue", "1\n1"},
+		{"NextID", "2\n2"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+// RandomizedGenState generates a random GenesisState for delay, without
+// delayed executions.
+func RandomizedGenState(simState *module.SimulationState) {
+	delayGenesis := types.NewGenesisState(uint64(simState.Rand.Intn(100))+1, nil)
+
+	bz, err := json.MarshalIndent(&delayGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(delayGenesis)
+}
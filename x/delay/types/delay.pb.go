@@ -0,0 +1,518 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/delay/v1beta1/delay.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DelayedExecution defines messages submitted by a sender for execution at the
+// end of the first block past their execute time. The sender can cancel it
+// until then.
+type DelayedExecution struct {
+	Id          uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender      string       `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Msgs        []*types.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	ExecuteTime time.Time    `protobuf:"bytes,4,opt,name=execute_time,json=executeTime,proto3,stdtime" json:"execute_time"`
+	// gas_limit is the gas the execution of the messages may consume, charged
+	// to the sender when they were submitted.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *DelayedExecution) Reset()         { *m = DelayedExecution{} }
+func (m *DelayedExecution) String() string { return proto.CompactTextString(m) }
+func (*DelayedExecution) ProtoMessage()    {}
+func (*DelayedExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35aac0f5253fae8b, []int{0}
+}
+func (m *DelayedExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelayedExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedExecution.Merge(m, src)
+}
+func (m *DelayedExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedExecution proto.InternalMessageInfo
+
+func (m *DelayedExecution) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DelayedExecution) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *DelayedExecution) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *DelayedExecution) GetExecuteTime() time.Time {
+	if m != nil {
+		return m.ExecuteTime
+	}
+	return time.Time{}
+}
+
+func (m *DelayedExecution) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DelayedExecution)(nil), "cosmos.delay.v1beta1.DelayedExecution")
+}
+
+func init() { proto.RegisterFile("cosmos/delay/v1beta1/delay.proto", fileDescriptor_35aac0f5253fae8b) }
+
+var fileDescriptor_35aac0f5253fae8b = []byte{
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4e, 0x32, 0x31,
+	0x14, 0x85, 0xa7, 0xc0, 0x4f, 0xa0, 0xfc, 0x31, 0x66, 0x42, 0xcc, 0x38, 0x26, 0xc3, 0xc4, 0xd5,
+	0xc4, 0xc4, 0x36, 0xe0, 0x13, 0x48, 0x30, 0x6e, 0x5c, 0x4d, 0x5c, 0xb9, 0x21, 0x1d, 0x5a, 0x6b,
+	0x23, 0x9d, 0x12, 0x5a, 0x0c, 0xbc, 0x05, 0x8f, 0x45, 0x5c, 0xb1, 0x74, 0xa5, 0x86, 0x79, 0x11,
+	0x33, 0x6d, 0xd9, 0xe8, 0xaa, 0x3d, 0xbd, 0xdf, 0xbd, 0xe7, 0xa4, 0x17, 0xa6, 0x33, 0xa5, 0xa5,
+	0xd2, 0x98, 0xb2, 0x39, 0xd9, 0xe0, 0xb7, 0x61, 0xc1, 0x0c, 0x19, 0x3a, 0x85, 0x16, 0x4b, 0x65,
+	0x54, 0xd8, 0x77, 0x04, 0x72, 0x6f, 0x9e, 0x88, 0xfb, 0x5c, 0x71, 0x65, 0x01, 0x5c, 0xdf, 0x1c,
+	0x1b, 0x9f, 0x73, 0xa5, 0xf8, 0x9c, 0x61, 0xab, 0x8a, 0xd5, 0x33, 0x26, 0xa5, 0x1f, 0x13, 0x0f,
+	0x7e, 0x97, 0x8c, 0x90, 0x4c, 0x1b, 0x22, 0x17, 0x0e, 0xb8, 0x7c, 0x07, 0xf0, 0x74, 0x52, 0x7b,
+	0x30, 0x7a, 0xb7, 0x66, 0xb3, 0x95, 0x11, 0xaa, 0x0c, 0x4f, 0x60, 0x43, 0xd0, 0x08, 0xa4, 0x20,
+	0x6b, 0xe5, 0x0d, 0x41, 0xc3, 0x33, 0xd8, 0xd6, 0xac, 0xa4, 0x6c, 0x19, 0x35, 0x52, 0x90, 0x75,
+	0x73, 0xaf, 0xc2, 0x0c, 0xb6, 0xa4, 0xe6, 0x3a, 0x6a, 0xa6, 0xcd, 0xac, 0x37, 0xea, 0x23, 0x67,
+	0x86, 0x8e, 0x66, 0xe8, 0xb6, 0xdc, 0xe4, 0x96, 0x08, 0xef, 0xe1, 0x7f, 0x66, 0xc7, 0xb3, 0x69,
+	0x9d, 0x20, 0x6a, 0xa5, 0x20, 0xeb, 0x8d, 0xe2, 0x3f, 0x1d, 0x8f, 0xc7, 0x78, 0xe3, 0xce, 0xee,
+	0x73, 0x10, 0x6c, 0xbf, 0x06, 0x20, 0xef, 0xf9, 0xce, 0xba, 0x16, 0x5e, 0xc0, 0x2e, 0x27, 0x7a,
+	0x3a, 0x17, 0x52, 0x98, 0xe8, 0x9f, 0x4d, 0xd8, 0xe1, 0x44, 0x3f, 0xd4, 0x7a, 0x3c, 0xd9, 0x1d,
+	0x12, 0xb0, 0x3f, 0x24, 0xe0, 0xfb, 0x90, 0x80, 0x6d, 0x95, 0x04, 0xfb, 0x2a, 0x09, 0x3e, 0xaa,
+	0x24, 0x78, 0xba, 0xe2, 0xc2, 0xbc, 0xac, 0x0a, 0x34, 0x53, 0x12, 0xfb, 0xbf, 0x77, 0xc7, 0xb5,
+	0xa6, 0xaf, 0x78, 0xed, 0x17, 0x61, 0x36, 0x0b, 0xa6, 0x8b, 0xb6, 0x4d, 0x73, 0xf3, 0x33, 0x00,
+	0x79, 0x34, 0x78, 0x26, 0xa5, 0x01, 0x00, 0x00,
+}
+
+func (m *DelayedExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintDelay(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecuteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecuteTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDelay(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintDelay(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDelay(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelay(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelay(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelayedExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDelay(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovDelay(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovDelay(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecuteTime)
+	n += 1 + l + sovDelay(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovDelay(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func sovDelay(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelay(x uint64) (n int) {
+	return sovDelay(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelayedExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExecuteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDelay(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelay
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelay
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelay
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelay
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelay        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelay          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelay = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = DelayedExecution{}
+
+// MaxExecutionsPerBlock is the maximum number of delayed executions executed at
+// the end of a block. The due executions in excess are executed at the end of
+// the following blocks, in order.
+const MaxExecutionsPerBlock = 100
+
+// NewDelayedExecution returns a new DelayedExecution of the messages signed by
+// the sender, whose execution may consume up to gasLimit gas.
+//nolint:interfacer
+func NewDelayedExecution(id uint64, sender sdk.AccAddress, msgs []*types.Any, executeTime time.Time, gasLimit uint64) DelayedExecution {
+	return DelayedExecution{
+		Id:          id,
+		Sender:      sender.String(),
+		Msgs:        msgs,
+		ExecuteTime: executeTime,
+		GasLimit:    gasLimit,
+	}
+}
+
+// GetServiceMsgs returns the cache values from the DelayedExecution.Msgs if present.
+func (de DelayedExecution) GetServiceMsgs() ([]sdk.ServiceMsg, error) {
+	return unpackServiceMsgs(de.Msgs)
+}
+
+// Validate performs a stateless validation of the delayed execution.
+func (de DelayedExecution) Validate() error {
+	sender, err := sdk.AccAddressFromBech32(de.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if de.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidGasLimit, "gas limit must be positive")
+	}
+
+	return validateServiceMsgs(sender, de.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (de DelayedExecution) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackInterfaces(unpacker, de.Msgs)
+}
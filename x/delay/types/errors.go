@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/delay module sentinel errors
+var (
+	ErrInvalidDelay             = sdkerrors.Register(ModuleName, 2, "invalid delay")
+	ErrDelayedExecutionNotFound = sdkerrors.Register(ModuleName, 3, "delayed execution not found")
+	ErrInvalidGenesis           = sdkerrors.Register(ModuleName, 4, "invalid genesis state")
+	ErrInvalidGasLimit          = sdkerrors.Register(ModuleName, 5, "invalid gas limit")
+)
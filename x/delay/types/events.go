@@ -0,0 +1,19 @@
+package types
+
+// delay module events
+const (
+	EventTypeDelay          = "delay"
+	EventTypeCancelDelayed  = "cancel_delayed"
+	EventTypeExecuteDelayed = "execute_delayed"
+
+	AttributeKeyID          = "id"
+	AttributeKeySender      = "sender"
+	AttributeKeyExecuteTime = "execute_time"
+	AttributeKeyResult      = "result"
+	AttributeKeyGasUsed     = "gas_used"
+	AttributeKeyError       = "error"
+
+	AttributeValueCategory = ModuleName
+	AttributeValueSuccess  = "success"
+	AttributeValueFailure  = "failure"
+)
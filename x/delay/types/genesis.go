@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// DefaultStartingID is the id of the first delayed execution.
+const DefaultStartingID uint64 = 1
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(nextID uint64, delayedExecutions []DelayedExecution) *GenesisState {
+	return &GenesisState{
+		NextId:            nextID,
+		DelayedExecutions: delayedExecutions,
+	}
+}
+
+// DefaultGenesisState returns default state for delay module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultStartingID, nil)
+}
+
+// ValidateGenesis checks the delayed executions in the genesis state are valid
+// and have distinct ids lower than the next id.
+func ValidateGenesis(data GenesisState) error {
+	ids := make(map[uint64]bool, len(data.DelayedExecutions))
+	for _, de := range data.DelayedExecutions {
+		if de.Id >= data.NextId {
+			return fmt.Errorf("delayed execution id %d must be lower than the next id %d", de.Id, data.NextId)
+		}
+
+		if ids[de.Id] {
+			return fmt.Errorf("duplicate delayed execution id %d", de.Id)
+		}
+		ids[de.Id] = true
+
+		if err := de.Validate(); err != nil {
+			return fmt.Errorf("invalid delayed execution %d: %w", de.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, de := range data.DelayedExecutions {
+		err := de.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	delaytypes "github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	msg, err := delaytypes.NewMsgDelay(sender, []sdk.ServiceMsg{sendMsg(sender)}, time.Hour, 200000)
+	require.NoError(t, err)
+
+	executeTime := time.Now().UTC()
+	newDelayedExecution := func(id uint64, msgs []*types.Any) delaytypes.DelayedExecution {
+		return delaytypes.NewDelayedExecution(id, sender, msgs, executeTime, 200000)
+	}
+
+	tests := []struct {
+		title      string
+		genesis    *delaytypes.GenesisState
+		expectPass bool
+	}{
+		{"default genesis", delaytypes.DefaultGenesisState(), true},
+		{"valid genesis", delaytypes.NewGenesisState(3, []delaytypes.DelayedExecution{
+			newDelayedExecution(1, msg.Msgs), newDelayedExecution(2, msg.Msgs),
+		}), true},
+		{"id not lower than the next id", delaytypes.NewGenesisState(2, []delaytypes.DelayedExecution{
+			newDelayedExecution(2, msg.Msgs),
+		}), false},
+		{"duplicate id", delaytypes.NewGenesisState(3, []delaytypes.DelayedExecution{
+			newDelayedExecution(1, msg.Msgs), newDelayedExecution(1, msg.Msgs),
+		}), false},
+		{"no messages", delaytypes.NewGenesisState(3, []delaytypes.DelayedExecution{
+			newDelayedExecution(1, nil),
+		}), false},
+		{"no gas limit", delaytypes.NewGenesisState(2, []delaytypes.DelayedExecution{
+			delaytypes.NewDelayedExecution(1, sender, msg.Msgs, executeTime, 0),
+		}), false},
+	}
+	for i, tc := range tests {
+		err := delaytypes.ValidateGenesis(*tc.genesis)
+		if tc.expectPass {
+			require.NoError(t, err, "test: %v", i)
+		} else {
+			require.Error(t, err, "test: %v", i)
+		}
+	}
+}
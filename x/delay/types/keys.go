@@ -0,0 +1,99 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "delay"
+
+	// StoreKey is the store key string for delay
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for delay
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for delay
+	QuerierRoute = ModuleName
+)
+
+// Keys for delay store
+// Items are stored with the following key: values
+//
+// - 0x01<id_Bytes>: DelayedExecution
+//
+// - 0x02<executeTime_Bytes><id_Bytes>: id
+//
+// - 0x03: nextID
+var (
+	DelayedExecutionKeyPrefix = []byte{0x01}
+	DelayQueuePrefix          = []byte{0x02}
+	NextIDKey                 = []byte{0x03}
+)
+
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
+// GetIDBytes returns the byte representation of the id of a delayed execution
+func GetIDBytes(id uint64) (idBz []byte) {
+	idBz = make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, id)
+	return
+}
+
+// GetIDFromBytes returns the id of a delayed execution in uint64 format from a byte array
+func GetIDFromBytes(bz []byte) (id uint64) {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// DelayedExecutionKey gets the key of a delayed execution
+func DelayedExecutionKey(id uint64) []byte {
+	return append(DelayedExecutionKeyPrefix, GetIDBytes(id)...)
+}
+
+// DelayQueueByTimeKey gets the delay queue key by execute time
+func DelayQueueByTimeKey(executeTime time.Time) []byte {
+	return append(DelayQueuePrefix, sdk.FormatTimeBytes(executeTime)...)
+}
+
+// DelayQueueKey returns the key for an id in the delay queue
+func DelayQueueKey(id uint64, executeTime time.Time) []byte {
+	return append(DelayQueueByTimeKey(executeTime), GetIDBytes(id)...)
+}
+
+// SplitDelayQueueKey splits the delay queue key and returns the id and execute time
+func SplitDelayQueueKey(key []byte) (id uint64, executeTime time.Time) {
+	if len(key[1:]) != 8+lenTime {
+		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key[1:]), lenTime+8))
+	}
+
+	executeTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	id = GetIDFromBytes(key[1+lenTime:])
+	return
+}
+
+// DecodeStoreKey decodes the key of an entry of the delay store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	switch {
+	case bytes.HasPrefix(key, DelayedExecutionKeyPrefix):
+		return sdk.NewStoreKeyParser("DelayedExecutions", key[1:], &DelayedExecution{}).Uint64("id").Decode()
+
+	case bytes.HasPrefix(key, DelayQueuePrefix):
+		return sdk.NewStoreKeyParser("DelayQueue", key[1:], nil).Time("execute_time").Uint64("id").Decode()
+
+	case bytes.HasPrefix(key, NextIDKey):
+		return sdk.NewStoreKeyParser("NextID", key[1:], nil).Decode()
+
+	default:
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+}
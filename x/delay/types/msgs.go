@@ -0,0 +1,168 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.MsgRequest = &MsgDelay{}
+	_ sdk.MsgRequest = &MsgCancelDelayed{}
+
+	_ types.UnpackInterfacesMessage = &MsgDelay{}
+)
+
+// NewMsgDelay creates a new MsgDelay
+//nolint:interfacer
+func NewMsgDelay(sender sdk.AccAddress, msgs []sdk.ServiceMsg, delay time.Duration, gasLimit uint64) (*MsgDelay, error) {
+	msgsAny, err := packServiceMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgDelay{
+		Sender:   sender.String(),
+		Msgs:     msgsAny,
+		Delay:    delay,
+		GasLimit: gasLimit,
+	}, nil
+}
+
+// GetServiceMsgs returns the cache values from the MsgDelay.Msgs if present.
+func (msg MsgDelay) GetServiceMsgs() ([]sdk.ServiceMsg, error) {
+	return unpackServiceMsgs(msg.Msgs)
+}
+
+// GetSigners implements Msg
+func (msg MsgDelay) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgDelay) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if msg.Delay <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidDelay, "delay must be positive: %s", msg.Delay)
+	}
+
+	if msg.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidGasLimit, "gas limit must be positive")
+	}
+
+	return validateServiceMsgs(sender, msg.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgDelay) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackInterfaces(unpacker, msg.Msgs)
+}
+
+// NewMsgCancelDelayed creates a new MsgCancelDelayed
+//nolint:interfacer
+func NewMsgCancelDelayed(sender sdk.AccAddress, id uint64) *MsgCancelDelayed {
+	return &MsgCancelDelayed{
+		Sender: sender.String(),
+		Id:     id,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelDelayed) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgCancelDelayed) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// packServiceMsgs packs the service messages into Anys whose type URL is
+// their method name.
+func packServiceMsgs(msgs []sdk.ServiceMsg) ([]*types.Any, error) {
+	msgsAny := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		anyMsg, err := types.NewAnyWithCustomTypeURL(msg.Request, msg.MethodName)
+		if err != nil {
+			return nil, err
+		}
+
+		msgsAny[i] = anyMsg
+	}
+
+	return msgsAny, nil
+}
+
+// unpackServiceMsgs returns the service messages cached in the Anys.
+func unpackServiceMsgs(msgsAny []*types.Any) ([]sdk.ServiceMsg, error) {
+	msgs := make([]sdk.ServiceMsg, len(msgsAny))
+	for i, msgAny := range msgsAny {
+		msgReq, ok := msgAny.GetCachedValue().(sdk.MsgRequest)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.MsgRequest", msgAny)
+		}
+
+		msgs[i] = sdk.ServiceMsg{
+			MethodName: msgAny.TypeUrl,
+			Request:    msgReq,
+		}
+	}
+
+	return msgs, nil
+}
+
+// validateServiceMsgs checks that the service messages are valid and only
+// signed by the sender.
+func validateServiceMsgs(sender sdk.AccAddress, msgsAny []*types.Any) error {
+	if len(msgsAny) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := unpackServiceMsgs(msgsAny)
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(sender) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %s must only be signed by the sender", msg.MethodName)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func unpackInterfaces(unpacker types.AnyUnpacker, msgsAny []*types.Any) error {
+	for _, x := range msgsAny {
+		var msg sdk.MsgRequest
+		err := unpacker.UnpackAny(x, &msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/delay/types"
+)
+
+var (
+	sender    = sdk.AccAddress("_______sender_______")
+	recipient = sdk.AccAddress("_____recipient______")
+)
+
+func sendMsg(from sdk.AccAddress) sdk.ServiceMsg {
+	return sdk.ServiceMsg{
+		MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+		Request:    banktypes.NewMsgSend(from, recipient, sdk.NewCoins(sdk.NewInt64Coin("steak", 2))),
+	}
+}
+
+func TestMsgDelay(t *testing.T) {
+	tests := []struct {
+		title      string
+		sender     sdk.AccAddress
+		msgs       []sdk.ServiceMsg
+		delay      time.Duration
+		gasLimit   uint64
+		expectPass bool
+	}{
+		{"nil sender address", nil, []sdk.ServiceMsg{sendMsg(sender)}, time.Hour, 200000, false},
+		{"zero-messages test: should fail", sender, []sdk.ServiceMsg{}, time.Hour, 200000, false},
+		{"zero delay", sender, []sdk.ServiceMsg{sendMsg(sender)}, 0, 200000, false},
+		{"negative delay", sender, []sdk.ServiceMsg{sendMsg(sender)}, -time.Hour, 200000, false},
+		{"message not signed by the sender", sender, []sdk.ServiceMsg{sendMsg(recipient)}, time.Hour, 200000, false},
+		{"zero gas limit", sender, []sdk.ServiceMsg{sendMsg(sender)}, time.Hour, 0, false},
+		{"valid test: msg type", sender, []sdk.ServiceMsg{sendMsg(sender), sendMsg(sender)}, time.Hour, 200000, true},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgDelay(tc.sender, tc.msgs, tc.delay, tc.gasLimit)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgCancelDelayed(t *testing.T) {
+	tests := []struct {
+		title      string
+		sender     sdk.AccAddress
+		expectPass bool
+	}{
+		{"nil sender address", nil, false},
+		{"valid test", sender, true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgCancelDelayed(tc.sender, 1)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -0,0 +1,1046 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/delay/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryDelayedExecutionRequest is the request type for the Query/DelayedExecution RPC method.
+type QueryDelayedExecutionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryDelayedExecutionRequest) Reset()         { *m = QueryDelayedExecutionRequest{} }
+func (m *QueryDelayedExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedExecutionRequest) ProtoMessage()    {}
+func (*QueryDelayedExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c361f62eb77e449, []int{0}
+}
+func (m *QueryDelayedExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedExecutionRequest.Merge(m, src)
+}
+func (m *QueryDelayedExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedExecutionRequest proto.InternalMessageInfo
+
+func (m *QueryDelayedExecutionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryDelayedExecutionResponse is the response type for the Query/DelayedExecution RPC method.
+type QueryDelayedExecutionResponse struct {
+	DelayedExecution *DelayedExecution `protobuf:"bytes,1,opt,name=delayed_execution,json=delayedExecution,proto3" json:"delayed_execution,omitempty"`
+}
+
+func (m *QueryDelayedExecutionResponse) Reset()         { *m = QueryDelayedExecutionResponse{} }
+func (m *QueryDelayedExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedExecutionResponse) ProtoMessage()    {}
+func (*QueryDelayedExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c361f62eb77e449, []int{1}
+}
+func (m *QueryDelayedExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedExecutionResponse.Merge(m, src)
+}
+func (m *QueryDelayedExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedExecutionResponse proto.InternalMessageInfo
+
+func (m *QueryDelayedExecutionResponse) GetDelayedExecution() *DelayedExecution {
+	if m != nil {
+		return m.DelayedExecution
+	}
+	return nil
+}
+
+// QueryDelayedExecutionsRequest is the request type for the Query/DelayedExecutions RPC method.
+type QueryDelayedExecutionsRequest struct {
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelayedExecutionsRequest) Reset()         { *m = QueryDelayedExecutionsRequest{} }
+func (m *QueryDelayedExecutionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedExecutionsRequest) ProtoMessage()    {}
+func (*QueryDelayedExecutionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c361f62eb77e449, []int{2}
+}
+func (m *QueryDelayedExecutionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedExecutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedExecutionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedExecutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedExecutionsRequest.Merge(m, src)
+}
+func (m *QueryDelayedExecutionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedExecutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedExecutionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedExecutionsRequest proto.InternalMessageInfo
+
+func (m *QueryDelayedExecutionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelayedExecutionsResponse is the response type for the Query/DelayedExecutions RPC method.
+type QueryDelayedExecutionsResponse struct {
+	DelayedExecutions []*DelayedExecution `protobuf:"bytes,1,rep,name=delayed_executions,json=delayedExecutions,proto3" json:"delayed_executions,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelayedExecutionsResponse) Reset()         { *m = QueryDelayedExecutionsResponse{} }
+func (m *QueryDelayedExecutionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedExecutionsResponse) ProtoMessage()    {}
+func (*QueryDelayedExecutionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c361f62eb77e449, []int{3}
+}
+func (m *QueryDelayedExecutionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedExecutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedExecutionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedExecutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedExecutionsResponse.Merge(m, src)
+}
+func (m *QueryDelayedExecutionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedExecutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedExecutionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedExecutionsResponse proto.InternalMessageInfo
+
+func (m *QueryDelayedExecutionsResponse) GetDelayedExecutions() []*DelayedExecution {
+	if m != nil {
+		return m.DelayedExecutions
+	}
+	return nil
+}
+
+func (m *QueryDelayedExecutionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryDelayedExecutionRequest)(nil), "cosmos.delay.v1beta1.QueryDelayedExecutionRequest")
+	proto.RegisterType((*QueryDelayedExecutionResponse)(nil), "cosmos.delay.v1beta1.QueryDelayedExecutionResponse")
+	proto.RegisterType((*QueryDelayedExecutionsRequest)(nil), "cosmos.delay.v1beta1.QueryDelayedExecutionsRequest")
+	proto.RegisterType((*QueryDelayedExecutionsResponse)(nil), "cosmos.delay.v1beta1.QueryDelayedExecutionsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/delay/v1beta1/query.proto", fileDescriptor_6c361f62eb77e449) }
+
+var fileDescriptor_6c361f62eb77e449 = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbf, 0x6a, 0xdb, 0x40,
+	0x18, 0xf7, 0xa9, 0x7f, 0x86, 0x2b, 0x14, 0xfb, 0xe8, 0x60, 0x84, 0x2b, 0x8c, 0x86, 0xd6, 0x18,
+	0x7c, 0x57, 0xdb, 0xed, 0x0b, 0x14, 0xb7, 0x5d, 0x5b, 0x97, 0x2c, 0x59, 0xc2, 0xc9, 0x77, 0x28,
+	0x22, 0xb6, 0x4e, 0xf6, 0x9d, 0x82, 0x4d, 0xc8, 0x92, 0x27, 0x08, 0xe4, 0x45, 0x42, 0x9e, 0x20,
+	0x63, 0x46, 0x43, 0x96, 0x8c, 0xc1, 0xce, 0x9e, 0x57, 0x08, 0xd2, 0x49, 0x89, 0x2d, 0x5b, 0x49,
+	0x34, 0x09, 0xf4, 0xfd, 0x7e, 0xdf, 0xef, 0x0f, 0xdf, 0xc1, 0xfa, 0x40, 0xc8, 0x91, 0x90, 0x84,
+	0xf1, 0x21, 0x9d, 0x91, 0xc3, 0xb6, 0xc3, 0x15, 0x6d, 0x93, 0x71, 0xc8, 0x27, 0x33, 0x1c, 0x4c,
+	0x84, 0x12, 0xe8, 0x93, 0x46, 0xe0, 0x18, 0x81, 0x13, 0x84, 0xd9, 0x4c, 0x78, 0x0e, 0x95, 0x5c,
+	0xc3, 0x1f, 0xc9, 0x01, 0x75, 0x3d, 0x9f, 0x2a, 0x4f, 0xf8, 0x7a, 0x83, 0xb9, 0x5d, 0x43, 0xef,
+	0xd3, 0x88, 0x9a, 0x2b, 0x84, 0x3b, 0xe4, 0x84, 0x06, 0x1e, 0xa1, 0xbe, 0x2f, 0x54, 0x4c, 0x97,
+	0x7a, 0x6a, 0x63, 0x58, 0xfb, 0x17, 0x29, 0xf4, 0x22, 0x06, 0x67, 0xbf, 0xa6, 0x7c, 0x10, 0x46,
+	0xf3, 0x3e, 0x1f, 0x87, 0x5c, 0x2a, 0xf4, 0x11, 0x1a, 0x1e, 0xab, 0x82, 0x3a, 0x68, 0xbc, 0xed,
+	0x1b, 0x1e, 0xb3, 0x15, 0xfc, 0x9c, 0x83, 0x97, 0x81, 0xf0, 0x25, 0x47, 0xff, 0x61, 0x85, 0xe9,
+	0xd9, 0x1e, 0x4f, 0x87, 0x31, 0xff, 0x43, 0xe7, 0x0b, 0xde, 0x16, 0x17, 0x6f, 0xac, 0x2a, 0xb3,
+	0xcc, 0x1f, 0xdb, 0xcd, 0x51, 0x95, 0xa9, 0xcd, 0xdf, 0x10, 0x3e, 0x55, 0x93, 0x95, 0x8b, 0x7a,
+	0xc4, 0xba, 0xf6, 0x54, 0xf3, 0x2f, 0x75, 0x79, 0xc2, 0xed, 0xaf, 0x30, 0xed, 0x4b, 0x00, 0xad,
+	0x3c, 0xa5, 0x24, 0xe0, 0x0e, 0x44, 0x1b, 0x01, 0x65, 0x15, 0xd4, 0xdf, 0x14, 0x48, 0x58, 0xc9,
+	0x26, 0x94, 0xe8, 0xcf, 0x5a, 0x02, 0x23, 0x4e, 0xf0, 0xf5, 0xc5, 0x04, 0xda, 0xd3, 0x6a, 0x84,
+	0xce, 0xbd, 0x01, 0xdf, 0xc5, 0x11, 0xd0, 0x05, 0x80, 0xe5, 0xac, 0x34, 0xea, 0x6c, 0xb7, 0xf8,
+	0xdc, 0x11, 0x98, 0xdd, 0x42, 0x1c, 0xed, 0xc9, 0xfe, 0x71, 0x72, 0x7d, 0x77, 0x66, 0x10, 0xd4,
+	0x22, 0xf9, 0x27, 0xba, 0xd6, 0x21, 0x39, 0xf2, 0xd8, 0x31, 0x3a, 0x07, 0xb0, 0xb2, 0x51, 0x3e,
+	0x2a, 0xe2, 0x20, 0x3d, 0x0a, 0xf3, 0x7b, 0x31, 0x52, 0xe2, 0xfb, 0x5b, 0xec, 0xbb, 0x89, 0x1a,
+	0xaf, 0xf5, 0xfd, 0xb3, 0x77, 0xb5, 0xb0, 0xc0, 0x7c, 0x61, 0x81, 0xdb, 0x85, 0x05, 0x4e, 0x97,
+	0x56, 0x69, 0xbe, 0xb4, 0x4a, 0x37, 0x4b, 0xab, 0xb4, 0xdb, 0x74, 0x3d, 0xb5, 0x1f, 0x3a, 0x78,
+	0x20, 0x46, 0xe9, 0x36, 0xfd, 0x69, 0x49, 0x76, 0x40, 0xa6, 0xc9, 0x6a, 0x35, 0x0b, 0xb8, 0x74,
+	0xde, 0xc7, 0x0f, 0xb2, 0xfb, 0x30, 0x00, 0x20, 0xb0, 0x57, 0xe0, 0x36, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// DelayedExecution returns the delayed execution of the provided id.
+	DelayedExecution(ctx context.Context, in *QueryDelayedExecutionRequest, opts ...grpc.CallOption) (*QueryDelayedExecutionResponse, error)
+	// DelayedExecutions returns all the pending delayed executions.
+	DelayedExecutions(ctx context.Context, in *QueryDelayedExecutionsRequest, opts ...grpc.CallOption) (*QueryDelayedExecutionsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DelayedExecution(ctx context.Context, in *QueryDelayedExecutionRequest, opts ...grpc.CallOption) (*QueryDelayedExecutionResponse, error) {
+	out := new(QueryDelayedExecutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.delay.v1beta1.Query/DelayedExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelayedExecutions(ctx context.Context, in *QueryDelayedExecutionsRequest, opts ...grpc.CallOption) (*QueryDelayedExecutionsResponse, error) {
+	out := new(QueryDelayedExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.delay.v1beta1.Query/DelayedExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// DelayedExecution returns the delayed execution of the provided id.
+	DelayedExecution(context.Context, *QueryDelayedExecutionRequest) (*QueryDelayedExecutionResponse, error)
+	// DelayedExecutions returns all the pending delayed executions.
+	DelayedExecutions(context.Context, *QueryDelayedExecutionsRequest) (*QueryDelayedExecutionsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DelayedExecution(ctx context.Context, req *QueryDelayedExecutionRequest) (*QueryDelayedExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedExecution not implemented")
+}
+func (*UnimplementedQueryServer) DelayedExecutions(ctx context.Context, req *QueryDelayedExecutionsRequest) (*QueryDelayedExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedExecutions not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DelayedExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.delay.v1beta1.Query/DelayedExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedExecution(ctx, req.(*QueryDelayedExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelayedExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.delay.v1beta1.Query/DelayedExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedExecutions(ctx, req.(*QueryDelayedExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.delay.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DelayedExecution",
+			Handler:    _Query_DelayedExecution_Handler,
+		},
+		{
+			MethodName: "DelayedExecutions",
+			Handler:    _Query_DelayedExecutions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/delay/v1beta1/query.proto",
+}
+
+func (m *QueryDelayedExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelayedExecution != nil {
+		{
+			size, err := m.DelayedExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedExecutionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedExecutionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedExecutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedExecutionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedExecutionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelayedExecutions) > 0 {
+		for iNdEx := len(m.DelayedExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDelayedExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDelayedExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelayedExecution != nil {
+		l = m.DelayedExecution.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedExecutionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedExecutionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelayedExecutions) > 0 {
+		for _, e := range m.DelayedExecutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDelayedExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelayedExecution == nil {
+				m.DelayedExecution = &DelayedExecution{}
+			}
+			if err := m.DelayedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedExecutionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedExecutionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedExecutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedExecutionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedExecutionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedExecutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedExecutions = append(m.DelayedExecutions, &DelayedExecution{})
+			if err := m.DelayedExecutions[len(m.DelayedExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1096 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/delay/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgDelay submits messages for execution after the provided delay.
+type MsgDelay struct {
+	Sender string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Msgs   []*types.Any  `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	Delay  time.Duration `protobuf:"bytes,3,opt,name=delay,proto3,stdduration" json:"delay"`
+	// gas_limit is the gas the execution of the messages may consume. It is
+	// charged when the MsgDelay is executed, and the execution of the messages
+	// fails if it runs out of gas.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *MsgDelay) Reset()         { *m = MsgDelay{} }
+func (m *MsgDelay) String() string { return proto.CompactTextString(m) }
+func (*MsgDelay) ProtoMessage()    {}
+func (*MsgDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ff5ff520b9a5c0, []int{0}
+}
+func (m *MsgDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelay.Merge(m, src)
+}
+func (m *MsgDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelay proto.InternalMessageInfo
+
+func (m *MsgDelay) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgDelay) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *MsgDelay) GetDelay() time.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+func (m *MsgDelay) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// MsgDelayResponse defines the Msg/Delay response type.
+type MsgDelayResponse struct {
+	// id is the id of the delayed execution.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgDelayResponse) Reset()         { *m = MsgDelayResponse{} }
+func (m *MsgDelayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelayResponse) ProtoMessage()    {}
+func (*MsgDelayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ff5ff520b9a5c0, []int{1}
+}
+func (m *MsgDelayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelayResponse.Merge(m, src)
+}
+func (m *MsgDelayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelayResponse proto.InternalMessageInfo
+
+func (m *MsgDelayResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelDelayed cancels the execution of delayed messages of the sender.
+type MsgCancelDelayed struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelDelayed) Reset()         { *m = MsgCancelDelayed{} }
+func (m *MsgCancelDelayed) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDelayed) ProtoMessage()    {}
+func (*MsgCancelDelayed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ff5ff520b9a5c0, []int{2}
+}
+func (m *MsgCancelDelayed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDelayed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDelayed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDelayed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDelayed.Merge(m, src)
+}
+func (m *MsgCancelDelayed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDelayed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDelayed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDelayed proto.InternalMessageInfo
+
+func (m *MsgCancelDelayed) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCancelDelayed) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelDelayedResponse defines the Msg/CancelDelayed response type.
+type MsgCancelDelayedResponse struct {
+}
+
+func (m *MsgCancelDelayedResponse) Reset()         { *m = MsgCancelDelayedResponse{} }
+func (m *MsgCancelDelayedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDelayedResponse) ProtoMessage()    {}
+func (*MsgCancelDelayedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ff5ff520b9a5c0, []int{3}
+}
+func (m *MsgCancelDelayedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDelayedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDelayedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDelayedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDelayedResponse.Merge(m, src)
+}
+func (m *MsgCancelDelayedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDelayedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDelayedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDelayedResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgDelay)(nil), "cosmos.delay.v1beta1.MsgDelay")
+	proto.RegisterType((*MsgDelayResponse)(nil), "cosmos.delay.v1beta1.MsgDelayResponse")
+	proto.RegisterType((*MsgCancelDelayed)(nil), "cosmos.delay.v1beta1.MsgCancelDelayed")
+	proto.RegisterType((*MsgCancelDelayedResponse)(nil), "cosmos.delay.v1beta1.MsgCancelDelayedResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/delay/v1beta1/tx.proto", fileDescriptor_72ff5ff520b9a5c0) }
+
+var fileDescriptor_72ff5ff520b9a5c0 = []byte{
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x6b, 0xe2, 0x40,
+	0x1c, 0xc6, 0x33, 0x31, 0x8a, 0x8e, 0xec, 0xb2, 0x04, 0x59, 0x62, 0x96, 0x1d, 0x43, 0x0e, 0x4b,
+	0x58, 0xd8, 0x19, 0x74, 0x4f, 0xbb, 0xb7, 0x75, 0x3d, 0x56, 0x0a, 0x39, 0xf6, 0x52, 0x12, 0x33,
+	0x9d, 0x86, 0x26, 0x19, 0x71, 0x62, 0x31, 0xdf, 0xa2, 0xc7, 0x7e, 0x80, 0x7e, 0x8f, 0x5e, 0x3d,
+	0x7a, 0xec, 0xa9, 0x2d, 0xfa, 0x45, 0x8a, 0x33, 0x89, 0xa0, 0xad, 0xa5, 0xa7, 0xe4, 0xcf, 0xf3,
+	0x7b, 0xe6, 0x79, 0xe6, 0x05, 0x7e, 0x9f, 0x70, 0x91, 0x72, 0x41, 0x22, 0x9a, 0x04, 0x05, 0xb9,
+	0xee, 0x87, 0x34, 0x0f, 0xfa, 0x24, 0x5f, 0xe0, 0xe9, 0x8c, 0xe7, 0xdc, 0xec, 0x28, 0x19, 0x4b,
+	0x19, 0x97, 0xb2, 0xdd, 0x61, 0x9c, 0x71, 0x09, 0x90, 0xed, 0x9f, 0x62, 0xed, 0x2e, 0xe3, 0x9c,
+	0x25, 0x94, 0xc8, 0x29, 0x9c, 0x5f, 0x90, 0x20, 0x2b, 0x4a, 0x09, 0x1d, 0x4a, 0xd1, 0x7c, 0x16,
+	0xe4, 0x31, 0xcf, 0x94, 0xee, 0xde, 0x01, 0xd8, 0x1c, 0x0b, 0x36, 0xda, 0xa6, 0x98, 0x5f, 0x61,
+	0x43, 0xd0, 0x2c, 0xa2, 0x33, 0x0b, 0x38, 0xc0, 0x6b, 0xf9, 0xe5, 0x64, 0x7a, 0xd0, 0x48, 0x05,
+	0x13, 0x96, 0xee, 0xd4, 0xbc, 0xf6, 0xa0, 0x83, 0xd5, 0x9a, 0xb8, 0x5a, 0x13, 0xff, 0xcb, 0x0a,
+	0x5f, 0x12, 0xe6, 0x1f, 0x58, 0x97, 0x85, 0xad, 0x9a, 0x03, 0xbc, 0xf6, 0xa0, 0xfb, 0x0a, 0x1d,
+	0x95, 0xf1, 0xc3, 0xe6, 0xf2, 0xb1, 0xa7, 0xdd, 0x3e, 0xf5, 0x80, 0xaf, 0x1c, 0xe6, 0x37, 0xd8,
+	0x62, 0x81, 0x38, 0x4f, 0xe2, 0x34, 0xce, 0x2d, 0xc3, 0x01, 0x9e, 0xe1, 0x37, 0x59, 0x20, 0x4e,
+	0xb6, 0xb3, 0xeb, 0xc2, 0x2f, 0x55, 0x4b, 0x9f, 0x8a, 0x29, 0xcf, 0x04, 0x35, 0x3f, 0x43, 0x3d,
+	0x8e, 0x64, 0x53, 0xc3, 0xd7, 0xe3, 0xc8, 0xfd, 0x2b, 0x99, 0xff, 0x41, 0x36, 0xa1, 0x89, 0x24,
+	0x69, 0x74, 0x74, 0x47, 0xca, 0xab, 0xef, 0xbc, 0x36, 0xb4, 0x0e, 0xbd, 0x55, 0xce, 0xe0, 0x1e,
+	0xc0, 0xda, 0x58, 0x30, 0xf3, 0x14, 0xd6, 0xd5, 0x31, 0x21, 0xfc, 0xd6, 0xdd, 0xe0, 0xaa, 0xa0,
+	0xfd, 0xe3, 0x7d, 0x7d, 0xb7, 0x01, 0x06, 0x3f, 0xed, 0xb7, 0x3d, 0x6e, 0xdc, 0xe3, 0x6c, 0xfc,
+	0x31, 0xae, 0x0a, 0x1a, 0x8e, 0x96, 0x6b, 0x04, 0x56, 0x6b, 0x04, 0x9e, 0xd7, 0x08, 0xdc, 0x6c,
+	0x90, 0xb6, 0xda, 0x20, 0xed, 0x61, 0x83, 0xb4, 0xb3, 0x9f, 0x2c, 0xce, 0x2f, 0xe7, 0x21, 0x9e,
+	0xf0, 0x94, 0x94, 0xef, 0x51, 0x7d, 0x7e, 0x89, 0xe8, 0x8a, 0x2c, 0xca, 0xc7, 0x99, 0x17, 0x53,
+	0x2a, 0xc2, 0x86, 0xbc, 0xc4, 0xdf, 0x2f, 0x03, 0x00, 0x31, 0xb0, 0xf2, 0xf6, 0xb9, 0x02, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Delay submits messages for execution after the provided delay. Each
+	// message should have only one signer corresponding to the sender.
+	Delay(ctx context.Context, in *MsgDelay, opts ...grpc.CallOption) (*MsgDelayResponse, error)
+	// CancelDelayed cancels the execution of delayed messages of the sender.
+	CancelDelayed(ctx context.Context, in *MsgCancelDelayed, opts ...grpc.CallOption) (*MsgCancelDelayedResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Delay(ctx context.Context, in *MsgDelay, opts ...grpc.CallOption) (*MsgDelayResponse, error) {
+	out := new(MsgDelayResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.delay.v1beta1.Msg/Delay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDelayed(ctx context.Context, in *MsgCancelDelayed, opts ...grpc.CallOption) (*MsgCancelDelayedResponse, error) {
+	out := new(MsgCancelDelayedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.delay.v1beta1.Msg/CancelDelayed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Delay submits messages for execution after the provided delay. Each
+	// message should have only one signer corresponding to the sender.
+	Delay(context.Context, *MsgDelay) (*MsgDelayResponse, error)
+	// CancelDelayed cancels the execution of delayed messages of the sender.
+	CancelDelayed(context.Context, *MsgCancelDelayed) (*MsgCancelDelayedResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Delay(ctx context.Context, req *MsgDelay) (*MsgDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delay not implemented")
+}
+func (*UnimplementedMsgServer) CancelDelayed(ctx context.Context, req *MsgCancelDelayed) (*MsgCancelDelayedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDelayed not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Delay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelay)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Delay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.delay.v1beta1.Msg/Delay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Delay(ctx, req.(*MsgDelay))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDelayed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDelayed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDelayed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.delay.v1beta1.Msg/CancelDelayed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDelayed(ctx, req.(*MsgCancelDelayed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.delay.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Delay",
+			Handler:    _Msg_Delay_Handler,
+		},
+		{
+			MethodName: "CancelDelayed",
+			Handler:    _Msg_CancelDelayed_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/delay/v1beta1/tx.proto",
+}
+
+func (m *MsgDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDelayed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDelayed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDelayed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDelayedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDelayedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDelayedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovTx(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *MsgDelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelDelayed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelDelayedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDelayed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDelayed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDelayed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDelayedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDelayedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDelayedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,17 +1,18 @@
 package rest
 
 import (
-	"fmt"
+	"context"
 	"net/http"
 
 	"github.com/gorilla/mux"
-
-	"github.com/cosmos/cosmos-sdk/x/distribution/client/common"
-	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
@@ -67,79 +68,58 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 
 // HTTP request handler to query the total rewards balance from all delegations
 func delegatorRewardsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
-		if !ok {
-			return
-		}
-
-		params := types.NewQueryDelegatorParams(delegatorAddr)
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		delegatorAddr, err := parseDelegatorAddressVar(r)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to marshal params: %s", err))
-			return
+			return nil, err
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorTotalRewards)
-		res, height, err := clientCtx.QueryWithData(route, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).DelegationTotalRewards(
+			r.Context(), &types.QueryDelegationTotalRewardsRequest{DelegatorAddress: delegatorAddr.String()}, opts...,
+		)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return types.NewQueryDelegatorTotalRewardsResponse(res.Rewards, res.Total), nil
+	})
 }
 
 // HTTP request handler to query a delegation rewards
 func delegationRewardsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		delegatorAddr, err := parseDelegatorAddressVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		delAddr := mux.Vars(r)["delegatorAddr"]
-		valAddr := mux.Vars(r)["validatorAddr"]
-
-		// query for rewards from a particular delegation
-		res, height, ok := checkResponseQueryDelegationRewards(w, clientCtx, delAddr, valAddr)
-		if !ok {
-			return
+		validatorAddr, err := parseValidatorAddressVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		// query for rewards from a particular delegation
+		return queryDelegationRewards(r.Context(), clientCtx, delegatorAddr, validatorAddr, opts...)
+	})
 }
 
 // HTTP request handler to query a delegation rewards
 func delegatorWithdrawalAddrHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
-		if !ok {
-			return
-		}
-
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		delegatorAddr, err := parseDelegatorAddressVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		bz := clientCtx.LegacyAmino.MustMarshalJSON(types.NewQueryDelegatorWithdrawAddrParams(delegatorAddr))
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/withdraw_addr", types.QuerierRoute), bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).DelegatorWithdrawAddress(
+			r.Context(), &types.QueryDelegatorWithdrawAddressRequest{DelegatorAddress: delegatorAddr.String()}, opts...,
+		)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.WithdrawAddress, nil
+	})
 }
 
 // ValidatorDistInfo defines the properties of
@@ -162,148 +142,135 @@ func NewValidatorDistInfo(operatorAddr sdk.AccAddress, rewards sdk.DecCoins,
 
 // HTTP request handler to query validator's distribution information
 func validatorInfoHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		valAddr, ok := checkValidatorAddressVar(w, r)
-		if !ok {
-			return
-		}
-
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		validatorAddr, err := parseValidatorAddressVar(r)
+		if err != nil {
+			return nil, err
 		}
 
 		// query commission
-		bz, err := common.QueryValidatorCommission(clientCtx, valAddr)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).ValidatorCommission(
+			r.Context(), &types.QueryValidatorCommissionRequest{ValidatorAddress: validatorAddr.String()}, opts...,
+		)
+		if err != nil {
+			return nil, err
 		}
 
-		var commission types.ValidatorAccumulatedCommission
-		if rest.CheckInternalServerError(w, clientCtx.LegacyAmino.UnmarshalJSON(bz, &commission)) {
-			return
+		commission := res.Commission
+		if commission.Commission == nil {
+			commission.Commission = sdk.DecCoins{}
 		}
 
 		// self bond rewards
-		delAddr := sdk.AccAddress(valAddr)
-		bz, height, ok := checkResponseQueryDelegationRewards(w, clientCtx, delAddr.String(), valAddr.String())
-		if !ok {
-			return
-		}
-
-		var rewards sdk.DecCoins
-		if rest.CheckInternalServerError(w, clientCtx.LegacyAmino.UnmarshalJSON(bz, &rewards)) {
-			return
-		}
-
-		bz, err = clientCtx.LegacyAmino.MarshalJSON(NewValidatorDistInfo(delAddr, rewards, commission))
-		if rest.CheckInternalServerError(w, err) {
-			return
+		delegatorAddr := sdk.AccAddress(validatorAddr)
+		rewards, err := queryDelegationRewards(r.Context(), clientCtx, delegatorAddr, validatorAddr, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, bz)
-	}
+		return NewValidatorDistInfo(delegatorAddr, rewards, commission), nil
+	})
 }
 
 // HTTP request handler to query validator's commission and self-delegation rewards
 func validatorRewardsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		valAddr := mux.Vars(r)["validatorAddr"]
-		validatorAddr, ok := checkValidatorAddressVar(w, r)
-		if !ok {
-			return
-		}
-
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		delAddr := sdk.AccAddress(validatorAddr).String()
-		bz, height, ok := checkResponseQueryDelegationRewards(w, clientCtx, delAddr, valAddr)
-		if !ok {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		validatorAddr, err := parseValidatorAddressVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, bz)
-	}
+		return queryDelegationRewards(r.Context(), clientCtx, sdk.AccAddress(validatorAddr), validatorAddr, opts...)
+	})
 }
 
 // HTTP request handler to query the distribution params values
 func paramsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
-		res, height, err := clientCtx.QueryWithData(route, nil)
-		if rest.CheckInternalServerError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		res, err := types.NewQueryClient(clientCtx).Params(r.Context(), &types.QueryParamsRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Params, nil
+	})
 }
 
 func communityPoolHandler(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/community_pool", types.QuerierRoute), nil)
-		if rest.CheckInternalServerError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		res, err := types.NewQueryClient(clientCtx).CommunityPool(r.Context(), &types.QueryCommunityPoolRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		var result sdk.DecCoins
-		if rest.CheckInternalServerError(w, clientCtx.LegacyAmino.UnmarshalJSON(res, &result)) {
-			return
+		if res.Pool == nil {
+			return sdk.DecCoins{}, nil
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, result)
-	}
+		return res.Pool, nil
+	})
 }
 
 // HTTP request handler to query the outstanding rewards
 func outstandingRewardsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		validatorAddr, ok := checkValidatorAddressVar(w, r)
-		if !ok {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		validatorAddr, err := parseValidatorAddressVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+		res, err := types.NewQueryClient(clientCtx).ValidatorOutstandingRewards(
+			r.Context(), &types.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: validatorAddr.String()}, opts...,
+		)
+		if err != nil {
+			return nil, err
 		}
 
-		bin := clientCtx.LegacyAmino.MustMarshalJSON(types.NewQueryValidatorOutstandingRewardsParams(validatorAddr))
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/validator_outstanding_rewards", types.QuerierRoute), bin)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		rewards := res.Rewards
+		if rewards.Rewards == nil {
+			rewards.Rewards = sdk.DecCoins{}
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
+		return rewards, nil
+	})
+}
+
+// queryDelegationRewards returns the rewards of the delegation of the delegator
+// to the validator.
+func queryDelegationRewards(
+	ctx context.Context, clientCtx client.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, opts ...grpc.CallOption,
+) (sdk.DecCoins, error) {
+	res, err := types.NewQueryClient(clientCtx).DelegationRewards(ctx, &types.QueryDelegationRewardsRequest{
+		DelegatorAddress: delegatorAddr.String(),
+		ValidatorAddress: validatorAddr.String(),
+	}, opts...)
+	if err != nil {
+		return nil, err
 	}
+
+	if res.Rewards == nil {
+		return sdk.DecCoins{}, nil
+	}
+
+	return res.Rewards, nil
 }
 
-func checkResponseQueryDelegationRewards(
-	w http.ResponseWriter, clientCtx client.Context, delAddr, valAddr string,
-) (res []byte, height int64, ok bool) {
+// parseDelegatorAddressVar returns the delegator address of the request path.
+func parseDelegatorAddressVar(r *http.Request) (sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return addr, nil
+}
 
-	res, height, err := common.QueryDelegationRewards(clientCtx, delAddr, valAddr)
-	if rest.CheckInternalServerError(w, err) {
-		return nil, 0, false
+// parseValidatorAddressVar returns the validator address of the request path.
+func parseValidatorAddressVar(r *http.Request) (sdk.ValAddress, error) {
+	addr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return res, height, true
+	return addr, nil
 }
@@ -1,7 +1,12 @@
 package types
 
 import (
+	"bytes"
 	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -212,3 +217,46 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// DecodeStoreKey decodes the key of an entry of the distribution store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	switch {
+	case bytes.HasPrefix(key, FeePoolKey):
+		return sdk.NewStoreKeyParser("FeePool", key[1:], &FeePool{}).Decode()
+
+	case bytes.HasPrefix(key, ProposerKey):
+		return sdk.NewStoreKeyParser("Proposer", key[1:], &gogotypes.BytesValue{}).Decode()
+
+	case bytes.HasPrefix(key, ValidatorOutstandingRewardsPrefix):
+		return sdk.NewStoreKeyParser("ValidatorOutstandingRewards", key[1:], &ValidatorOutstandingRewards{}).ValAddress("validator").Decode()
+
+	case bytes.HasPrefix(key, DelegatorWithdrawAddrPrefix):
+		return sdk.NewStoreKeyParser("DelegatorWithdrawAddr", key[1:], nil).AccAddress("delegator").Decode()
+
+	case bytes.HasPrefix(key, DelegatorStartingInfoPrefix):
+		return sdk.NewStoreKeyParser("DelegatorStartingInfo", key[1:], &DelegatorStartingInfo{}).
+			ValAddress("validator").AccAddress("delegator").
+			Decode()
+
+	case bytes.HasPrefix(key, ValidatorHistoricalRewardsPrefix):
+		// the periods of the historical rewards are little endian
+		return sdk.NewStoreKeyParser("ValidatorHistoricalRewards", key[1:], &ValidatorHistoricalRewards{}).
+			ValAddress("validator").
+			Field("period", 8, func(bz []byte) string { return strconv.FormatUint(binary.LittleEndian.Uint64(bz), 10) }).
+			Decode()
+
+	case bytes.HasPrefix(key, ValidatorCurrentRewardsPrefix):
+		return sdk.NewStoreKeyParser("ValidatorCurrentRewards", key[1:], &ValidatorCurrentRewards{}).ValAddress("validator").Decode()
+
+	case bytes.HasPrefix(key, ValidatorAccumulatedCommissionPrefix):
+		return sdk.NewStoreKeyParser("ValidatorAccumulatedCommission", key[1:], &ValidatorAccumulatedCommission{}).ValAddress("validator").Decode()
+
+	case bytes.HasPrefix(key, ValidatorSlashEventPrefix):
+		return sdk.NewStoreKeyParser("ValidatorSlashEvent", key[1:], &ValidatorSlashEvent{}).
+			ValAddress("validator").Uint64("height").Uint64("period").
+			Decode()
+
+	default:
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+}
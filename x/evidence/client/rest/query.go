@@ -6,11 +6,15 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
-
-	"github.com/gorilla/mux"
 )
 
 func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
@@ -26,70 +30,52 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 }
 
 func queryEvidenceHandler(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		evidenceHash := vars[RestParamEvidenceHash]
-
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		evidenceHash := mux.Vars(r)[RestParamEvidenceHash]
 		if strings.TrimSpace(evidenceHash) == "" {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "evidence hash required but not specified")
-			return
-		}
-
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+			return nil, status.Error(codes.InvalidArgument, "evidence hash required but not specified")
 		}
 
 		decodedHash, err := hex.DecodeString(evidenceHash)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid evidence hash")
-			return
+			return nil, status.Error(codes.InvalidArgument, "invalid evidence hash")
 		}
 
-		params := types.NewQueryEvidenceRequest(decodedHash)
-		bz, err := clientCtx.JSONMarshaler.MarshalJSON(params)
+		res, err := types.NewQueryClient(clientCtx).Evidence(r.Context(), types.NewQueryEvidenceRequest(decodedHash), opts...)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to marshal query params: %s", err))
-			return
+			return nil, err
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEvidence)
-		res, height, err := clientCtx.QueryWithData(route, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		var evidence exported.Evidence
+		if err := clientCtx.InterfaceRegistry.UnpackAny(res.Evidence, &evidence); err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return evidence, nil
+	})
 }
 
 func queryAllEvidenceHandler(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
 		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
-		params := types.NewQueryAllEvidenceParams(page, limit)
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
+		res, err := types.NewQueryClient(clientCtx).AllEvidence(
+			r.Context(), types.NewQueryAllEvidenceRequest(rest.NewPageRequest(page, limit)), opts...,
+		)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to marshal query params: %s", err))
-			return
+			return nil, err
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllEvidence)
-		res, height, err := clientCtx.QueryWithData(route, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		evidence := make([]exported.Evidence, len(res.Evidence))
+		for i, evidenceAny := range res.Evidence {
+			if err := clientCtx.InterfaceRegistry.UnpackAny(evidenceAny, &evidence[i]); err != nil {
+				return nil, err
+			}
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return evidence, nil
+	})
 }
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"bytes"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "evidence"
@@ -18,3 +26,14 @@ const (
 var (
 	KeyPrefixEvidence = []byte{0x00}
 )
+
+// DecodeStoreKey decodes the key of an entry of the evidence store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	if !bytes.HasPrefix(key, KeyPrefixEvidence) {
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+
+	return sdk.NewStoreKeyParser("Evidence", key[1:], &codectypes.Any{}).
+		Field("hash", len(key)-1, func(bz []byte) string { return fmt.Sprintf("%X", bz) }).
+		Decode()
+}
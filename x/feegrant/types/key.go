@@ -1,6 +1,9 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -34,3 +37,12 @@ func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
 func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
 	return append(FeeAllowanceKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
 }
+
+// DecodeStoreKey decodes the key of an entry of the feegrant store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	if !bytes.HasPrefix(key, FeeAllowanceKeyPrefix) {
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+
+	return sdk.NewStoreKeyParser("FeeAllowances", key[1:], &FeeAllowanceGrant{}).AccAddress("grantee").AccAddress("granter").Decode()
+}
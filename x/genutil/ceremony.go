@@ -0,0 +1,330 @@
+package genutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// maxGenTxSize is the maximum size in bytes of a genesis transaction accepted
+// by the genesis ceremony service.
+const maxGenTxSize = 1 << 20
+
+// GenesisCeremony coordinates the collection of genesis transactions from the
+// validators of a new chain. Each submitted gentx is validated against the
+// draft genesis, must be signed by its delegator for the chain ID of the draft
+// genesis, and is persisted to the gentx directory. The final genesis is only
+// assembled from the accepted gentxs.
+type GenesisCeremony struct {
+	mtx sync.Mutex
+
+	cdc            codec.JSONMarshaler
+	txConfig       client.TxConfig
+	genBalIterator types.GenesisBalancesIterator
+	genDoc         tmtypes.GenesisDoc
+	appState       map[string]json.RawMessage
+	genTxsDir      string
+
+	// validators maps the bech32 validator operator address of each accepted
+	// gentx to its consensus public key.
+	validators map[string]string
+	// genTxs maps the bech32 validator operator address of each accepted gentx
+	// to the gentx.
+	genTxs map[string]sdk.Tx
+	closed bool
+}
+
+// NewGenesisCeremony returns a GenesisCeremony which validates gentxs against
+// the provided draft genesis and stores them in genTxsDir.
+func NewGenesisCeremony(
+	cdc codec.JSONMarshaler, txConfig client.TxConfig,
+	genBalIterator types.GenesisBalancesIterator, genDoc tmtypes.GenesisDoc, genTxsDir string,
+) (*GenesisCeremony, error) {
+	appState, err := types.GenesisStateFromGenDoc(genDoc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal draft genesis app state: %w", err)
+	}
+
+	if err := os.MkdirAll(genTxsDir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &GenesisCeremony{
+		cdc:            cdc,
+		txConfig:       txConfig,
+		genBalIterator: genBalIterator,
+		genDoc:         genDoc,
+		appState:       appState,
+		genTxsDir:      genTxsDir,
+		validators:     make(map[string]string),
+		genTxs:         make(map[string]sdk.Tx),
+	}, nil
+}
+
+// AddGenTx validates the given JSON encoded genesis transaction against the
+// draft genesis and writes it to the gentx directory. It returns the operator
+// address of the validator created by the gentx.
+func (gc *GenesisCeremony) AddGenTx(txBz []byte) (string, error) {
+	tx, err := gc.txConfig.TxJSONDecoder()(txBz)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode gentx: %w", err)
+	}
+
+	msg, err := gc.validateGenTx(tx)
+	if err != nil {
+		return "", err
+	}
+
+	gc.mtx.Lock()
+	defer gc.mtx.Unlock()
+
+	if gc.closed {
+		return "", errors.New("genesis ceremony is closed")
+	}
+
+	if _, ok := gc.validators[msg.ValidatorAddress]; ok {
+		return "", fmt.Errorf("a gentx for validator %s was already submitted", msg.ValidatorAddress)
+	}
+
+	pk := msg.Pubkey.String()
+	for valAddr, valPk := range gc.validators {
+		if valPk == pk {
+			return "", fmt.Errorf("consensus public key is already used by validator %s", valAddr)
+		}
+	}
+
+	// re-encode the transaction so that all stored gentxs share the same format
+	bz, err := gc.txConfig.TxJSONEncoder()(tx)
+	if err != nil {
+		return "", err
+	}
+
+	file := filepath.Join(gc.genTxsDir, fmt.Sprintf("gentx-%s.json", msg.ValidatorAddress))
+	if err := ioutil.WriteFile(file, bz, 0600); err != nil {
+		return "", err
+	}
+
+	gc.validators[msg.ValidatorAddress] = pk
+	gc.genTxs[msg.ValidatorAddress] = tx
+
+	return msg.ValidatorAddress, nil
+}
+
+// Validators returns the sorted operator addresses of all validators with an
+// accepted gentx.
+func (gc *GenesisCeremony) Validators() []string {
+	gc.mtx.Lock()
+	defer gc.mtx.Unlock()
+
+	vals := make([]string, 0, len(gc.validators))
+	for valAddr := range gc.validators {
+		vals = append(vals, valAddr)
+	}
+
+	sort.Strings(vals)
+	return vals
+}
+
+// Close stops the ceremony from accepting any further gentxs.
+func (gc *GenesisCeremony) Close() {
+	gc.mtx.Lock()
+	defer gc.mtx.Unlock()
+
+	gc.closed = true
+}
+
+// Finalize closes the ceremony and assembles the final genesis application
+// state from the accepted gentxs, ignoring any other gentx file of the gentx
+// directory. Gentxs are processed in the order of their validator operator
+// addresses, making the resulting genesis deterministic for a given set of
+// submissions.
+func (gc *GenesisCeremony) Finalize() (tmtypes.GenesisDoc, error) {
+	gc.Close()
+
+	genDoc := gc.genDoc
+
+	valAddrs := gc.Validators()
+	if len(valAddrs) == 0 {
+		return genDoc, errors.New("there must be at least one genesis tx")
+	}
+
+	appGenTxs := make([]sdk.Tx, len(valAddrs))
+	gc.mtx.Lock()
+	for i, valAddr := range valAddrs {
+		appGenTxs[i] = gc.genTxs[valAddr]
+	}
+	gc.mtx.Unlock()
+
+	appGenesisState, err := types.GenesisStateFromGenDoc(genDoc)
+	if err != nil {
+		return genDoc, err
+	}
+
+	appGenesisState, err = SetGenTxsInAppGenesisState(gc.cdc, gc.txConfig.TxJSONEncoder(), appGenesisState, appGenTxs)
+	if err != nil {
+		return genDoc, err
+	}
+
+	genDoc.AppState, err = json.MarshalIndent(appGenesisState, "", "  ")
+	if err != nil {
+		return genDoc, err
+	}
+
+	return genDoc, genDoc.ValidateAndComplete()
+}
+
+// ServeHTTP implements http.Handler. It exposes the following routes:
+//
+//   GET  /genesis     returns the draft genesis
+//   GET  /validators  returns the validators with an accepted gentx
+//   POST /gentx       submits a JSON encoded genesis transaction
+func (gc *GenesisCeremony) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/genesis" && r.Method == http.MethodGet:
+		bz, err := tmjson.MarshalIndent(gc.genDoc, "", "  ")
+		if err != nil {
+			writeCeremonyError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+
+	case r.URL.Path == "/validators" && r.Method == http.MethodGet:
+		writeCeremonyJSON(w, http.StatusOK, gc.Validators())
+
+	case r.URL.Path == "/gentx" && r.Method == http.MethodPost:
+		bz, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxGenTxSize))
+		if err != nil {
+			writeCeremonyError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		valAddr, err := gc.AddGenTx(bz)
+		if err != nil {
+			writeCeremonyError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeCeremonyJSON(w, http.StatusOK, map[string]string{"validator_address": valAddr})
+
+	default:
+		writeCeremonyError(w, http.StatusNotFound, fmt.Errorf("unknown route %s %s", r.Method, r.URL.Path))
+	}
+}
+
+// validateGenTx performs stateless validation of the gentx and makes sure the
+// delegator has enough funds in the draft genesis to cover the self-delegation.
+func (gc *GenesisCeremony) validateGenTx(tx sdk.Tx) (*stakingtypes.MsgCreateValidator, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, errors.New("each genesis transaction must provide a single genesis message")
+	}
+
+	msg, ok := msgs[0].(*stakingtypes.MsgCreateValidator)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", &stakingtypes.MsgCreateValidator{}, msgs[0])
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return nil, fmt.Errorf("expected TxWithMemo, got %T", tx)
+	}
+
+	if len(memoTx.GetMemo()) == 0 {
+		return nil, errors.New("gentx memo must contain the node's address and IP")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gc.verifyGenTxSignature(tx, delAddr); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateAccountInGenesis(gc.appState, gc.genBalIterator, delAddr, sdk.NewCoins(msg.Value), gc.cdc); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// verifyGenTxSignature checks that the gentx is only signed by the delegator,
+// and that its signature is valid for the chain ID of the draft genesis. Gentxs
+// are signed with the account number and sequence 0, as the delegator account
+// does not exist before genesis.
+func (gc *GenesisCeremony) verifyGenTxSignature(tx sdk.Tx, delAddr sdk.AccAddress) error {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return fmt.Errorf("expected SigVerifiableTx, got %T", tx)
+	}
+
+	signers := sigTx.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(delAddr) {
+		return fmt.Errorf("gentx must only be signed by the delegator %s", delAddr)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	if len(sigs) != 1 {
+		return fmt.Errorf("gentx must have exactly one signature, got %d", len(sigs))
+	}
+
+	sig := sigs[0]
+	if sig.PubKey == nil || !delAddr.Equals(sdk.AccAddress(sig.PubKey.Address())) {
+		return fmt.Errorf("gentx must be signed with the public key of the delegator %s", delAddr)
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       gc.genDoc.ChainID,
+		AccountNumber: 0,
+		Sequence:      sig.Sequence,
+	}
+
+	if err := authsigning.VerifySignature(sig.PubKey, signerData, sig.Data, gc.txConfig.SignModeHandler(), tx); err != nil {
+		return fmt.Errorf("invalid gentx signature for chain %s: %w", gc.genDoc.ChainID, err)
+	}
+
+	return nil
+}
+
+func writeCeremonyJSON(w http.ResponseWriter, status int, v interface{}) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(bz)
+}
+
+func writeCeremonyError(w http.ResponseWriter, status int, err error) {
+	writeCeremonyJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,172 @@
+package genutil_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGenesisCeremony(t *testing.T) {
+	genTxsDir, err := ioutil.TempDir(os.TempDir(), "testGenesisCeremony")
+	require.NoError(t, err)
+	defer os.RemoveAll(genTxsDir)
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	genDoc := tmtypes.GenesisDoc{ChainID: "test-chain", AppState: []byte("{}")}
+
+	ceremony, err := genutil.NewGenesisCeremony(
+		encCfg.Marshaler, encCfg.TxConfig, banktypes.GenesisBalancesIterator{}, genDoc, genTxsDir,
+	)
+	require.NoError(t, err)
+
+	// malformed gentxs are rejected
+	_, err = ceremony.AddGenTx([]byte("{"))
+	require.Error(t, err)
+
+	// transactions without a MsgCreateValidator are rejected
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	txBz, err := encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	_, err = ceremony.AddGenTx(txBz)
+	require.Error(t, err)
+	require.Empty(t, ceremony.Validators())
+
+	rec := httptest.NewRecorder()
+	ceremony.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/genesis", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "test-chain")
+
+	rec = httptest.NewRecorder()
+	ceremony.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/gentx", strings.NewReader("{")))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	ceremony.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	// the genesis cannot be finalized without any gentx
+	_, err = ceremony.Finalize()
+	require.Error(t, err)
+}
+
+func TestGenesisCeremonySignedGenTxs(t *testing.T) {
+	genTxsDir, err := ioutil.TempDir(os.TempDir(), "testGenesisCeremony")
+	require.NoError(t, err)
+	defer os.RemoveAll(genTxsDir)
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	priv1, priv2, priv3 := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	addr1, addr2 := sdk.AccAddress(priv1.PubKey().Address()), sdk.AccAddress(priv2.PubKey().Address())
+
+	// the draft genesis funds the delegators of the first two validators
+	appState := simapp.NewDefaultGenesisState(encCfg.Marshaler)
+	bankGenesis := banktypes.DefaultGenesisState()
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+			Address: addr.String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		})
+	}
+	appState[banktypes.ModuleName] = encCfg.Marshaler.MustMarshalJSON(bankGenesis)
+	appStateBz, err := json.Marshal(appState)
+	require.NoError(t, err)
+	genDoc := tmtypes.GenesisDoc{ChainID: "test-chain", AppState: appStateBz}
+
+	ceremony, err := genutil.NewGenesisCeremony(
+		encCfg.Marshaler, encCfg.TxConfig, banktypes.GenesisBalancesIterator{}, genDoc, genTxsDir,
+	)
+	require.NoError(t, err)
+
+	createValidatorMsg := func(addr sdk.AccAddress) sdk.Msg {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(addr), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50),
+			stakingtypes.NewDescription("moniker", "", "", "", ""), stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+		)
+		require.NoError(t, err)
+		return msg
+	}
+
+	// gentxs signed for another chain, or not by their delegator, are rejected
+	_, err = ceremony.AddGenTx(signGenTx(t, encCfg.TxConfig, priv1, "other-chain", createValidatorMsg(addr1)))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid gentx signature")
+	_, err = ceremony.AddGenTx(signGenTx(t, encCfg.TxConfig, priv3, "test-chain", createValidatorMsg(addr1)))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "public key of the delegator")
+	require.Empty(t, ceremony.Validators())
+
+	// a gentx signed by its delegator is accepted, once
+	valAddr, err := ceremony.AddGenTx(signGenTx(t, encCfg.TxConfig, priv1, "test-chain", createValidatorMsg(addr1)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.ValAddress(addr1).String(), valAddr)
+	require.Equal(t, []string{valAddr}, ceremony.Validators())
+
+	_, err = ceremony.AddGenTx(signGenTx(t, encCfg.TxConfig, priv1, "test-chain", createValidatorMsg(addr1)))
+	require.Error(t, err)
+
+	// gentx files which were not accepted are ignored by the final genesis
+	strayGenTx := signGenTx(t, encCfg.TxConfig, priv2, "test-chain", createValidatorMsg(addr2))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(genTxsDir, "gentx-stray.json"), strayGenTx, 0600))
+
+	finalGenDoc, err := ceremony.Finalize()
+	require.NoError(t, err)
+
+	finalAppState, err := types.GenesisStateFromGenDoc(finalGenDoc)
+	require.NoError(t, err)
+	genTxs := types.GetGenesisStateFromAppState(encCfg.Marshaler, finalAppState).GenTxs
+	require.Len(t, genTxs, 1)
+
+	genTx, err := encCfg.TxConfig.TxJSONDecoder()(genTxs[0])
+	require.NoError(t, err)
+	require.Equal(t, valAddr, genTx.GetMsgs()[0].(*stakingtypes.MsgCreateValidator).ValidatorAddress)
+
+	// a finalized ceremony does not accept new gentxs, even valid ones
+	_, err = ceremony.AddGenTx(strayGenTx)
+	require.Error(t, err)
+	require.Equal(t, []string{valAddr}, ceremony.Validators())
+}
+
+// signGenTx returns the JSON encoded gentx of the message, signed with the key
+// for the chain ID.
+func signGenTx(t *testing.T, txConfig client.TxConfig, priv cryptotypes.PrivKey, chainID string, msg sdk.Msg) []byte {
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetMemo("nodeid@127.0.0.1:26656")
+	txBuilder.SetGasLimit(200000)
+
+	signMode := txConfig.SignModeHandler().DefaultMode()
+	sigData := &signing.SingleSignatureData{SignMode: signMode}
+	sig := signing.SignatureV2{PubKey: priv.PubKey(), Data: sigData}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, authsigning.SignerData{ChainID: chainID}, txBuilder.GetTx())
+	require.NoError(t, err)
+	sigData.Signature, err = priv.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	bz, err := txConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagListenAddr         = "listen-addr"
+	flagExpectedValidators = "expected-validators"
+)
+
+// GenesisCeremonyCmd returns the cobra command that runs an HTTP service
+// collecting signed genesis transactions from the validators of a new chain.
+func GenesisCeremonyCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis-ceremony",
+		Short: "Run a service collecting genesis txs over the network and output the final genesis.json file",
+		Long: `Run an HTTP service that collects signed genesis transactions from validators.
+
+Every submitted gentx is validated against the draft genesis found at
+[--home]/config/genesis.json, must be signed by its delegator for the chain ID
+of the draft genesis, and is stored in the gentx directory. Once the expected
+number of validators have submitted their gentx, or when the process receives
+SIGINT/SIGTERM, the service stops and the final genesis is assembled
+deterministically from the accepted gentxs and written in place of the draft
+genesis.
+
+The following routes are exposed:
+
+  GET  /genesis     returns the draft genesis
+  GET  /validators  returns the validators with an accepted gentx
+  POST /gentx       submits a JSON encoded genesis transaction
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config.SetRoot(clientCtx.HomeDir)
+
+			genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis doc from file")
+			}
+
+			genTxsDir, _ := cmd.Flags().GetString(flagGenTxDir)
+			if genTxsDir == "" {
+				genTxsDir = filepath.Join(config.RootDir, "config", "gentx")
+			}
+
+			ceremony, err := genutil.NewGenesisCeremony(
+				clientCtx.JSONMarshaler, clientCtx.TxConfig, genBalIterator, *genDoc, genTxsDir,
+			)
+			if err != nil {
+				return err
+			}
+
+			listenAddr, _ := cmd.Flags().GetString(flagListenAddr)
+			expected, _ := cmd.Flags().GetUint32(flagExpectedValidators)
+
+			listener, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				return err
+			}
+
+			srv := &http.Server{Handler: ceremony}
+			errCh := make(chan error, 1)
+
+			go func() {
+				if err := srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+					errCh <- err
+				}
+			}()
+
+			cmd.PrintErrf("Collecting genesis transactions for chain %s on %s\n", genDoc.ChainID, listener.Addr())
+
+			if err := waitForGenTxs(ceremony, expected, errCh); err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := srv.Shutdown(ctx); err != nil {
+				return err
+			}
+
+			finalGenDoc, err := ceremony.Finalize()
+			if err != nil {
+				return errors.Wrap(err, "failed to assemble final genesis")
+			}
+
+			if err := genutil.ExportGenesisFile(&finalGenDoc, config.GenesisFile()); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("Final genesis with %d validator(s) written to %q\n", len(ceremony.Validators()), config.GenesisFile())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagGenTxDir, "", "override default \"gentx\" directory in which to store the collected genesis transactions; default [--home]/config/gentx/")
+	cmd.Flags().String(flagListenAddr, "0.0.0.0:26680", "The address the genesis ceremony service listens on")
+	cmd.Flags().Uint32(flagExpectedValidators, 0, "Number of gentxs after which the ceremony is closed; if 0, collect until the process is interrupted")
+
+	return cmd
+}
+
+// waitForGenTxs blocks until the expected number of validators have submitted
+// a gentx, the process is interrupted or the server fails.
+func waitForGenTxs(ceremony *genutil.GenesisCeremony, expected uint32, errCh <-chan error) error {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-errCh:
+			return err
+
+		case <-sigs:
+			ceremony.Close()
+			return nil
+
+		case <-ticker.C:
+			if expected > 0 && len(ceremony.Validators()) >= int(expected) {
+				ceremony.Close()
+				return nil
+			}
+		}
+	}
+}
@@ -1,14 +1,18 @@
 package rest
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	gcutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -27,447 +31,303 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 }
 
 func queryParamsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		paramType := vars[RestParamsType]
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		paramType := mux.Vars(r)[RestParamsType]
 
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/gov/%s/%s", types.QueryParams, paramType), nil)
-		if rest.CheckNotFoundError(w, err) {
-			return
-		}
-
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
-}
-
-func queryProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		strProposalID := vars[RestProposalID]
-
-		if len(strProposalID) == 0 {
-			err := errors.New("proposalId required but not specified")
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
+		res, err := types.NewQueryClient(clientCtx).Params(r.Context(), &types.QueryParamsRequest{ParamsType: paramType}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, strProposalID)
-		if !ok {
-			return
-		}
+		switch paramType {
+		case types.ParamDeposit:
+			return res.DepositParams, nil
 
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
+		case types.ParamVoting:
+			return res.VotingParams, nil
 
-		params := types.NewQueryProposalParams(proposalID)
-
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		default:
+			return res.TallyParams, nil
 		}
+	})
+}
 
-		res, height, err := clientCtx.QueryWithData("custom/gov/proposal", bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+func queryProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		proposalID, err := parseProposalIDVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return queryProposal(r, clientCtx, proposalID, opts...)
+	})
 }
 
 func queryDepositsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		strProposalID := vars[RestProposalID]
-
-		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, strProposalID)
-		if !ok {
-			return
-		}
-
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		params := types.NewQueryProposalParams(proposalID)
-
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		res, _, err := clientCtx.QueryWithData("custom/gov/proposal", bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		proposalID, err := parseProposalIDVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		var proposal types.Proposal
-		if rest.CheckInternalServerError(w, clientCtx.LegacyAmino.UnmarshalJSON(res, &proposal)) {
-			return
+		proposal, err := queryProposal(r, clientCtx, proposalID, opts...)
+		if err != nil {
+			return nil, err
 		}
 
 		// For inactive proposals we must query the txs directly to get the deposits
 		// as they're no longer in state.
-		propStatus := proposal.Status
-		if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
-			res, err = gcutils.QueryDepositsByTxQuery(clientCtx, params)
-		} else {
-			res, _, err = clientCtx.QueryWithData("custom/gov/deposits", bz)
+		if !isActiveProposal(proposal) {
+			return gcutils.QueryDepositsByTxQuery(clientCtx, types.NewQueryProposalParams(proposalID))
 		}
 
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
-
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
-}
+		deposits := types.Deposits{}
+		pageReq := &query.PageRequest{}
 
-func queryProposerHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		strProposalID := vars[RestProposalID]
+		for {
+			res, err := types.NewQueryClient(clientCtx).Deposits(r.Context(), &types.QueryDepositsRequest{ProposalId: proposalID, Pagination: pageReq}, opts...)
+			if err != nil {
+				return nil, err
+			}
 
-		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, strProposalID)
-		if !ok {
-			return
-		}
+			deposits = append(deposits, res.Deposits...)
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				return deposits, nil
+			}
 
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
 		}
+	})
+}
 
-		res, err := gcutils.QueryProposerByTxQuery(clientCtx, proposalID)
-		if rest.CheckInternalServerError(w, err) {
-			return
+func queryProposerHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, _ ...grpc.CallOption) (interface{}, error) {
+		proposalID, err := parseProposalIDVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return gcutils.QueryProposerByTxQuery(clientCtx, proposalID)
+	})
 }
 
 func queryDepositHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		strProposalID := vars[RestProposalID]
-		bechDepositorAddr := vars[RestDepositor]
-
-		if len(strProposalID) == 0 {
-			err := errors.New("proposalId required but not specified")
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-
-		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, strProposalID)
-		if !ok {
-			return
-		}
-
-		if len(bechDepositorAddr) == 0 {
-			err := errors.New("depositor address required but not specified")
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-
-		depositorAddr, err := sdk.AccAddressFromBech32(bechDepositorAddr)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		proposalID, err := parseProposalIDVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		params := types.NewQueryDepositParams(proposalID, depositorAddr)
-
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		depositorAddr, err := parseAddressVar(r, RestDepositor)
+		if err != nil {
+			return nil, err
 		}
 
-		res, _, err := clientCtx.QueryWithData("custom/gov/deposit", bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).Deposit(r.Context(), &types.QueryDepositRequest{ProposalId: proposalID, Depositor: depositorAddr.String()}, opts...)
+		if err == nil {
+			return res.Deposit, nil
 		}
 
-		var deposit types.Deposit
-		if rest.CheckBadRequestError(w, clientCtx.LegacyAmino.UnmarshalJSON(res, &deposit)) {
-			return
+		if status.Code(err) != codes.InvalidArgument {
+			return nil, err
 		}
 
-		// For an empty deposit, either the proposal does not exist or is inactive in
+		// Without a deposit, either the proposal does not exist or is inactive in
 		// which case the deposit would be removed from state and should be queried
 		// for directly via a txs query.
-		if deposit.Empty() {
-			bz, err := clientCtx.LegacyAmino.MarshalJSON(types.NewQueryProposalParams(proposalID))
-			if rest.CheckBadRequestError(w, err) {
-				return
-			}
-
-			res, _, err = clientCtx.QueryWithData("custom/gov/proposal", bz)
-			if err != nil || len(res) == 0 {
-				err := fmt.Errorf("proposalID %d does not exist", proposalID)
-				rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
-				return
-			}
-
-			res, err = gcutils.QueryDepositByTxQuery(clientCtx, params)
-			if rest.CheckInternalServerError(w, err) {
-				return
-			}
+		if err := checkProposalExists(r, clientCtx, proposalID, opts...); err != nil {
+			return nil, err
 		}
 
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return gcutils.QueryDepositByTxQuery(clientCtx, types.NewQueryDepositParams(proposalID, depositorAddr))
+	})
 }
 
 func queryVoteHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		strProposalID := vars[RestProposalID]
-		bechVoterAddr := vars[RestVoter]
-
-		if len(strProposalID) == 0 {
-			err := errors.New("proposalId required but not specified")
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-
-		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, strProposalID)
-		if !ok {
-			return
-		}
-
-		if len(bechVoterAddr) == 0 {
-			err := errors.New("voter address required but not specified")
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-
-		voterAddr, err := sdk.AccAddressFromBech32(bechVoterAddr)
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		proposalID, err := parseProposalIDVar(r)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
+			return nil, err
 		}
 
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		params := types.NewQueryVoteParams(proposalID, voterAddr)
-
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		voterAddr, err := parseAddressVar(r, RestVoter)
+		if err != nil {
+			return nil, err
 		}
 
-		res, _, err := clientCtx.QueryWithData("custom/gov/vote", bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).Vote(r.Context(), &types.QueryVoteRequest{ProposalId: proposalID, Voter: voterAddr.String()}, opts...)
+		if err == nil {
+			return res.Vote, nil
 		}
 
-		var vote types.Vote
-		if rest.CheckBadRequestError(w, clientCtx.LegacyAmino.UnmarshalJSON(res, &vote)) {
-			return
+		if status.Code(err) != codes.InvalidArgument {
+			return nil, err
 		}
 
-		// For an empty vote, either the proposal does not exist or is inactive in
+		// Without a vote, either the proposal does not exist or is inactive in
 		// which case the vote would be removed from state and should be queried for
 		// directly via a txs query.
-		if vote.Empty() {
-			bz, err := clientCtx.LegacyAmino.MarshalJSON(types.NewQueryProposalParams(proposalID))
-			if rest.CheckBadRequestError(w, err) {
-				return
-			}
-
-			res, _, err = clientCtx.QueryWithData("custom/gov/proposal", bz)
-			if err != nil || len(res) == 0 {
-				err := fmt.Errorf("proposalID %d does not exist", proposalID)
-				rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
-				return
-			}
-
-			res, err = gcutils.QueryVoteByTxQuery(clientCtx, params)
-			if rest.CheckInternalServerError(w, err) {
-				return
-			}
+		if err := checkProposalExists(r, clientCtx, proposalID, opts...); err != nil {
+			return nil, err
 		}
 
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return gcutils.QueryVoteByTxQuery(clientCtx, types.NewQueryVoteParams(proposalID, voterAddr))
+	})
 }
 
-// todo: Split this functionality into helper functions to remove the above
 func queryVotesOnProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
 		_, page, limit, err := rest.ParseHTTPArgs(r)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		vars := mux.Vars(r)
-		strProposalID := vars[RestProposalID]
-
-		if len(strProposalID) == 0 {
-			err := errors.New("proposalId required but not specified")
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-
-		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, strProposalID)
-		if !ok {
-			return
-		}
-
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(types.NewQueryProposalParams(proposalID))
-		if rest.CheckBadRequestError(w, err) {
-			return
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
-		res, _, err := clientCtx.QueryWithData("custom/gov/proposal", bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		proposalID, err := parseProposalIDVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		var proposal types.Proposal
-		if rest.CheckInternalServerError(w, clientCtx.LegacyAmino.UnmarshalJSON(res, &proposal)) {
-			return
+		proposal, err := queryProposal(r, clientCtx, proposalID, opts...)
+		if err != nil {
+			return nil, err
 		}
 
 		// For inactive proposals we must query the txs directly to get the votes
 		// as they're no longer in state.
-		params := types.NewQueryProposalVotesParams(proposalID, page, limit)
-
-		propStatus := proposal.Status
-		if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
-			res, err = gcutils.QueryVotesByTxQuery(clientCtx, params)
-		} else {
-			bz, err = clientCtx.LegacyAmino.MarshalJSON(params)
-			if rest.CheckBadRequestError(w, err) {
-				return
-			}
+		if !isActiveProposal(proposal) {
+			return gcutils.QueryVotesByTxQuery(clientCtx, types.NewQueryProposalVotesParams(proposalID, page, limit))
+		}
 
-			res, _, err = clientCtx.QueryWithData("custom/gov/votes", bz)
+		res, err := types.NewQueryClient(clientCtx).Votes(r.Context(), &types.QueryVotesRequest{ProposalId: proposalID, Pagination: rest.NewPageRequest(page, limit)}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		if rest.CheckInternalServerError(w, err) {
-			return
+		if res.Votes == nil {
+			return types.Votes{}, nil
 		}
 
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Votes, nil
+	})
 }
 
 // HTTP request handler to query list of governance proposals
 func queryProposalsWithParameterFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
 		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
-		var (
-			voterAddr      sdk.AccAddress
-			depositorAddr  sdk.AccAddress
-			proposalStatus types.ProposalStatus
-		)
+		req := &types.QueryProposalsRequest{Pagination: rest.NewPageRequest(page, limit)}
 
 		if v := r.URL.Query().Get(RestVoter); len(v) != 0 {
-			voterAddr, err = sdk.AccAddressFromBech32(v)
-			if rest.CheckBadRequestError(w, err) {
-				return
+			if _, err := sdk.AccAddressFromBech32(v); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
+
+			req.Voter = v
 		}
 
 		if v := r.URL.Query().Get(RestDepositor); len(v) != 0 {
-			depositorAddr, err = sdk.AccAddressFromBech32(v)
-			if rest.CheckBadRequestError(w, err) {
-				return
+			if _, err := sdk.AccAddressFromBech32(v); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
+
+			req.Depositor = v
 		}
 
 		if v := r.URL.Query().Get(RestProposalStatus); len(v) != 0 {
-			proposalStatus, err = types.ProposalStatusFromString(gcutils.NormalizeProposalStatus(v))
-			if rest.CheckBadRequestError(w, err) {
-				return
+			req.ProposalStatus, err = types.ProposalStatusFromString(gcutils.NormalizeProposalStatus(v))
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
 		}
 
-		params := types.NewQueryProposalsParams(page, limit, proposalStatus, voterAddr, depositorAddr)
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).Proposals(r.Context(), req, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposals)
-		res, height, err := clientCtx.QueryWithData(route, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		if res.Proposals == nil {
+			return types.Proposals{}, nil
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return types.Proposals(res.Proposals), nil
+	})
 }
 
-// todo: Split this functionality into helper functions to remove the above
 func queryTallyOnProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		strProposalID := vars[RestProposalID]
-
-		if len(strProposalID) == 0 {
-			err := errors.New("proposalId required but not specified")
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		proposalID, err := parseProposalIDVar(r)
+		if err != nil {
+			return nil, err
 		}
 
-		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, strProposalID)
-		if !ok {
-			return
+		res, err := types.NewQueryClient(clientCtx).TallyResult(r.Context(), &types.QueryTallyResultRequest{ProposalId: proposalID}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
+		return res.Tally, nil
+	})
+}
 
-		params := types.NewQueryProposalParams(proposalID)
+// queryProposal returns the proposal of the given id, or a NotFound error if
+// it does not exist.
+func queryProposal(r *http.Request, clientCtx client.Context, proposalID uint64, opts ...grpc.CallOption) (types.Proposal, error) {
+	res, err := types.NewQueryClient(clientCtx).Proposal(r.Context(), &types.QueryProposalRequest{ProposalId: proposalID}, opts...)
+	if err != nil {
+		return types.Proposal{}, err
+	}
 
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
+	return res.Proposal, nil
+}
 
-		res, height, err := clientCtx.QueryWithData("custom/gov/tally", bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
+// checkProposalExists returns a NotFound error if the proposal of the given id
+// does not exist.
+func checkProposalExists(r *http.Request, clientCtx client.Context, proposalID uint64, opts ...grpc.CallOption) error {
+	_, err := queryProposal(r, clientCtx, proposalID, opts...)
+	if status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.NotFound, "proposalID %d does not exist", proposalID)
+	}
+
+	return err
+}
+
+// isActiveProposal returns whether the deposits and votes of the proposal are
+// still in state.
+func isActiveProposal(proposal types.Proposal) bool {
+	return proposal.Status == types.StatusVotingPeriod || proposal.Status == types.StatusDepositPeriod
+}
+
+// parseProposalIDVar returns the proposal id of the request path.
+func parseProposalIDVar(r *http.Request) (uint64, error) {
+	strProposalID := mux.Vars(r)[RestProposalID]
+	if len(strProposalID) == 0 {
+		return 0, status.Error(codes.InvalidArgument, "proposalId required but not specified")
+	}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
+	proposalID, err := strconv.ParseUint(strProposalID, 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "'%s' is not a valid uint64", strProposalID)
 	}
+
+	return proposalID, nil
+}
+
+// parseAddressVar returns the account address of the request path variable of
+// the given name.
+func parseAddressVar(r *http.Request, name string) (sdk.AccAddress, error) {
+	bech32Addr := mux.Vars(r)[name]
+	if len(bech32Addr) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s address required but not specified", name)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(bech32Addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return addr, nil
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"time"
@@ -161,3 +162,29 @@ func splitKeyWithAddress(key []byte) (proposalID uint64, addr sdk.AccAddress) {
 	addr = sdk.AccAddress(key[10:])
 	return
 }
+
+// DecodeStoreKey decodes the key of an entry of the gov store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	switch {
+	case bytes.HasPrefix(key, ProposalsKeyPrefix):
+		return sdk.NewStoreKeyParser("Proposals", key[1:], &Proposal{}).Uint64("proposal_id").Decode()
+
+	case bytes.HasPrefix(key, ActiveProposalQueuePrefix):
+		return sdk.NewStoreKeyParser("ActiveProposalQueue", key[1:], nil).Time("end_time").Uint64("proposal_id").Decode()
+
+	case bytes.HasPrefix(key, InactiveProposalQueuePrefix):
+		return sdk.NewStoreKeyParser("InactiveProposalQueue", key[1:], nil).Time("end_time").Uint64("proposal_id").Decode()
+
+	case bytes.HasPrefix(key, ProposalIDKey):
+		return sdk.NewStoreKeyParser("ProposalID", key[1:], nil).Decode()
+
+	case bytes.HasPrefix(key, DepositsKeyPrefix):
+		return sdk.NewStoreKeyParser("Deposits", key[1:], &Deposit{}).Uint64("proposal_id").AccAddress("depositor").Decode()
+
+	case bytes.HasPrefix(key, VotesKeyPrefix):
+		return sdk.NewStoreKeyParser("Votes", key[1:], &Vote{}).Uint64("proposal_id").AccAddress("voter").Decode()
+
+	default:
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+}
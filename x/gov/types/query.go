@@ -0,0 +1,16 @@
+package types
+
+import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+func (m *QueryProposalResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return m.Proposal.UnpackInterfaces(unpacker)
+}
+
+func (m *QueryProposalsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return Proposals(m.Proposals).UnpackInterfaces(unpacker)
+}
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueryProposalResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryProposalsResponse{}
+)
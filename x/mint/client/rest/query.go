@@ -1,10 +1,10 @@
 package rest
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -29,58 +29,34 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 }
 
 func queryParamsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
-
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		res, height, err := clientCtx.QueryWithData(route, nil)
-		if rest.CheckInternalServerError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		res, err := types.NewQueryClient(clientCtx).Params(r.Context(), &types.QueryParamsRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Params, nil
+	})
 }
 
 func queryInflationHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInflation)
-
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		res, err := types.NewQueryClient(clientCtx).Inflation(r.Context(), &types.QueryInflationRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		res, height, err := clientCtx.QueryWithData(route, nil)
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
-
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Inflation, nil
+	})
 }
 
 func queryAnnualProvisionsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAnnualProvisions)
-
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		res, height, err := clientCtx.QueryWithData(route, nil)
-		if rest.CheckInternalServerError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		res, err := types.NewQueryClient(clientCtx).AnnualProvisions(r.Context(), &types.QueryAnnualProvisionsRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.AnnualProvisions, nil
+	})
 }
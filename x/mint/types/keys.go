@@ -1,5 +1,12 @@
 package types
 
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // MinterKey is the key to use for the keeper store.
 var MinterKey = []byte{0x00}
 
@@ -18,3 +25,12 @@ const (
 	QueryInflation        = "inflation"
 	QueryAnnualProvisions = "annual_provisions"
 )
+
+// DecodeStoreKey decodes the key of an entry of the mint store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	if !bytes.HasPrefix(key, MinterKey) {
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+
+	return sdk.NewStoreKeyParser("Minter", key[1:], &Minter{}).Decode()
+}
@@ -13,6 +13,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	"github.com/cosmos/cosmos-sdk/x/params/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
 type IntegrationTestSuite struct {
@@ -82,6 +83,19 @@ value: "100"`,
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryFeatureActivationsCmd() {
+	val := s.network.Validators[0]
+
+	cmd := cli.NewQueryFeatureActivationsCmd()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var res proposal.QueryFeatureActivationsResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Empty(res.Activations)
+	s.Require().Positive(res.Height)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
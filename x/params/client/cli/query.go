@@ -19,7 +19,10 @@ func NewQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(NewQuerySubspaceParamsCmd())
+	cmd.AddCommand(
+		NewQuerySubspaceParamsCmd(),
+		NewQueryFeatureActivationsCmd(),
+	)
 
 	return cmd
 }
@@ -52,3 +55,31 @@ func NewQuerySubspaceParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewQueryFeatureActivationsCmd returns a CLI command handler for querying the
+// protocol feature activation schedule.
+func NewQueryFeatureActivationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feature-activations",
+		Short: "Query the protocol feature activation schedule",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := proposal.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeatureActivations(cmd.Context(), &proposal.QueryFeatureActivationsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -24,5 +25,8 @@ func ConsensusParamsKeyTable() types.KeyTable {
 		types.NewParamSetPair(
 			baseapp.ParamStoreKeyValidatorParams, tmproto.ValidatorParams{}, baseapp.ValidateValidatorParams,
 		),
+		types.NewParamSetPair(
+			baseapp.ParamStoreKeyFeatureActivations, sdk.FeatureActivations{}, baseapp.ValidateFeatureActivations,
+		),
 	)
 }
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
@@ -34,3 +35,28 @@ func (k Keeper) Params(c context.Context, req *proposal.QueryParamsRequest) (*pr
 
 	return &proposal.QueryParamsResponse{Param: param}, nil
 }
+
+// FeatureActivations returns the protocol feature activation schedule
+func (k Keeper) FeatureActivations(c context.Context, req *proposal.QueryFeatureActivationsRequest) (*proposal.QueryFeatureActivationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &proposal.QueryFeatureActivationsResponse{Height: ctx.BlockHeight()}
+
+	ss, ok := k.GetSubspace(baseapp.Paramspace)
+	if !ok || !ss.Has(ctx, baseapp.ParamStoreKeyFeatureActivations) {
+		return res, nil
+	}
+
+	var features sdk.FeatureActivations
+	ss.Get(ctx, baseapp.ParamStoreKeyFeatureActivations, &features)
+
+	res.Activations = make([]proposal.FeatureActivation, len(features))
+	for i, f := range features {
+		res.Activations[i] = proposal.FeatureActivation{Name: f.Name, Height: f.Height}
+	}
+
+	return res, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
@@ -84,3 +85,21 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryFeatureActivations() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.FeatureActivations(ctx, &proposal.QueryFeatureActivationsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Activations)
+	suite.Require().Equal(suite.ctx.BlockHeight(), res.Height)
+
+	ss, ok := suite.app.ParamsKeeper.GetSubspace(baseapp.Paramspace)
+	suite.Require().True(ok)
+	ss.Set(suite.ctx, baseapp.ParamStoreKeyFeatureActivations, sdk.FeatureActivations{{Name: "foo", Height: 10}, {Name: "bar", Height: 20}})
+
+	res, err = suite.queryClient.FeatureActivations(ctx, &proposal.QueryFeatureActivationsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proposal.FeatureActivation{{Name: "foo", Height: 10}, {Name: "bar", Height: 20}}, res.Activations)
+}
@@ -3,10 +3,12 @@ package params
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
@@ -34,6 +36,14 @@ func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
 		)
 
+		if c.Subspace == baseapp.Paramspace && c.Key == string(baseapp.ParamStoreKeyFeatureActivations) {
+			if err := updateFeatureActivations(ctx, ss, []byte(c.Value)); err != nil {
+				return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+			}
+
+			continue
+		}
+
 		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
 			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
 		}
@@ -41,3 +51,24 @@ func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal
 
 	return nil
 }
+
+// updateFeatureActivations updates the feature activation schedule, validating
+// the update against the current schedule at the current block height so that
+// active features cannot be rescheduled and no feature is scheduled in the past.
+func updateFeatureActivations(ctx sdk.Context, ss types.Subspace, value []byte) error {
+	var prev, next sdk.FeatureActivations
+	ss.GetIfExists(ctx, baseapp.ParamStoreKeyFeatureActivations, &prev)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := ss.Update(cacheCtx, baseapp.ParamStoreKeyFeatureActivations, value); err != nil {
+		return err
+	}
+
+	ss.Get(cacheCtx, baseapp.ParamStoreKeyFeatureActivations, &next)
+	if err := prev.ValidateUpdate(next, ctx.BlockHeight()); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
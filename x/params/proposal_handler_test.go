@@ -10,6 +10,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -132,3 +133,40 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestProposalHandlerFeatureActivations(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(baseapp.Paramspace).WithKeyTable(keeper.ConsensusParamsKeyTable())
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	update := func(ctx sdk.Context, value string) error {
+		tp := testProposal(proposal.NewParamChange(baseapp.Paramspace, string(baseapp.ParamStoreKeyFeatureActivations), value))
+		return hdlr(ctx, tp)
+	}
+	schedule := func(ctx sdk.Context) sdk.FeatureActivations {
+		var features sdk.FeatureActivations
+		ss.GetIfExists(ctx, baseapp.ParamStoreKeyFeatureActivations, &features)
+		return features
+	}
+
+	ctx := input.ctx.WithBlockHeight(5)
+	require.Error(t, update(ctx, `[{"name":"foo","height":"20"},{"name":"bar","height":"10"}]`))
+	require.Error(t, update(ctx, `[{"name":"foo","height":"5"}]`))
+	require.Empty(t, schedule(ctx))
+
+	expected := sdk.FeatureActivations{{Name: "foo", Height: 10}, {Name: "bar", Height: 20}}
+	require.NoError(t, update(ctx, `[{"name":"foo","height":"10"},{"name":"bar","height":"20"}]`))
+	require.Equal(t, expected, schedule(ctx))
+
+	// foo is active from height 10 on and can no longer be rescheduled or
+	// removed, while bar is still pending
+	ctx = input.ctx.WithBlockHeight(15)
+	require.Error(t, update(ctx, `[{"name":"bar","height":"20"},{"name":"foo","height":"30"}]`))
+	require.Error(t, update(ctx, `[{"name":"bar","height":"20"}]`))
+	require.Error(t, update(ctx, `[{"name":"foo","height":"10"},{"name":"bar","height":"12"}]`))
+	require.Equal(t, expected, schedule(ctx))
+
+	expected = sdk.FeatureActivations{{Name: "foo", Height: 10}, {Name: "bar", Height: 25}}
+	require.NoError(t, update(ctx, `[{"name":"foo","height":"10"},{"name":"bar","height":"25"}]`))
+	require.Equal(t, expected, schedule(ctx))
+}
@@ -123,9 +123,64 @@ func (m *ParamChange) GetValue() string {
 	return ""
 }
 
+// FeatureActivation defines the block height from which a named protocol
+// feature is enabled.
+type FeatureActivation struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FeatureActivation) Reset()         { *m = FeatureActivation{} }
+func (m *FeatureActivation) String() string { return proto.CompactTextString(m) }
+func (*FeatureActivation) ProtoMessage()    {}
+func (*FeatureActivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53a944ecb0483e4c, []int{2}
+}
+func (m *FeatureActivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureActivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeatureActivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureActivation.Merge(m, src)
+}
+func (m *FeatureActivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureActivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureActivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureActivation proto.InternalMessageInfo
+
+func (m *FeatureActivation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeatureActivation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ParameterChangeProposal)(nil), "cosmos.params.v1beta1.ParameterChangeProposal")
 	proto.RegisterType((*ParamChange)(nil), "cosmos.params.v1beta1.ParamChange")
+	proto.RegisterType((*FeatureActivation)(nil), "cosmos.params.v1beta1.FeatureActivation")
 }
 
 func init() {
@@ -133,26 +188,28 @@ func init() {
 }
 
 var fileDescriptor_53a944ecb0483e4c = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0x84, 0x72, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x21, 0x6a, 0xf4, 0xa0, 0x82,
-	0x50, 0x35, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x15, 0xfa, 0x20, 0x16, 0x44, 0xb1, 0xd2,
-	0x7c, 0x46, 0x2e, 0xf1, 0x00, 0x90, 0xc2, 0xd4, 0x92, 0xd4, 0x22, 0xe7, 0x8c, 0xc4, 0xbc, 0xf4,
-	0xd4, 0x80, 0xa2, 0xfc, 0x82, 0xfc, 0xe2, 0xc4, 0x1c, 0x21, 0x11, 0x2e, 0xd6, 0x92, 0xcc, 0x92,
-	0x9c, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x08, 0x47, 0x48, 0x81, 0x8b, 0x3b, 0x25,
-	0xb5, 0x38, 0xb9, 0x28, 0xb3, 0xa0, 0x24, 0x33, 0x3f, 0x4f, 0x82, 0x09, 0x2c, 0x87, 0x2c, 0x24,
-	0xe4, 0xc4, 0xc5, 0x9e, 0x0c, 0x36, 0xa9, 0x58, 0x82, 0x59, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x49,
-	0x0f, 0xab, 0x93, 0xf4, 0xc0, 0x16, 0x43, 0x2c, 0x75, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08,
-	0xa6, 0xd1, 0x8a, 0xa3, 0x63, 0x81, 0x3c, 0xc3, 0x8c, 0x05, 0xf2, 0x0c, 0x4a, 0xe1, 0x5c, 0xdc,
-	0x48, 0xea, 0x84, 0xa4, 0xb8, 0x38, 0x8a, 0x4b, 0x93, 0x8a, 0x0b, 0x12, 0x93, 0x61, 0xee, 0x82,
-	0xf3, 0x85, 0x04, 0xb8, 0x98, 0xb3, 0x53, 0x2b, 0xa1, 0x4e, 0x02, 0x31, 0x41, 0x5e, 0x28, 0x4b,
-	0xcc, 0x29, 0x4d, 0x95, 0x60, 0x86, 0x78, 0x01, 0xcc, 0xb1, 0x62, 0x01, 0x19, 0xec, 0x14, 0xb4,
-	0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7,
-	0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa4,
-	0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x03, 0x1d, 0x42, 0xe9, 0x16,
-	0xa7, 0x64, 0xeb, 0x57, 0xc0, 0x62, 0xa0, 0xa4, 0xb2, 0x20, 0xb5, 0x58, 0xbf, 0x00, 0x1a, 0x64,
-	0x49, 0x6c, 0xe0, 0x50, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x26, 0x09, 0x3d, 0xa8,
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x31, 0x4f, 0xf3, 0x30,
+	0x14, 0x8c, 0xbf, 0xf4, 0x2b, 0xc5, 0x5d, 0xc0, 0x2a, 0x10, 0x75, 0x70, 0xab, 0x4c, 0x5d, 0x48,
+	0x54, 0x60, 0xea, 0x82, 0x28, 0x12, 0x73, 0x95, 0x05, 0x89, 0xcd, 0x49, 0xad, 0x24, 0x6a, 0x53,
+	0x5b, 0xb1, 0x53, 0xd1, 0x7f, 0xc0, 0xc8, 0xc8, 0x46, 0x47, 0x7e, 0x4a, 0xc7, 0x8e, 0x4c, 0x08,
+	0x25, 0x7f, 0x04, 0xc5, 0x76, 0x50, 0x07, 0x26, 0xbf, 0x7b, 0x3e, 0xdf, 0xbb, 0xe7, 0x83, 0x6e,
+	0xc4, 0x44, 0xc6, 0x84, 0xcf, 0x49, 0x4e, 0x32, 0xe1, 0xaf, 0xc7, 0x21, 0x95, 0x64, 0x6c, 0xa0,
+	0xc7, 0x73, 0x26, 0x19, 0x3a, 0xd3, 0x1c, 0xcf, 0x34, 0x0d, 0xa7, 0xdf, 0x8b, 0x59, 0xcc, 0x14,
+	0xc3, 0xaf, 0x2b, 0x4d, 0x76, 0xdf, 0x01, 0xbc, 0x98, 0xd5, 0x44, 0x2a, 0x69, 0x7e, 0x9f, 0x90,
+	0x55, 0x4c, 0x67, 0x39, 0xe3, 0x4c, 0x90, 0x25, 0xea, 0xc1, 0xff, 0x32, 0x95, 0x4b, 0xea, 0x80,
+	0x21, 0x18, 0x1d, 0x07, 0x1a, 0xa0, 0x21, 0xec, 0xce, 0xa9, 0x88, 0xf2, 0x94, 0xcb, 0x94, 0xad,
+	0x9c, 0x7f, 0xea, 0xee, 0xb0, 0x85, 0xa6, 0xf0, 0x28, 0x52, 0x4a, 0xc2, 0xb1, 0x87, 0xf6, 0xa8,
+	0x7b, 0xe5, 0x7a, 0x7f, 0x5a, 0xf2, 0xd4, 0x60, 0x3d, 0x74, 0xda, 0xda, 0x7d, 0x0d, 0xac, 0xa0,
+	0x79, 0x38, 0xe9, 0xbc, 0x6c, 0x07, 0xd6, 0xdb, 0x76, 0x60, 0xb9, 0x8f, 0xb0, 0x7b, 0xc0, 0x43,
+	0x7d, 0xd8, 0x11, 0x45, 0x28, 0x38, 0x89, 0x1a, 0x5f, 0xbf, 0x18, 0x9d, 0x40, 0x7b, 0x41, 0x37,
+	0xc6, 0x52, 0x5d, 0xd6, 0x2b, 0xac, 0xc9, 0xb2, 0xa0, 0x8e, 0xad, 0x57, 0x50, 0x60, 0xd2, 0x52,
+	0xc2, 0xb7, 0xf0, 0xf4, 0x81, 0x12, 0x59, 0xe4, 0xf4, 0x2e, 0x92, 0xe9, 0x9a, 0x28, 0xef, 0x08,
+	0xb6, 0x56, 0x24, 0x6b, 0xa4, 0x55, 0x8d, 0xce, 0x61, 0x3b, 0xa1, 0x69, 0x9c, 0x48, 0xa5, 0x6c,
+	0x07, 0x06, 0x4d, 0x83, 0x8f, 0x12, 0x83, 0x5d, 0x89, 0xc1, 0xbe, 0xc4, 0xe0, 0xbb, 0xc4, 0xe0,
+	0xb5, 0xc2, 0xd6, 0xbe, 0xc2, 0xd6, 0x67, 0x85, 0xad, 0xa7, 0x9b, 0x38, 0x95, 0x49, 0x11, 0x7a,
+	0x11, 0xcb, 0x7c, 0x93, 0x9a, 0x3e, 0x2e, 0xc5, 0x7c, 0xe1, 0x3f, 0x37, 0x11, 0xca, 0x0d, 0xa7,
+	0xc2, 0xe7, 0xe6, 0xcf, 0xc3, 0xb6, 0x8a, 0xe5, 0xfa, 0x67, 0x00, 0x03, 0x7f, 0xe7, 0x1a, 0xe9,
 	0x01, 0x00, 0x00,
 }
 
@@ -221,6 +278,33 @@ func (this *ParamChange) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FeatureActivation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeatureActivation)
+	if !ok {
+		that2, ok := that.(FeatureActivation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
 func (m *ParameterChangeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -316,6 +400,41 @@ func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeatureActivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureActivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureActivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -371,6 +490,22 @@ func (m *ParamChange) Size() (n int) {
 	return n
 }
 
+func (m *FeatureActivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -671,6 +806,107 @@ func (m *ParamChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeatureActivation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureActivation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureActivation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
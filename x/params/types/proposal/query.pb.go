@@ -130,36 +130,139 @@ func (m *QueryParamsResponse) GetParam() ParamChange {
 	return ParamChange{}
 }
 
+// QueryFeatureActivationsRequest is request type for the
+// Query/FeatureActivations RPC method.
+type QueryFeatureActivationsRequest struct {
+}
+
+func (m *QueryFeatureActivationsRequest) Reset()         { *m = QueryFeatureActivationsRequest{} }
+func (m *QueryFeatureActivationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeatureActivationsRequest) ProtoMessage()    {}
+func (*QueryFeatureActivationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{2}
+}
+func (m *QueryFeatureActivationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeatureActivationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeatureActivationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeatureActivationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeatureActivationsRequest.Merge(m, src)
+}
+func (m *QueryFeatureActivationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeatureActivationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeatureActivationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeatureActivationsRequest proto.InternalMessageInfo
+
+// QueryFeatureActivationsResponse is response type for the
+// Query/FeatureActivations RPC method.
+type QueryFeatureActivationsResponse struct {
+	// activations defines the feature activation schedule, sorted by activation
+	// height.
+	Activations []FeatureActivation `protobuf:"bytes,1,rep,name=activations,proto3" json:"activations"`
+	// height defines the block height at which the schedule was queried.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryFeatureActivationsResponse) Reset()         { *m = QueryFeatureActivationsResponse{} }
+func (m *QueryFeatureActivationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeatureActivationsResponse) ProtoMessage()    {}
+func (*QueryFeatureActivationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{3}
+}
+func (m *QueryFeatureActivationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeatureActivationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeatureActivationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeatureActivationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeatureActivationsResponse.Merge(m, src)
+}
+func (m *QueryFeatureActivationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeatureActivationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeatureActivationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeatureActivationsResponse proto.InternalMessageInfo
+
+func (m *QueryFeatureActivationsResponse) GetActivations() []FeatureActivation {
+	if m != nil {
+		return m.Activations
+	}
+	return nil
+}
+
+func (m *QueryFeatureActivationsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.params.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.params.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryFeatureActivationsRequest)(nil), "cosmos.params.v1beta1.QueryFeatureActivationsRequest")
+	proto.RegisterType((*QueryFeatureActivationsResponse)(nil), "cosmos.params.v1beta1.QueryFeatureActivationsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/params/v1beta1/query.proto", fileDescriptor_2b32979c1792ccc4) }
 
 var fileDescriptor_2b32979c1792ccc4 = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x4b, 0x3b, 0x31,
-	0x14, 0xc0, 0x2f, 0xfd, 0xff, 0x5b, 0x34, 0x2e, 0x12, 0x15, 0xca, 0xa1, 0x57, 0x3d, 0x10, 0x54,
-	0x30, 0xa1, 0xd5, 0xd9, 0xa1, 0xee, 0xa2, 0x05, 0x17, 0xb7, 0x5c, 0x0d, 0x69, 0x69, 0x7b, 0x2f,
-	0xbd, 0x97, 0x13, 0xbb, 0x3a, 0x38, 0x17, 0xfc, 0x52, 0x1d, 0x0b, 0x2e, 0x4e, 0x22, 0xad, 0x1f,
-	0x44, 0x9a, 0xb4, 0x82, 0x58, 0xc5, 0x29, 0x2f, 0x2f, 0xbf, 0xf7, 0x7b, 0x79, 0x09, 0xdd, 0x6b,
-	0x02, 0xf6, 0x00, 0x85, 0x91, 0x99, 0xec, 0xa1, 0xb8, 0xab, 0x26, 0xca, 0xca, 0xaa, 0xe8, 0xe7,
-	0x2a, 0x1b, 0x70, 0x93, 0x81, 0x05, 0xb6, 0xe5, 0x11, 0xee, 0x11, 0x3e, 0x47, 0xc2, 0x4d, 0x0d,
-	0x1a, 0x1c, 0x21, 0x66, 0x91, 0x87, 0xc3, 0x6d, 0x0d, 0xa0, 0xbb, 0x4a, 0x48, 0xd3, 0x16, 0x32,
-	0x4d, 0xc1, 0x4a, 0xdb, 0x86, 0x14, 0xe7, 0xa7, 0xf1, 0xf2, 0x6e, 0x73, 0xb3, 0x63, 0xe2, 0x3a,
-	0x65, 0x57, 0xb3, 0xee, 0x97, 0x2e, 0xd9, 0x50, 0xfd, 0x5c, 0xa1, 0x65, 0x21, 0x5d, 0xc1, 0x3c,
-	0x41, 0x23, 0x9b, 0xaa, 0x4c, 0x76, 0xc9, 0xc1, 0x6a, 0xe3, 0x73, 0xcf, 0xd6, 0xe9, 0xbf, 0x8e,
-	0x1a, 0x94, 0x0b, 0x2e, 0x3d, 0x0b, 0xe3, 0x6b, 0xba, 0xf1, 0xc5, 0x81, 0x06, 0x52, 0x54, 0xec,
-	0x8c, 0x16, 0x5d, 0x2b, 0x67, 0x58, 0xab, 0xc5, 0x7c, 0xe9, 0x64, 0xdc, 0x55, 0x9d, 0xb7, 0x64,
-	0xaa, 0x55, 0xfd, 0xff, 0xe8, 0xb5, 0x12, 0x34, 0x7c, 0x59, 0x6d, 0x48, 0x68, 0xd1, 0x79, 0xd9,
-	0x23, 0xa1, 0x25, 0x2f, 0x67, 0x87, 0x3f, 0x58, 0xbe, 0x0f, 0x11, 0x1e, 0xfd, 0x05, 0xf5, 0x77,
-	0x8d, 0xf7, 0x1f, 0x9e, 0xdf, 0x9f, 0x0a, 0x15, 0xb6, 0x23, 0x7e, 0x7b, 0xb3, 0xfa, 0xc5, 0x68,
-	0x12, 0x91, 0xf1, 0x24, 0x22, 0x6f, 0x93, 0x88, 0x0c, 0xa7, 0x51, 0x30, 0x9e, 0x46, 0xc1, 0xcb,
-	0x34, 0x0a, 0x6e, 0x4e, 0x75, 0xdb, 0xb6, 0xf2, 0x84, 0x37, 0xa1, 0xb7, 0x50, 0xf8, 0xe5, 0x18,
-	0x6f, 0x3b, 0xe2, 0x7e, 0xe1, 0xb3, 0x03, 0xa3, 0x50, 0x98, 0x0c, 0x0c, 0xa0, 0xec, 0x26, 0x25,
-	0xf7, 0x09, 0x27, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xd5, 0x67, 0xc7, 0x18, 0x02, 0x00,
-	0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xce, 0xb4, 0xb6, 0xe8, 0xf4, 0x22, 0xe3, 0x07, 0x25, 0x68, 0x5a, 0x03, 0x42, 0x2d, 0x9a,
+	0xa1, 0xf1, 0xe3, 0x28, 0x58, 0xc1, 0xa3, 0xd4, 0x80, 0x17, 0x2f, 0x32, 0x89, 0x63, 0x12, 0xda,
+	0x66, 0xa6, 0x99, 0x49, 0xb1, 0x57, 0x0f, 0x5e, 0xbc, 0x08, 0xfe, 0x18, 0xff, 0x42, 0xf1, 0x54,
+	0xd8, 0xcb, 0x9e, 0x96, 0xa5, 0xdd, 0x1f, 0xb2, 0x74, 0x66, 0xba, 0xdb, 0xa5, 0x4d, 0xd9, 0x3d,
+	0x65, 0xe6, 0xcd, 0xf3, 0xf5, 0x3e, 0x09, 0x7c, 0x12, 0x31, 0x31, 0x66, 0x02, 0x73, 0x92, 0x93,
+	0xb1, 0xc0, 0xd3, 0x5e, 0x48, 0x25, 0xe9, 0xe1, 0x49, 0x41, 0xf3, 0x99, 0xc7, 0x73, 0x26, 0x19,
+	0x7a, 0xa0, 0x21, 0x9e, 0x86, 0x78, 0x06, 0x62, 0xdf, 0x8f, 0x59, 0xcc, 0x14, 0x02, 0xaf, 0x4f,
+	0x1a, 0x6c, 0x3f, 0x8a, 0x19, 0x8b, 0x47, 0x14, 0x13, 0x9e, 0x62, 0x92, 0x65, 0x4c, 0x12, 0x99,
+	0xb2, 0x4c, 0x98, 0xb7, 0xee, 0x7e, 0x37, 0xa3, 0xac, 0x30, 0x6e, 0x1f, 0xa2, 0x4f, 0x6b, 0xf7,
+	0x81, 0x1a, 0x06, 0x74, 0x52, 0x50, 0x21, 0x91, 0x0d, 0x6f, 0x8b, 0x22, 0x14, 0x9c, 0x44, 0xb4,
+	0x09, 0xda, 0xa0, 0x73, 0x27, 0xb8, 0xb8, 0xa3, 0xbb, 0xb0, 0x3a, 0xa4, 0xb3, 0x66, 0x45, 0x8d,
+	0xd7, 0x47, 0xf7, 0x33, 0xbc, 0x77, 0x45, 0x43, 0x70, 0x96, 0x09, 0x8a, 0xde, 0xc2, 0x9a, 0xb2,
+	0x52, 0x0a, 0x0d, 0xdf, 0xf5, 0xf6, 0x6e, 0xe6, 0x29, 0xd6, 0xfb, 0x84, 0x64, 0x31, 0xed, 0xdf,
+	0x9a, 0x9f, 0xb4, 0xac, 0x40, 0xd3, 0xdc, 0x36, 0x74, 0x94, 0xec, 0x07, 0x4a, 0x64, 0x91, 0xd3,
+	0x77, 0x91, 0x4c, 0xa7, 0x7a, 0x3f, 0x13, 0xd3, 0xfd, 0x0d, 0x60, 0xab, 0x14, 0x62, 0x52, 0x0c,
+	0x60, 0x83, 0x5c, 0x8e, 0x9b, 0xa0, 0x5d, 0xed, 0x34, 0xfc, 0x4e, 0x49, 0x96, 0x1d, 0x1d, 0x93,
+	0x68, 0x5b, 0x02, 0x3d, 0x84, 0xf5, 0x84, 0xa6, 0x71, 0x22, 0x55, 0x07, 0xd5, 0xc0, 0xdc, 0xfc,
+	0xff, 0x15, 0x58, 0x53, 0x69, 0xd0, 0x2f, 0x00, 0xeb, 0xba, 0x0c, 0xf4, 0xac, 0xc4, 0x69, 0xb7,
+	0x74, 0xbb, 0x7b, 0x1d, 0xa8, 0xde, 0xca, 0x7d, 0xfa, 0xf3, 0xe8, 0xec, 0x6f, 0xa5, 0x85, 0x1e,
+	0xe3, 0x43, 0xdf, 0x18, 0xfd, 0x03, 0x10, 0xed, 0x76, 0x83, 0x5e, 0x1f, 0x72, 0x2a, 0xad, 0xdb,
+	0x7e, 0x73, 0x53, 0x9a, 0x09, 0xeb, 0xab, 0xb0, 0xcf, 0x51, 0xb7, 0x24, 0xec, 0x77, 0x4d, 0xfd,
+	0xba, 0x55, 0x72, 0xff, 0xe3, 0x7c, 0xe9, 0x80, 0xc5, 0xd2, 0x01, 0xa7, 0x4b, 0x07, 0xfc, 0x59,
+	0x39, 0xd6, 0x62, 0xe5, 0x58, 0xc7, 0x2b, 0xc7, 0xfa, 0xf2, 0x2a, 0x4e, 0x65, 0x52, 0x84, 0x5e,
+	0xc4, 0xc6, 0x1b, 0x3d, 0xfd, 0x78, 0x21, 0xbe, 0x0d, 0xf1, 0x8f, 0x8d, 0xb8, 0x9c, 0x71, 0x2a,
+	0x30, 0xcf, 0x19, 0x67, 0x82, 0x8c, 0xc2, 0xba, 0xfa, 0xdd, 0x5f, 0x9e, 0x0f, 0x00, 0x9b, 0x86,
+	0x4c, 0xff, 0x82, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -177,6 +280,9 @@ type QueryClient interface {
 	// Params queries a specific parameter of a module, given its subspace and
 	// key.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeatureActivations queries the protocol feature activation schedule set by
+	// governance.
+	FeatureActivations(ctx context.Context, in *QueryFeatureActivationsRequest, opts ...grpc.CallOption) (*QueryFeatureActivationsResponse, error)
 }
 
 type queryClient struct {
@@ -196,11 +302,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) FeatureActivations(ctx context.Context, in *QueryFeatureActivationsRequest, opts ...grpc.CallOption) (*QueryFeatureActivationsResponse, error) {
+	out := new(QueryFeatureActivationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.params.v1beta1.Query/FeatureActivations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries a specific parameter of a module, given its subspace and
 	// key.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeatureActivations queries the protocol feature activation schedule set by
+	// governance.
+	FeatureActivations(context.Context, *QueryFeatureActivationsRequest) (*QueryFeatureActivationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -210,6 +328,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) FeatureActivations(ctx context.Context, req *QueryFeatureActivationsRequest) (*QueryFeatureActivationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeatureActivations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -233,6 +354,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeatureActivations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeatureActivationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeatureActivations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.params.v1beta1.Query/FeatureActivations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeatureActivations(ctx, req.(*QueryFeatureActivationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.params.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -241,6 +380,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "FeatureActivations",
+			Handler:    _Query_FeatureActivations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/params/v1beta1/query.proto",
@@ -316,6 +459,71 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeatureActivationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeatureActivationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeatureActivationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeatureActivationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeatureActivationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeatureActivationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Activations) > 0 {
+		for iNdEx := len(m.Activations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Activations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -355,6 +563,33 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeatureActivationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeatureActivationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Activations) > 0 {
+		for _, e := range m.Activations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -558,6 +793,159 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeatureActivationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeatureActivationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeatureActivationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeatureActivationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeatureActivationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeatureActivationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Activations = append(m.Activations, FeatureActivation{})
+			if err := m.Activations[len(m.Activations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,10 +1,12 @@
 package rest
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,81 +33,53 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 
 // http request handler to query signing info
 func signingInfoHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, vars["validatorPubKey"])
-		if rest.CheckBadRequestError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, mux.Vars(r)["validatorPubKey"])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+		res, err := types.NewQueryClient(clientCtx).SigningInfo(
+			r.Context(), &types.QuerySigningInfoRequest{ConsAddress: sdk.ConsAddress(pk.Address()).String()}, opts...,
+		)
+		if err != nil {
+			return nil, err
 		}
 
-		params := types.QuerySigningInfoRequest{ConsAddress: pk.Address().String()}
-
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfo)
-		res, height, err := clientCtx.QueryWithData(route, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
-
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.ValSigningInfo, nil
+	})
 }
 
 // http request handler to query signing info
 func signingInfoHandlerListFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
 		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
+		res, err := types.NewQueryClient(clientCtx).SigningInfos(
+			r.Context(), &types.QuerySigningInfosRequest{Pagination: rest.NewPageRequest(page, limit)}, opts...,
+		)
+		if err != nil {
+			return nil, err
 		}
 
-		params := types.NewQuerySigningInfosParams(page, limit)
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		if res.Info == nil {
+			return []types.ValidatorSigningInfo{}, nil
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfos)
-		res, height, err := clientCtx.QueryWithData(route, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
-
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Info, nil
+	})
 }
 
 func queryParamsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		route := fmt.Sprintf("custom/%s/parameters", types.QuerierRoute)
-
-		res, height, err := clientCtx.QueryWithData(route, nil)
-		if rest.CheckInternalServerError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		res, err := types.NewQueryClient(clientCtx).Params(r.Context(), &types.QueryParamsRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Params, nil
+	})
 }
@@ -1,8 +1,14 @@
 package types
 
 import (
+	"bytes"
 	"encoding/binary"
+	"fmt"
+	"strconv"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -65,3 +71,23 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// DecodeStoreKey decodes the key of an entry of the slashing store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	switch {
+	case bytes.HasPrefix(key, ValidatorSigningInfoKeyPrefix):
+		return sdk.NewStoreKeyParser("ValidatorSigningInfo", key[1:], &ValidatorSigningInfo{}).ConsAddress("address").Decode()
+
+	case bytes.HasPrefix(key, ValidatorMissedBlockBitArrayKeyPrefix):
+		return sdk.NewStoreKeyParser("ValidatorMissedBlockBitArray", key[1:], &gogotypes.BoolValue{}).
+			ConsAddress("address").
+			Field("index", 8, func(bz []byte) string { return strconv.FormatInt(int64(binary.LittleEndian.Uint64(bz)), 10) }).
+			Decode()
+
+	case bytes.HasPrefix(key, AddrPubkeyRelationKeyPrefix):
+		return sdk.NewStoreKeyParser("AddrPubkeyRelation", key[1:], &codectypes.Any{}).ConsAddress("address").Decode()
+
+	default:
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+}
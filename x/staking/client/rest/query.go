@@ -1,12 +1,14 @@
 package rest
 
 import (
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
@@ -109,12 +111,44 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 
 // HTTP request handler to query a delegator delegations
 func delegatorDelegationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return queryDelegator(clientCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorDelegations))
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		delegatorAddr, pageReq, err := parseDelegatorRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := types.NewQueryClient(clientCtx).DelegatorDelegations(r.Context(), &types.QueryDelegatorDelegationsRequest{DelegatorAddr: delegatorAddr.String(), Pagination: pageReq}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.DelegationResponses == nil {
+			return types.DelegationResponses{}, nil
+		}
+
+		return res.DelegationResponses, nil
+	})
 }
 
 // HTTP request handler to query a delegator unbonding delegations
-func delegatorUnbondingDelegationsHandlerFn(cliCtx client.Context) http.HandlerFunc {
-	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorUnbondingDelegations))
+func delegatorUnbondingDelegationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		delegatorAddr, pageReq, err := parseDelegatorRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := types.NewQueryClient(clientCtx).DelegatorUnbondingDelegations(r.Context(), &types.QueryDelegatorUnbondingDelegationsRequest{DelegatorAddr: delegatorAddr.String(), Pagination: pageReq}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.UnbondingResponses == nil {
+			return []types.UnbondingDelegation{}, nil
+		}
+
+		return res.UnbondingResponses, nil
+	})
 }
 
 // HTTP request handler to query all staking txs (msgs) from a delegator
@@ -200,207 +234,249 @@ func delegatorTxsHandlerFn(clientCtx client.Context) http.HandlerFunc {
 }
 
 // HTTP request handler to query an unbonding-delegation
-func unbondingDelegationHandlerFn(cliCtx client.Context) http.HandlerFunc {
-	return queryBonds(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUnbondingDelegation))
+func unbondingDelegationHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		delegatorAddr, validatorAddr, err := parseBondRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := types.NewQueryClient(clientCtx).UnbondingDelegation(r.Context(), &types.QueryUnbondingDelegationRequest{DelegatorAddr: delegatorAddr.String(), ValidatorAddr: validatorAddr.String()}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Unbond, nil
+	})
 }
 
 // HTTP request handler to query redelegations
 func redelegationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		var params types.QueryRedelegationParams
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		var req types.QueryRedelegationsRequest
 
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		bechDelegatorAddr := r.URL.Query().Get("delegator")
-		bechSrcValidatorAddr := r.URL.Query().Get("validator_from")
-		bechDstValidatorAddr := r.URL.Query().Get("validator_to")
-
-		if len(bechDelegatorAddr) != 0 {
-			delegatorAddr, err := sdk.AccAddressFromBech32(bechDelegatorAddr)
-			if rest.CheckBadRequestError(w, err) {
-				return
+		if v := r.URL.Query().Get("delegator"); len(v) != 0 {
+			if _, err := sdk.AccAddressFromBech32(v); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
 
-			params.DelegatorAddr = delegatorAddr
+			req.DelegatorAddr = v
 		}
 
-		if len(bechSrcValidatorAddr) != 0 {
-			srcValidatorAddr, err := sdk.ValAddressFromBech32(bechSrcValidatorAddr)
-			if rest.CheckBadRequestError(w, err) {
-				return
+		if v := r.URL.Query().Get("validator_from"); len(v) != 0 {
+			if _, err := sdk.ValAddressFromBech32(v); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
 
-			params.SrcValidatorAddr = srcValidatorAddr
+			req.SrcValidatorAddr = v
 		}
 
-		if len(bechDstValidatorAddr) != 0 {
-			dstValidatorAddr, err := sdk.ValAddressFromBech32(bechDstValidatorAddr)
-			if rest.CheckBadRequestError(w, err) {
-				return
+		if v := r.URL.Query().Get("validator_to"); len(v) != 0 {
+			if _, err := sdk.ValAddressFromBech32(v); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
 
-			params.DstValidatorAddr = dstValidatorAddr
+			req.DstValidatorAddr = v
 		}
 
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).Redelegations(r.Context(), &req, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRedelegations), bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		if res.RedelegationResponses == nil {
+			return types.RedelegationResponses{}, nil
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.RedelegationResponses, nil
+	})
 }
 
 // HTTP request handler to query a delegation
 func delegationHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return queryBonds(clientCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegation))
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		delegatorAddr, validatorAddr, err := parseBondRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := types.NewQueryClient(clientCtx).Delegation(r.Context(), &types.QueryDelegationRequest{DelegatorAddr: delegatorAddr.String(), ValidatorAddr: validatorAddr.String()}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		return res.DelegationResponse, nil
+	})
 }
 
 // HTTP request handler to query all delegator bonded validators
-func delegatorValidatorsHandlerFn(cliCtx client.Context) http.HandlerFunc {
-	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorValidators))
+func delegatorValidatorsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		delegatorAddr, pageReq, err := parseDelegatorRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := types.NewQueryClient(clientCtx).DelegatorValidators(r.Context(), &types.QueryDelegatorValidatorsRequest{DelegatorAddr: delegatorAddr.String(), Pagination: pageReq}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.Validators == nil {
+			return types.Validators{}, nil
+		}
+
+		return types.Validators(res.Validators), nil
+	})
 }
 
 // HTTP request handler to get information from a currently bonded validator
-func delegatorValidatorHandlerFn(cliCtx client.Context) http.HandlerFunc {
-	return queryBonds(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorValidator))
+func delegatorValidatorHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		delegatorAddr, validatorAddr, err := parseBondRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := types.NewQueryClient(clientCtx).DelegatorValidator(r.Context(), &types.QueryDelegatorValidatorRequest{DelegatorAddr: delegatorAddr.String(), ValidatorAddr: validatorAddr.String()}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Validator, nil
+	})
 }
 
 // HTTP request handler to query list of validators
 func validatorsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
 		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		status := r.FormValue("status")
+		bondStatus := r.FormValue("status")
 		// These are query params that were available in =<0.39. We show a nice
 		// error message for this breaking change.
-		if status == "bonded" || status == "unbonding" || status == "unbonded" {
-			err := fmt.Errorf("cosmos sdk v0.40 introduces a breaking change on this endpoint:"+
+		if bondStatus == "bonded" || bondStatus == "unbonding" || bondStatus == "unbonded" {
+			return nil, status.Errorf(codes.InvalidArgument, "cosmos sdk v0.40 introduces a breaking change on this endpoint:"+
 				" instead of querying using `?status=%s`, please use `status=BOND_STATUS_%s`. For more"+
-				" info, please see our REST endpoint migration guide at %s", status, strings.ToUpper(status), clientrest.DeprecationURL)
-
-			if rest.CheckBadRequestError(w, err) {
-				return
-			}
-
+				" info, please see our REST endpoint migration guide at %s", bondStatus, strings.ToUpper(bondStatus), clientrest.DeprecationURL)
 		}
 
-		if status == "" {
-			status = types.BondStatusBonded
+		if bondStatus == "" {
+			bondStatus = types.BondStatusBonded
 		}
 
-		params := types.NewQueryValidatorsParams(page, limit, status)
-
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).Validators(r.Context(), &types.QueryValidatorsRequest{Status: bondStatus, Pagination: rest.NewPageRequest(page, limit)}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidators)
-
-		res, height, err := clientCtx.QueryWithData(route, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		if res.Validators == nil {
+			return types.Validators{}, nil
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return types.Validators(res.Validators), nil
+	})
 }
 
 // HTTP request handler to query the validator information from a given validator address
-func validatorHandlerFn(cliCtx client.Context) http.HandlerFunc {
-	return queryValidator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidator))
+func validatorHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		validatorAddr, err := parseValidatorAddressVar(r)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := types.NewQueryClient(clientCtx).Validator(r.Context(), &types.QueryValidatorRequest{ValidatorAddr: validatorAddr.String()}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Validator, nil
+	})
 }
 
 // HTTP request handler to query all unbonding delegations from a validator
 func validatorDelegationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return queryValidator(clientCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorDelegations))
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		validatorAddr, pageReq, err := parseValidatorRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := types.NewQueryClient(clientCtx).ValidatorDelegations(r.Context(), &types.QueryValidatorDelegationsRequest{ValidatorAddr: validatorAddr.String(), Pagination: pageReq}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.DelegationResponses == nil {
+			return types.DelegationResponses{}, nil
+		}
+
+		return res.DelegationResponses, nil
+	})
 }
 
 // HTTP request handler to query all unbonding delegations from a validator
-func validatorUnbondingDelegationsHandlerFn(cliCtx client.Context) http.HandlerFunc {
-	return queryValidator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorUnbondingDelegations))
-}
+func validatorUnbondingDelegationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		validatorAddr, pageReq, err := parseValidatorRequest(r)
+		if err != nil {
+			return nil, err
+		}
 
-// HTTP request handler to query historical info at a given height
-func historicalInfoHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		heightStr := vars["height"]
+		res, err := types.NewQueryClient(clientCtx).ValidatorUnbondingDelegations(r.Context(), &types.QueryValidatorUnbondingDelegationsRequest{ValidatorAddr: validatorAddr.String(), Pagination: pageReq}, opts...)
+		if err != nil {
+			return nil, err
+		}
 
-		height, err := strconv.ParseInt(heightStr, 10, 64)
-		if err != nil || height < 0 {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Must provide non-negative integer for height: %v", err))
-			return
+		if res.UnbondingResponses == nil {
+			return []types.UnbondingDelegation{}, nil
 		}
 
-		params := types.QueryHistoricalInfoRequest{Height: height}
+		return res.UnbondingResponses, nil
+	})
+}
 
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckInternalServerError(w, err) {
-			return
+// HTTP request handler to query historical info at a given height
+func historicalInfoHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		height, err := strconv.ParseInt(mux.Vars(r)["height"], 10, 64)
+		if err != nil || height < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Must provide non-negative integer for height: %v", err)
 		}
 
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHistoricalInfo), bz)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).HistoricalInfo(r.Context(), &types.QueryHistoricalInfoRequest{Height: height}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Hist, nil
+	})
 }
 
 // HTTP request handler to query the pool information
 func poolHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPool), nil)
-		if rest.CheckInternalServerError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		res, err := types.NewQueryClient(clientCtx).Pool(r.Context(), &types.QueryPoolRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Pool, nil
+	})
 }
 
 // HTTP request handler to query the staking params values
 func paramsHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters), nil)
-		if rest.CheckInternalServerError(w, err) {
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		res, err := types.NewQueryClient(clientCtx).Params(r.Context(), &types.QueryParamsRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
-	}
+		return res.Params, nil
+	})
 }
@@ -55,7 +55,7 @@ func (s *IntegrationTestSuite) TestLegacyGetValidators() {
 				s.Require().Greater(len(validators), 0)
 				// While we're at it, also check that the consensus_pubkey is
 				// an Any, and not bech32 anymore.
-				s.Require().Contains(string(resp.Result), "\"consensus_pubkey\": {\n      \"type\": \"tendermint/PubKeyEd25519\",")
+				s.Require().Contains(string(resp.Result), "\"consensus_pubkey\":{\"type\":\"tendermint/PubKeyEd25519\",")
 			}
 		})
 	}
@@ -5,12 +5,14 @@ import (
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
-	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // contains checks if the a given query contains one of the tx types
@@ -36,109 +38,60 @@ func queryTxs(clientCtx client.Context, action string, delegatorAddr string) (*s
 	return authtx.QueryTxsByEvents(clientCtx, events, page, limit, "")
 }
 
-func queryBonds(clientCtx client.Context, endpoint string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		bech32delegator := vars["delegatorAddr"]
-		bech32validator := vars["validatorAddr"]
-
-		delegatorAddr, err := sdk.AccAddressFromBech32(bech32delegator)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		validatorAddr, err := sdk.ValAddressFromBech32(bech32validator)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		params := types.QueryDelegatorValidatorRequest{DelegatorAddr: delegatorAddr.String(), ValidatorAddr: validatorAddr.String()}
-
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		res, height, err := clientCtx.QueryWithData(endpoint, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
-
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
+// parseBondRequest returns the delegator and validator addresses of the
+// request path.
+func parseBondRequest(r *http.Request) (sdk.AccAddress, sdk.ValAddress, error) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-}
-
-func queryDelegator(clientCtx client.Context, endpoint string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		bech32delegator := vars["delegatorAddr"]
-
-		delegatorAddr, err := sdk.AccAddressFromBech32(bech32delegator)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
-
-		params := types.NewQueryDelegatorParams(delegatorAddr)
 
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		res, height, err := clientCtx.QueryWithData(endpoint, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
-
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
+	validatorAddr, err := parseValidatorAddressVar(r)
+	if err != nil {
+		return nil, nil, err
 	}
-}
 
-func queryValidator(clientCtx client.Context, endpoint string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		bech32validatorAddr := vars["validatorAddr"]
+	return delegatorAddr, validatorAddr, nil
+}
 
-		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
+// parseDelegatorRequest returns the delegator address of the request path and
+// the page request of its query parameters.
+func parseDelegatorRequest(r *http.Request) (sdk.AccAddress, *query.PageRequest, error) {
+	_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		validatorAddr, err := sdk.ValAddressFromBech32(bech32validatorAddr)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
+	delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
-		if !ok {
-			return
-		}
+	return delegatorAddr, rest.NewPageRequest(page, limit), nil
+}
 
-		params := types.NewQueryValidatorParams(validatorAddr, page, limit)
+// parseValidatorRequest returns the validator address of the request path and
+// the page request of its query parameters.
+func parseValidatorRequest(r *http.Request) (sdk.ValAddress, *query.PageRequest, error) {
+	_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
+	validatorAddr, err := parseValidatorAddressVar(r)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		res, height, err := clientCtx.QueryWithData(endpoint, bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
+	return validatorAddr, rest.NewPageRequest(page, limit), nil
+}
 
-		clientCtx = clientCtx.WithHeight(height)
-		rest.PostProcessResponse(w, clientCtx, res)
+// parseValidatorAddressVar returns the validator address of the request path.
+func parseValidatorAddressVar(r *http.Request) (sdk.ValAddress, error) {
+	validatorAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+
+	return validatorAddr, nil
 }
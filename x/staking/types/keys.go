@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -333,3 +335,80 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// DecodeStoreKey decodes the key of an entry of the staking store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	switch {
+	case bytes.HasPrefix(key, LastValidatorPowerKey):
+		return sdk.NewStoreKeyParser("LastValidatorPower", key[1:], &gogotypes.Int64Value{}).ValAddress("validator").Decode()
+
+	case bytes.HasPrefix(key, LastTotalPowerKey):
+		return sdk.NewStoreKeyParser("LastTotalPower", key[1:], &sdk.IntProto{}).Decode()
+
+	case bytes.HasPrefix(key, ValidatorsKey):
+		return sdk.NewStoreKeyParser("Validators", key[1:], &Validator{}).ValAddress("validator").Decode()
+
+	case bytes.HasPrefix(key, ValidatorsByConsAddrKey):
+		return sdk.NewStoreKeyParser("ValidatorsByConsAddr", key[1:], nil).ConsAddress("consensus_address").Decode()
+
+	case bytes.HasPrefix(key, ValidatorsByPowerIndexKey):
+		// the operator addresses of the power index are inverted
+		return sdk.NewStoreKeyParser("ValidatorsByPowerIndex", key[1:], nil).
+			Uint64("power").
+			LengthPrefixedField("validator", func(bz []byte) string {
+				addr := make(sdk.ValAddress, len(bz))
+				for i, b := range bz {
+					addr[i] = ^b
+				}
+
+				return addr.String()
+			}).
+			Decode()
+
+	case bytes.HasPrefix(key, DelegationKey):
+		return sdk.NewStoreKeyParser("Delegations", key[1:], &Delegation{}).AccAddress("delegator").ValAddress("validator").Decode()
+
+	case bytes.HasPrefix(key, UnbondingDelegationKey):
+		return sdk.NewStoreKeyParser("UnbondingDelegations", key[1:], &UnbondingDelegation{}).
+			AccAddress("delegator").ValAddress("validator").
+			Decode()
+
+	case bytes.HasPrefix(key, UnbondingDelegationByValIndexKey):
+		return sdk.NewStoreKeyParser("UnbondingDelegationsByValidator", key[1:], nil).
+			ValAddress("validator").AccAddress("delegator").
+			Decode()
+
+	case bytes.HasPrefix(key, RedelegationKey):
+		return sdk.NewStoreKeyParser("Redelegations", key[1:], &Redelegation{}).
+			AccAddress("delegator").ValAddress("validator_src").ValAddress("validator_dst").
+			Decode()
+
+	case bytes.HasPrefix(key, RedelegationByValSrcIndexKey):
+		return sdk.NewStoreKeyParser("RedelegationsByValidatorSrc", key[1:], nil).
+			ValAddress("validator_src").AccAddress("delegator").ValAddress("validator_dst").
+			Decode()
+
+	case bytes.HasPrefix(key, RedelegationByValDstIndexKey):
+		return sdk.NewStoreKeyParser("RedelegationsByValidatorDst", key[1:], nil).
+			ValAddress("validator_dst").AccAddress("delegator").ValAddress("validator_src").
+			Decode()
+
+	case bytes.HasPrefix(key, UnbondingQueueKey):
+		return sdk.NewStoreKeyParser("UnbondingQueue", key[1:], &DVPairs{}).Time("completion_time").Decode()
+
+	case bytes.HasPrefix(key, RedelegationQueueKey):
+		return sdk.NewStoreKeyParser("RedelegationQueue", key[1:], &DVVTriplets{}).Time("completion_time").Decode()
+
+	case bytes.HasPrefix(key, ValidatorQueueKey):
+		// the times of the validator queue are prefixed by their length
+		return sdk.NewStoreKeyParser("ValidatorQueue", key[1:], &ValAddresses{}).
+			Uint64("time_length").Time("completion_time").Uint64("completion_height").
+			Decode()
+
+	case bytes.HasPrefix(key, HistoricalInfoKey):
+		return sdk.NewStoreKeyParser("HistoricalInfo", key[1:], &HistoricalInfo{}).String("height").Decode()
+
+	default:
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+}
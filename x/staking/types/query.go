@@ -0,0 +1,35 @@
+package types
+
+import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+func (m *QueryValidatorsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return Validators(m.Validators).UnpackInterfaces(unpacker)
+}
+
+func (m *QueryValidatorResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return m.Validator.UnpackInterfaces(unpacker)
+}
+
+func (m *QueryDelegatorValidatorsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return Validators(m.Validators).UnpackInterfaces(unpacker)
+}
+
+func (m *QueryDelegatorValidatorResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return m.Validator.UnpackInterfaces(unpacker)
+}
+
+func (m *QueryHistoricalInfoResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Hist == nil {
+		return nil
+	}
+
+	return m.Hist.UnpackInterfaces(unpacker)
+}
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueryValidatorsResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryValidatorResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryDelegatorValidatorsResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryDelegatorValidatorResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryHistoricalInfoResponse{}
+)
@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
+		GetModuleVersionsCmd(),
 	)
 
 	return cmd
@@ -110,3 +111,34 @@ func GetAppliedPlanCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetModuleVersionsCmd returns the query module versions command.
+func GetModuleVersionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-versions",
+		Short: "get the consensus versions of the modules",
+		Long: "Gets the consensus protocol version of the app, the consensus versions of the modules of the running binary, " +
+			"and the consensus versions the state of the modules was migrated to.\n" +
+			"This helps operators verify a node runs the expected state machine after an upgrade.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleVersions(cmd.Context(), &types.QueryModuleVersionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
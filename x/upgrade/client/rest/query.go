@@ -1,11 +1,12 @@
 package rest
 
 import (
-	"encoding/binary"
-	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -22,52 +23,33 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 }
 
 func getCurrentPlanHandler(clientCtx client.Context) func(http.ResponseWriter, *http.Request) {
-	return func(w http.ResponseWriter, request *http.Request) {
-		// ignore height for now
-		res, _, err := clientCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierKey, types.QueryCurrent))
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
-		if len(res) == 0 {
-			http.NotFound(w, request)
-			return
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
+		res, err := types.NewQueryClient(clientCtx).CurrentPlan(r.Context(), &types.QueryCurrentPlanRequest{}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		var plan types.Plan
-		err = clientCtx.LegacyAmino.UnmarshalJSON(res, &plan)
-		if rest.CheckInternalServerError(w, err) {
-			return
+		if res.Plan == nil {
+			return nil, status.Error(codes.NotFound, "no upgrade scheduled")
 		}
 
-		rest.PostProcessResponse(w, clientCtx, plan)
-	}
+		return *res.Plan, nil
+	})
 }
 
 func getDonePlanHandler(clientCtx client.Context) func(http.ResponseWriter, *http.Request) {
-	return func(w http.ResponseWriter, r *http.Request) {
+	return rest.NewGRPCQueryHandlerFn(clientCtx, func(r *http.Request, clientCtx client.Context, opts ...grpc.CallOption) (interface{}, error) {
 		name := mux.Vars(r)["name"]
 
-		params := types.QueryAppliedPlanRequest{Name: name}
-		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		res, _, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierKey, types.QueryApplied), bz)
-		if rest.CheckBadRequestError(w, err) {
-			return
+		res, err := types.NewQueryClient(clientCtx).AppliedPlan(r.Context(), &types.QueryAppliedPlanRequest{Name: name}, opts...)
+		if err != nil {
+			return nil, err
 		}
 
-		if len(res) == 0 {
-			http.NotFound(w, r)
-			return
-		}
-		if len(res) != 8 {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, "unknown format for applied-upgrade")
+		if res.Height == 0 {
+			return nil, status.Errorf(codes.NotFound, "upgrade %s not applied", name)
 		}
 
-		applied := int64(binary.BigEndian.Uint64(res))
-		fmt.Println(applied)
-		rest.PostProcessResponse(w, clientCtx, applied)
-	}
+		return res.Height, nil
+	})
 }
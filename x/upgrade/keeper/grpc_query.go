@@ -46,3 +46,14 @@ func (k Keeper) UpgradedConsensusState(c context.Context, req *types.QueryUpgrad
 		UpgradedConsensusState: consState,
 	}, nil
 }
+
+// ModuleVersions implements the Query/ModuleVersions gRPC method
+func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersionsRequest) (*types.QueryModuleVersionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryModuleVersionsResponse{
+		AppVersion:        ctx.BlockHeader().Version.App,
+		ConsensusVersions: types.NewModuleVersions(k.GetModuleConsensusVersions()),
+		MigratedVersions:  types.NewModuleVersions(k.GetModuleVersionMap(ctx)),
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -138,6 +139,22 @@ func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestQueryModuleVersions() {
+	ctx := suite.ctx.WithBlockHeader(tmproto.Header{Version: tmversion.Consensus{App: 2}})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.UpgradeKeeper)
+
+	res, err := types.NewQueryClient(queryHelper).ModuleVersions(gocontext.Background(), &types.QueryModuleVersionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.AppVersion)
+
+	// the module versions are stored at genesis
+	vm := suite.app.UpgradeKeeper.GetModuleConsensusVersions()
+	suite.Require().NotEmpty(vm)
+	suite.Require().Equal(types.NewModuleVersions(vm), res.ConsensusVersions)
+	suite.Require().Equal(types.NewModuleVersions(vm), res.MigratedVersions)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
@@ -16,6 +16,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -28,6 +29,7 @@ type Keeper struct {
 	storeKey           sdk.StoreKey
 	cdc                codec.BinaryMarshaler
 	upgradeHandlers    map[string]types.UpgradeHandler
+	consensusVersions  module.MigrationMap
 }
 
 // NewKeeper constructs an upgrade Keeper
@@ -38,6 +40,7 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey sdk.StoreKey, cdc cod
 		storeKey:           storeKey,
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
+		consensusVersions:  module.MigrationMap{},
 	}
 }
 
@@ -48,6 +51,57 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetModuleConsensusVersions sets the consensus versions of the modules of the
+// running binary, usually those returned by the GetVersionMap method of the
+// app's module manager. They are returned by the module versions query.
+func (k Keeper) SetModuleConsensusVersions(vm module.MigrationMap) {
+	for moduleName, version := range vm {
+		k.consensusVersions[moduleName] = version
+	}
+}
+
+// GetModuleConsensusVersions returns the consensus versions of the modules of
+// the running binary, as set by SetModuleConsensusVersions.
+func (k Keeper) GetModuleConsensusVersions() module.MigrationMap {
+	vm := make(module.MigrationMap, len(k.consensusVersions))
+	for moduleName, version := range k.consensusVersions {
+		vm[moduleName] = version
+	}
+
+	return vm
+}
+
+// SetModuleVersionMap stores the consensus versions the state of the modules
+// was migrated to, so that operators can check them against the consensus
+// versions of the running binary. Apps call it at genesis, from InitChainer,
+// and after the store migrations of an upgrade, from RunMigrations. As the
+// state of a chain whose genesis predates the version map is only migrated by
+// upgrade handlers, ApplyUpgrade also stores the consensus versions of the
+// running binary once the upgrade handler has run.
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.MigrationMap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	for moduleName, version := range vm {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, version)
+		store.Set([]byte(moduleName), bz)
+	}
+}
+
+// GetModuleVersionMap returns the consensus versions the state of the modules
+// was migrated to, as stored by SetModuleVersionMap.
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.MigrationMap {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	vm := make(module.MigrationMap)
+	for ; it.Valid(); it.Next() {
+		vm[string(it.Key())] = binary.BigEndian.Uint64(it.Value())
+	}
+
+	return vm
+}
+
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
 // If there is another Plan already scheduled, it will overwrite it
 // (implicitly cancelling the current plan)
@@ -193,6 +247,11 @@ func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 
 	handler(ctx, plan)
 
+	// The state of the modules is at the consensus versions of the running
+	// binary once the upgrade handler has run, whether or not it called
+	// SetModuleVersionMap.
+	k.SetModuleVersionMap(ctx, k.GetModuleConsensusVersions())
+
 	// Must clear IBC state after upgrade is applied as it is stored separately from the upgrade plan.
 	// This will prevent resubmission of upgrade msg after upgrade is already completed.
 	k.ClearIBCState(ctx, plan.Height)
@@ -6,11 +6,15 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -211,6 +215,45 @@ func (s *KeeperTestSuite) TestSetUpgradedClient() {
 
 }
 
+func (s *KeeperTestSuite) TestModuleVersions() {
+	// the module versions are stored at genesis
+	vm := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(uint64(2), vm["bank"])
+	s.Require().Equal(uint64(1), vm[types.ModuleName])
+
+	s.app.UpgradeKeeper.SetModuleConsensusVersions(module.MigrationMap{"bank": 3, "auth": 1})
+	s.app.UpgradeKeeper.SetModuleVersionMap(s.ctx, module.MigrationMap{"bank": 3})
+	vm = s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(uint64(3), vm["bank"])
+	s.Require().Equal(uint64(1), vm["auth"])
+
+	ctx := s.ctx.WithBlockHeader(tmproto.Header{Version: tmversion.Consensus{App: 3}})
+	querier := keeper.NewQuerier(s.app.UpgradeKeeper, s.app.LegacyAmino())
+	bz, err := querier(ctx, []string{types.QueryModuleVersions}, abci.RequestQuery{})
+	s.Require().NoError(err)
+
+	var res types.QueryModuleVersionsResponse
+	s.Require().NoError(s.app.LegacyAmino().UnmarshalJSON(bz, &res))
+	s.Require().Equal(uint64(3), res.AppVersion)
+	s.Require().Equal([]types.ModuleVersion{{Name: "auth", Version: 1}, {Name: "bank", Version: 3}}, res.ConsensusVersions)
+	s.Require().Equal(types.NewModuleVersions(vm), res.MigratedVersions)
+}
+
+func (s *KeeperTestSuite) TestApplyUpgradeSetsModuleVersions() {
+	// a chain whose genesis predates the version map has none stored
+	store := prefix.NewStore(s.ctx.KVStore(s.app.GetKey(types.StoreKey)), []byte{types.VersionMapByte})
+	for moduleName := range s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx) {
+		store.Delete([]byte(moduleName))
+	}
+	s.Require().Empty(s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx))
+
+	plan := types.Plan{Name: "no-migrations", Height: s.ctx.BlockHeight() + 1}
+	s.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan) {})
+	s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, plan)
+
+	s.Require().Equal(s.app.UpgradeKeeper.GetModuleConsensusVersions(), s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -24,6 +24,9 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 		case types.QueryApplied:
 			return queryApplied(ctx, req, k, legacyQuerierCdc)
 
+		case types.QueryModuleVersions:
+			return queryModuleVersions(ctx, req, k, legacyQuerierCdc)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -62,3 +65,17 @@ func queryApplied(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerie
 
 	return bz, nil
 }
+
+func queryModuleVersions(ctx sdk.Context, _ abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	res, err := k.ModuleVersions(sdk.WrapSDKContext(ctx), &types.QueryModuleVersionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -1,6 +1,12 @@
 package types
 
-import "fmt"
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -21,6 +27,8 @@ const (
 	PlanByte = 0x0
 	// DoneByte is a prefix for to look up completed upgrade plan by name
 	DoneByte = 0x1
+	// VersionMapByte is a prefix to look up the consensus version the state of a module was migrated to by module name
+	VersionMapByte = 0x2
 
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
@@ -51,3 +59,34 @@ func UpgradedClientKey(height int64) []byte {
 func UpgradedConsStateKey(height int64) []byte {
 	return []byte(fmt.Sprintf("%s/%d/%s", KeyUpgradedIBCState, height, KeyUpgradedConsState))
 }
+
+// DecodeStoreKey decodes the key of an entry of the upgrade store.
+func DecodeStoreKey(key []byte) (sdk.DecodedStoreKey, error) {
+	upgradedIBCStatePrefix := []byte(KeyUpgradedIBCState + "/")
+
+	switch {
+	case len(key) == 0:
+		return sdk.DecodedStoreKey{}, fmt.Errorf("empty %s store key", ModuleName)
+
+	case key[0] == PlanByte:
+		return sdk.NewStoreKeyParser("Plan", key[1:], &Plan{}).Decode()
+
+	case key[0] == DoneByte:
+		return sdk.NewStoreKeyParser("Done", key[1:], nil).String("name").Decode()
+
+	case key[0] == VersionMapByte:
+		return sdk.NewStoreKeyParser("ModuleVersions", key[1:], nil).String("module").Decode()
+
+	case bytes.HasPrefix(key, upgradedIBCStatePrefix):
+		// the upgraded IBC state keys are of the form upgradedIBCState/{height}/{sub-key}
+		parts := strings.Split(string(key[len(upgradedIBCStatePrefix):]), "/")
+		if len(parts) != 2 || (parts[1] != KeyUpgradedClient && parts[1] != KeyUpgradedConsState) {
+			return sdk.DecodedStoreKey{}, fmt.Errorf("invalid %s store key %q", ModuleName, key)
+		}
+
+		return sdk.DecodedStoreKey{Prefix: parts[1], Fields: []sdk.StoreKeyField{{Name: "height", Value: parts[0]}}}, nil
+
+	default:
+		return sdk.DecodedStoreKey{}, fmt.Errorf("unknown %s store key %X", ModuleName, key)
+	}
+}
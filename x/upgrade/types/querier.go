@@ -1,7 +1,22 @@
 package types
 
+import "sort"
+
 // query endpoints supported by the upgrade Querier
 const (
-	QueryCurrent = "current"
-	QueryApplied = "applied"
+	QueryCurrent        = "current"
+	QueryApplied        = "applied"
+	QueryModuleVersions = "module_versions"
 )
+
+// NewModuleVersions returns the module versions of a map of module names to
+// consensus versions, sorted by module name.
+func NewModuleVersions(vm map[string]uint64) []ModuleVersion {
+	mvs := make([]ModuleVersion, 0, len(vm))
+	for name, version := range vm {
+		mvs = append(mvs, ModuleVersion{Name: name, Version: version})
+	}
+
+	sort.Slice(mvs, func(i, j int) bool { return mvs[i].Name < mvs[j].Name })
+	return mvs
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -302,6 +303,166 @@ func (m *QueryUpgradedConsensusStateResponse) GetUpgradedConsensusState() []byte
 	return nil
 }
 
+// QueryModuleVersionsRequest is the request type for the Query/ModuleVersions
+// RPC method.
+type QueryModuleVersionsRequest struct {
+}
+
+func (m *QueryModuleVersionsRequest) Reset()         { *m = QueryModuleVersionsRequest{} }
+func (m *QueryModuleVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsRequest) ProtoMessage()    {}
+func (*QueryModuleVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{6}
+}
+func (m *QueryModuleVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsRequest.Merge(m, src)
+}
+func (m *QueryModuleVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsRequest proto.InternalMessageInfo
+
+// QueryModuleVersionsResponse is the response type for the Query/ModuleVersions
+// RPC method. Modules are sorted by name.
+type QueryModuleVersionsResponse struct {
+	// app_version is the consensus protocol version of the app.
+	AppVersion uint64 `protobuf:"varint,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// consensus_versions are the consensus versions of the modules of the
+	// running binary.
+	ConsensusVersions []ModuleVersion `protobuf:"bytes,2,rep,name=consensus_versions,json=consensusVersions,proto3" json:"consensus_versions"`
+	// migrated_versions are the consensus versions the state of the modules was
+	// migrated to.
+	MigratedVersions []ModuleVersion `protobuf:"bytes,3,rep,name=migrated_versions,json=migratedVersions,proto3" json:"migrated_versions"`
+}
+
+func (m *QueryModuleVersionsResponse) Reset()         { *m = QueryModuleVersionsResponse{} }
+func (m *QueryModuleVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsResponse) ProtoMessage()    {}
+func (*QueryModuleVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{7}
+}
+func (m *QueryModuleVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsResponse.Merge(m, src)
+}
+func (m *QueryModuleVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleVersionsResponse) GetAppVersion() uint64 {
+	if m != nil {
+		return m.AppVersion
+	}
+	return 0
+}
+
+func (m *QueryModuleVersionsResponse) GetConsensusVersions() []ModuleVersion {
+	if m != nil {
+		return m.ConsensusVersions
+	}
+	return nil
+}
+
+func (m *QueryModuleVersionsResponse) GetMigratedVersions() []ModuleVersion {
+	if m != nil {
+		return m.MigratedVersions
+	}
+	return nil
+}
+
+// ModuleVersion is the consensus version of a module.
+type ModuleVersion struct {
+	// name is the name of the module.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the consensus version of the module.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
+func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
+func (*ModuleVersion) ProtoMessage()    {}
+func (*ModuleVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{8}
+}
+func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVersion.Merge(m, src)
+}
+func (m *ModuleVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
+
+func (m *ModuleVersion) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ModuleVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -309,6 +470,9 @@ func init() {
 	proto.RegisterType((*QueryAppliedPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryAppliedPlanResponse")
 	proto.RegisterType((*QueryUpgradedConsensusStateRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateRequest")
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
+	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsRequest")
+	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
+	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
 }
 
 func init() {
@@ -316,38 +480,48 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcf, 0x6b, 0x13, 0x41,
-	0x18, 0xcd, 0xc4, 0x58, 0x74, 0xe2, 0x41, 0xe6, 0x10, 0xd3, 0x50, 0xd6, 0x32, 0x16, 0x29, 0xd8,
-	0xec, 0xb4, 0xe9, 0x45, 0x14, 0x44, 0x2d, 0x16, 0x11, 0x0f, 0x1a, 0xf1, 0xe2, 0x25, 0x4c, 0xb2,
-	0xe3, 0x66, 0x71, 0x33, 0x33, 0xdd, 0x99, 0x11, 0x4b, 0xe9, 0xc5, 0xbf, 0x40, 0xf0, 0xee, 0xcd,
-	0x9b, 0x7f, 0x88, 0xc7, 0x82, 0x17, 0xbd, 0x49, 0xe2, 0x1f, 0x22, 0x3b, 0x3b, 0x2b, 0x5b, 0xb2,
-	0xbb, 0x91, 0x9e, 0xf6, 0xc7, 0xf7, 0xde, 0xf7, 0xde, 0x37, 0xef, 0x1b, 0x88, 0x27, 0x42, 0xcd,
-	0x84, 0x22, 0x46, 0x86, 0x09, 0x0d, 0x18, 0x79, 0xbf, 0x37, 0x66, 0x9a, 0xee, 0x91, 0x23, 0xc3,
-	0x92, 0x63, 0x5f, 0x26, 0x42, 0x0b, 0xd4, 0xc9, 0x30, 0xbe, 0xc3, 0xf8, 0x0e, 0xd3, 0x5b, 0x0f,
-	0x85, 0x08, 0x63, 0x46, 0x2c, 0x6a, 0x6c, 0xde, 0x12, 0xca, 0x1d, 0xa5, 0xb7, 0xe1, 0x4a, 0x54,
-	0x46, 0x84, 0x72, 0x2e, 0x34, 0xd5, 0x91, 0xe0, 0xca, 0x55, 0xb7, 0x2a, 0x44, 0x73, 0x01, 0x8b,
-	0xc2, 0xeb, 0xf0, 0xc6, 0xcb, 0xd4, 0xc5, 0x81, 0x49, 0x12, 0xc6, 0xf5, 0x8b, 0x98, 0xf2, 0x21,
-	0x3b, 0x32, 0x4c, 0x69, 0xfc, 0x1c, 0x76, 0x97, 0x4b, 0x4a, 0x0a, 0xae, 0x18, 0xda, 0x85, 0x2d,
-	0x19, 0x53, 0xde, 0x05, 0x9b, 0x60, 0xbb, 0x3d, 0xd8, 0xf0, 0xcb, 0xcd, 0xfb, 0x96, 0x63, 0x91,
-	0xb8, 0xef, 0x84, 0x1e, 0x49, 0x19, 0x47, 0x2c, 0x28, 0x08, 0x21, 0x04, 0x5b, 0x9c, 0xce, 0x98,
-	0x6d, 0x76, 0x75, 0x68, 0xdf, 0xf1, 0xc0, 0x89, 0x9f, 0x83, 0x3b, 0xf1, 0x0e, 0x5c, 0x9b, 0xb2,
-	0x28, 0x9c, 0x6a, 0xcb, 0xb8, 0x34, 0x74, 0x5f, 0xf8, 0x09, 0xc4, 0x96, 0xf3, 0x3a, 0x73, 0x11,
-	0x1c, 0xa4, 0x68, 0xae, 0x8c, 0x7a, 0xa5, 0xa9, 0x66, 0xb9, 0xda, 0x4d, 0xd8, 0x8e, 0xa9, 0xd2,
-	0xa3, 0x73, 0x2d, 0x60, 0xfa, 0xeb, 0x69, 0xd6, 0x86, 0xc1, 0x5b, 0xb5, 0x6d, 0x9c, 0x8b, 0xbb,
-	0xb0, 0xeb, 0xc6, 0x0d, 0x46, 0x93, 0x1c, 0x32, 0x52, 0x29, 0xa6, 0xdb, 0xdc, 0x04, 0xdb, 0xd7,
-	0x86, 0x1d, 0x53, 0xda, 0xe1, 0x59, 0xeb, 0x0a, 0xb8, 0xde, 0x1c, 0x7c, 0x6b, 0xc1, 0xcb, 0x56,
-	0x07, 0x7d, 0x01, 0xb0, 0x5d, 0x38, 0x64, 0x44, 0xaa, 0x8e, 0xb3, 0x22, 0xa9, 0xde, 0xee, 0xff,
-	0x13, 0x32, 0xf3, 0x78, 0xe7, 0xe3, 0x8f, 0x3f, 0x9f, 0x9b, 0xb7, 0xd1, 0x16, 0xa9, 0xd8, 0x92,
-	0x49, 0x46, 0x1a, 0xa5, 0xd9, 0xa1, 0xaf, 0x00, 0xb6, 0x0b, 0x41, 0xac, 0x30, 0xb8, 0x9c, 0xf0,
-	0x0a, 0x83, 0x25, 0x19, 0xe3, 0x7d, 0x6b, 0xb0, 0x8f, 0xee, 0x54, 0x19, 0xa4, 0x19, 0xc9, 0x1a,
-	0x24, 0x27, 0xe9, 0xce, 0x9c, 0xa2, 0x5f, 0x00, 0x76, 0xca, 0x53, 0x43, 0xf7, 0x6a, 0x1d, 0xd4,
-	0x6e, 0x4c, 0xef, 0xfe, 0x85, 0xb8, 0x6e, 0x90, 0x43, 0x3b, 0xc8, 0x43, 0xf4, 0x80, 0xd4, 0xdf,
-	0xc7, 0xa5, 0x25, 0x22, 0x27, 0x85, 0x35, 0x3d, 0x7d, 0x7c, 0xf8, 0x7d, 0xee, 0x81, 0xb3, 0xb9,
-	0x07, 0x7e, 0xcf, 0x3d, 0xf0, 0x69, 0xe1, 0x35, 0xce, 0x16, 0x5e, 0xe3, 0xe7, 0xc2, 0x6b, 0xbc,
-	0xd9, 0x09, 0x23, 0x3d, 0x35, 0x63, 0x7f, 0x22, 0x66, 0xb9, 0x46, 0xf6, 0xe8, 0xab, 0xe0, 0x1d,
-	0xf9, 0xf0, 0x4f, 0x50, 0x1f, 0x4b, 0xa6, 0xc6, 0x6b, 0xf6, 0xde, 0xef, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x32, 0x81, 0xe9, 0x7a, 0x94, 0x04, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x4f, 0x13, 0x4f,
+	0x1c, 0xed, 0x94, 0xc2, 0xff, 0xef, 0xaf, 0x6a, 0x60, 0x62, 0xea, 0xb2, 0x92, 0x42, 0x56, 0x54,
+	0x8c, 0xd0, 0x81, 0x72, 0x31, 0x1a, 0x8d, 0x42, 0x24, 0xc6, 0x68, 0xa2, 0x35, 0x1a, 0xc3, 0xa5,
+	0x99, 0x76, 0xc7, 0xa5, 0x71, 0xbb, 0x33, 0xec, 0xcc, 0x12, 0x09, 0xe1, 0xe2, 0x27, 0x30, 0xf1,
+	0xee, 0xcd, 0x8b, 0x9f, 0x84, 0x23, 0x89, 0x17, 0xb9, 0x18, 0x03, 0x7e, 0x04, 0x3f, 0x80, 0xd9,
+	0xd9, 0x59, 0x68, 0xd3, 0xdd, 0x0a, 0x9e, 0x76, 0x76, 0xe6, 0xbd, 0xf7, 0x7b, 0xbf, 0x99, 0x79,
+	0x03, 0x4e, 0x9b, 0xcb, 0x2e, 0x97, 0x24, 0x12, 0x5e, 0x48, 0x5d, 0x46, 0xb6, 0x96, 0x5a, 0x4c,
+	0xd1, 0x25, 0xb2, 0x19, 0xb1, 0x70, 0xbb, 0x26, 0x42, 0xae, 0x38, 0xae, 0x24, 0x98, 0x9a, 0xc1,
+	0xd4, 0x0c, 0xc6, 0x9e, 0xf4, 0x38, 0xf7, 0x7c, 0x46, 0x34, 0xaa, 0x15, 0xbd, 0x25, 0x34, 0x30,
+	0x14, 0xfb, 0x92, 0xc7, 0x3d, 0xae, 0x87, 0x24, 0x1e, 0x99, 0xd9, 0x29, 0x43, 0xa0, 0xa2, 0x43,
+	0x68, 0x10, 0x70, 0x45, 0x55, 0x87, 0x07, 0xd2, 0xac, 0xce, 0xe6, 0x58, 0x49, 0xcb, 0x6a, 0x94,
+	0x33, 0x09, 0x97, 0x5f, 0xc4, 0xde, 0x56, 0xa3, 0x30, 0x64, 0x81, 0x7a, 0xee, 0xd3, 0xa0, 0xc1,
+	0x36, 0x23, 0x26, 0x95, 0xf3, 0x14, 0xac, 0xc1, 0x25, 0x29, 0x78, 0x20, 0x19, 0x5e, 0x84, 0x92,
+	0xf0, 0x69, 0x60, 0xa1, 0x19, 0x34, 0x57, 0xae, 0x4f, 0xd5, 0xb2, 0x5b, 0xaa, 0x69, 0x8e, 0x46,
+	0x3a, 0x0b, 0xa6, 0xd0, 0x43, 0x21, 0xfc, 0x0e, 0x73, 0x7b, 0x0a, 0x61, 0x0c, 0xa5, 0x80, 0x76,
+	0x99, 0x16, 0x3b, 0xd7, 0xd0, 0x63, 0xa7, 0x0e, 0xd6, 0x20, 0xdc, 0x14, 0xaf, 0xc0, 0xd8, 0x06,
+	0xeb, 0x78, 0x1b, 0x4a, 0x33, 0x46, 0x1a, 0xe6, 0xcf, 0x79, 0x04, 0x8e, 0xe6, 0xbc, 0x4a, 0x5c,
+	0xb8, 0xab, 0x31, 0x3a, 0x90, 0x91, 0x7c, 0xa9, 0xa8, 0x62, 0x69, 0xb5, 0x69, 0x28, 0xfb, 0x54,
+	0xaa, 0x66, 0x9f, 0x04, 0xc4, 0x53, 0x8f, 0x13, 0x19, 0x06, 0x57, 0x87, 0xca, 0x18, 0x17, 0xb7,
+	0xc1, 0x32, 0xed, 0xba, 0xcd, 0x76, 0x0a, 0x69, 0xca, 0x18, 0x63, 0x15, 0x67, 0xd0, 0xdc, 0xf9,
+	0x46, 0x25, 0xca, 0x54, 0x78, 0x52, 0xfa, 0x1f, 0x8d, 0x17, 0x9d, 0x29, 0xb0, 0x75, 0x99, 0x67,
+	0xdc, 0x8d, 0x7c, 0xf6, 0x9a, 0x85, 0x32, 0x3e, 0xbc, 0x74, 0xf3, 0x7f, 0x23, 0xb8, 0x92, 0xb9,
+	0x6c, 0xaa, 0x4f, 0x43, 0x99, 0x0a, 0xd1, 0xdc, 0x4a, 0xe6, 0x75, 0x17, 0xa5, 0x06, 0x50, 0x21,
+	0x0c, 0x12, 0xaf, 0x03, 0x3e, 0x71, 0x65, 0x60, 0xd2, 0x2a, 0xce, 0x8c, 0xcc, 0x95, 0xeb, 0xd7,
+	0xf2, 0xce, 0xab, 0xaf, 0xd8, 0x4a, 0x69, 0xef, 0xc7, 0x74, 0xa1, 0x31, 0x71, 0x2c, 0x93, 0x9a,
+	0xc0, 0x6f, 0x60, 0xa2, 0xdb, 0xf1, 0x42, 0xaa, 0x98, 0x7b, 0x22, 0x3d, 0x72, 0x76, 0xe9, 0xf1,
+	0x54, 0x25, 0x55, 0x76, 0xee, 0xc1, 0x85, 0x3e, 0x60, 0xd6, 0xdd, 0xc0, 0x16, 0xfc, 0x97, 0xf6,
+	0x5d, 0xd4, 0x7d, 0xa7, 0xbf, 0xf5, 0x83, 0x51, 0x18, 0xd5, 0xbb, 0x86, 0x3f, 0x23, 0x28, 0xf7,
+	0x5c, 0x5c, 0x4c, 0xf2, 0x7c, 0xe5, 0xdc, 0x7e, 0x7b, 0xf1, 0xf4, 0x84, 0xe4, 0x48, 0x9c, 0xf9,
+	0x0f, 0xdf, 0x7e, 0x7d, 0x2a, 0x5e, 0xc7, 0xb3, 0x24, 0x27, 0x79, 0xed, 0x84, 0xd4, 0x8c, 0xf3,
+	0x80, 0xbf, 0x20, 0x28, 0xf7, 0x5c, 0xee, 0xbf, 0x18, 0x1c, 0x4c, 0x8d, 0xbd, 0x78, 0x7a, 0x82,
+	0x31, 0xb8, 0xac, 0x0d, 0x2e, 0xe0, 0x5b, 0x79, 0x06, 0x69, 0x42, 0xd2, 0x06, 0xc9, 0x4e, 0xbc,
+	0xd7, 0xbb, 0xf8, 0x00, 0x41, 0x25, 0x3b, 0x09, 0xf8, 0xce, 0x50, 0x07, 0x43, 0x53, 0x68, 0xdf,
+	0xfd, 0x27, 0xae, 0x69, 0x64, 0x4d, 0x37, 0xf2, 0x00, 0xdf, 0x27, 0xc3, 0xdf, 0xb8, 0x81, 0x60,
+	0x92, 0x9d, 0x9e, 0xe8, 0xef, 0xe2, 0xaf, 0x08, 0x2e, 0xf6, 0xe7, 0x0b, 0xd7, 0x87, 0xfa, 0xca,
+	0xcc, 0xaa, 0xbd, 0x7c, 0x26, 0x8e, 0xe9, 0x81, 0xe8, 0x1e, 0x6e, 0xe2, 0x1b, 0x79, 0x3d, 0x74,
+	0x35, 0xef, 0x38, 0x5f, 0x2b, 0x6b, 0x7b, 0x87, 0x55, 0xb4, 0x7f, 0x58, 0x45, 0x3f, 0x0f, 0xab,
+	0xe8, 0xe3, 0x51, 0xb5, 0xb0, 0x7f, 0x54, 0x2d, 0x7c, 0x3f, 0xaa, 0x16, 0xd6, 0xe7, 0xbd, 0x8e,
+	0xda, 0x88, 0x5a, 0xb5, 0x36, 0xef, 0xa6, 0x62, 0xc9, 0x67, 0x41, 0xba, 0xef, 0xc8, 0xfb, 0x63,
+	0x65, 0xb5, 0x2d, 0x98, 0x6c, 0x8d, 0xe9, 0x87, 0x7f, 0xf9, 0xcf, 0x00, 0x56, 0x43, 0xc2, 0x82,
+	0xab, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -371,6 +545,10 @@ type QueryClient interface {
 	// stored at the last height of this chain.
 	// UpgradedConsensusState RPC not supported with legacy querier
 	UpgradedConsensusState(ctx context.Context, in *QueryUpgradedConsensusStateRequest, opts ...grpc.CallOption) (*QueryUpgradedConsensusStateResponse, error)
+	// ModuleVersions queries the consensus protocol version of the app, the
+	// consensus versions of the modules of the running binary, and the consensus
+	// versions the state of the modules was migrated to.
+	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 }
 
 type queryClient struct {
@@ -408,6 +586,15 @@ func (c *queryClient) UpgradedConsensusState(ctx context.Context, in *QueryUpgra
 	return out, nil
 }
 
+func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error) {
+	out := new(QueryModuleVersionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/ModuleVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -419,6 +606,10 @@ type QueryServer interface {
 	// stored at the last height of this chain.
 	// UpgradedConsensusState RPC not supported with legacy querier
 	UpgradedConsensusState(context.Context, *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error)
+	// ModuleVersions queries the consensus protocol version of the app, the
+	// consensus versions of the modules of the running binary, and the consensus
+	// versions the state of the modules was migrated to.
+	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -434,6 +625,9 @@ func (*UnimplementedQueryServer) AppliedPlan(ctx context.Context, req *QueryAppl
 func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradedConsensusState not implemented")
 }
+func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -493,6 +687,24 @@ func _Query_UpgradedConsensusState_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/ModuleVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleVersions(ctx, req.(*QueryModuleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -509,6 +721,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpgradedConsensusState",
 			Handler:    _Query_UpgradedConsensusState_Handler,
 		},
+		{
+			MethodName: "ModuleVersions",
+			Handler:    _Query_ModuleVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -688,6 +904,120 @@ func (m *QueryUpgradedConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryModuleVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MigratedVersions) > 0 {
+		for iNdEx := len(m.MigratedVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigratedVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConsensusVersions) > 0 {
+		for iNdEx := len(m.ConsensusVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AppVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -771,6 +1101,55 @@ func (m *QueryUpgradedConsensusStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryModuleVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppVersion != 0 {
+		n += 1 + sovQuery(uint64(m.AppVersion))
+	}
+	if len(m.ConsensusVersions) > 0 {
+		for _, e := range m.ConsensusVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MigratedVersions) > 0 {
+		for _, e := range m.MigratedVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1217,6 +1596,294 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusVersions = append(m.ConsensusVersions, ModuleVersion{})
+			if err := m.ConsensusVersions[len(m.ConsensusVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigratedVersions = append(m.MigratedVersions, ModuleVersion{})
+			if err := m.MigratedVersions[len(m.MigratedVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0